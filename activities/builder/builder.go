@@ -1,17 +1,20 @@
 package builder
 
 import (
-	"bytes"
 	"context"
-	"encoding/base64"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
 	"strings"
 
+	"go.temporal.io/sdk/activity"
 	"go.uber.org/zap"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -23,8 +26,10 @@ import (
 	"github.com/moby/buildkit/client"
 	"github.com/moby/buildkit/session"
 	"github.com/moby/buildkit/session/auth/authprovider"
+	"github.com/moby/buildkit/session/secrets/secretsprovider"
 	"github.com/moby/buildkit/util/staticfs"
 	"github.com/skip-mev/ironbird/messages"
+	pb "github.com/skip-mev/ironbird/server/proto"
 	"github.com/skip-mev/ironbird/types"
 	"github.com/tonistiigi/fsutil"
 	fstypes "github.com/tonistiigi/fsutil/types"
@@ -37,6 +42,7 @@ type Activity struct {
 	AwsConfig     *aws.Config
 	Chains        types.Chains
 	Registry      types.RegistryConfig
+	GRPCClient    pb.IronbirdServiceClient
 }
 
 type BuildResult struct {
@@ -44,6 +50,17 @@ type BuildResult struct {
 	Logs    []byte
 }
 
+const (
+	// sourcePatchFile and sourceTarballFile are the names of uploaded changes in the build context, the Dockerfiles
+	// apply them on top of the checked out SHA
+	sourcePatchFile   = "source.patch"
+	sourceTarballFile = "source.tar.gz"
+
+	// gitAuthTokenSecret is the BuildKit secret the Dockerfiles clone the chain with, secrets don't end up in the
+	// image history unlike build args
+	gitAuthTokenSecret = "GIT_AUTH_TOKEN"
+)
+
 var (
 	dependencies = map[string]string{
 		"cometbft/cometbft": "github.com/cometbft/cometbft",
@@ -58,28 +75,6 @@ var (
 	}
 )
 
-func (a *Activity) getAuthenticationToken(ctx context.Context) (string, string, error) {
-	token, err := util.FetchDockerRepoToken(ctx, *a.AwsConfig)
-	if err != nil {
-		return "", "", err
-	}
-
-	decodedToken, err := base64.StdEncoding.DecodeString(token)
-
-	if err != nil {
-		return "", "", fmt.Errorf("failed to decode token: %w", err)
-	}
-
-	// username:string
-	parts := strings.Split(string(decodedToken), ":")
-
-	if len(parts) != 2 {
-		return "", "", fmt.Errorf("invalid token format")
-	}
-
-	return parts[0], parts[1], nil
-}
-
 func (a *Activity) createRepositoryIfNotExists(ctx context.Context) error {
 	stsClient := sts.NewFromConfig(*a.AwsConfig)
 	stsIdentity, err := stsClient.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
@@ -148,9 +143,30 @@ func generateMultipleReplaces(req messages.BuildDockerImageRequest) string {
 			generateReplace(dependencies, repoOwners["cometbft"], "cometbft", req.CometBFTSha))
 	}
 
+	// Arbitrary replacements are applied last in module order, so they take precedence over the ones above
+	for _, module := range slices.Sorted(maps.Keys(req.GoModReplaces)) {
+		replaceCommands = append(replaceCommands,
+			fmt.Sprintf("go mod edit -replace %s=%s", module, normalizeReplacement(req.GoModReplaces[module])))
+	}
+
 	return strings.Join(replaceCommands, " && ")
 }
 
+// normalizeReplacement turns a fork URL@sha into the module path form go mod edit expects
+func normalizeReplacement(replacement string) string {
+	replacement = strings.TrimPrefix(replacement, "https://")
+	return strings.Replace(replacement, ".git@", "@", 1)
+}
+
+// replacesDigest returns a short digest of the go.mod replacements, which identifies them in image tags
+func replacesDigest(replaces map[string]string) string {
+	h := sha256.New()
+	for _, module := range slices.Sorted(maps.Keys(replaces)) {
+		fmt.Fprintf(h, "%s=%s\n", module, normalizeReplacement(replaces[module]))
+	}
+	return hex.EncodeToString(h.Sum(nil))[:12]
+}
+
 func generateTag(req messages.BuildDockerImageRequest) string {
 	imageName := req.ImageConfig.Image
 	version := req.ImageConfig.Version
@@ -158,7 +174,14 @@ func generateTag(req messages.BuildDockerImageRequest) string {
 	sha := req.SHA
 
 	if repo == "cometbft" {
-		return fmt.Sprintf("%s-%s-%s-%s", imageName, version, repo, sha)
+		tag := fmt.Sprintf("%s-%s-%s-%s", imageName, version, repo, sha)
+		if len(req.GoModReplaces) > 0 {
+			tag = fmt.Sprintf("%s-replace-%s", tag, replacesDigest(req.GoModReplaces))
+		}
+		if req.Variant != "" {
+			tag = fmt.Sprintf("%s-%s", tag, req.Variant)
+		}
+		return tag
 	}
 
 	// For EVM builds, include SDK and CometBFT versions in tag if specified
@@ -176,69 +199,120 @@ func generateTag(req messages.BuildDockerImageRequest) string {
 		cometVersion = strings.ReplaceAll(cometVersion, "/", "-")
 		tag = fmt.Sprintf("%s-comet-%s", tag, cometVersion)
 	}
+	if len(req.GoModReplaces) > 0 {
+		// Replacements can be arbitrarily long, so the tag only contains a digest of them
+		tag = fmt.Sprintf("%s-replace-%s", tag, replacesDigest(req.GoModReplaces))
+	}
+	// A commit hash identifies the same source on every remote, but branch and tag names of a fork can point at
+	// other commits than upstream's, so the fork is reflected in the tag unless a full commit hash is built
+	if req.Source.GitURL != "" && !isCommitHash(sha) {
+		tag = fmt.Sprintf("%s-git-%s", tag, contentDigest([]byte(req.Source.GitURL)))
+	}
+	// Uploaded changes are applied on top of the SHA, so they have to be reflected in the tag as well
+	if len(req.Source.Patch) > 0 {
+		tag = fmt.Sprintf("%s-patch-%s", tag, contentDigest(req.Source.Patch))
+	}
+	if len(req.Source.Tarball) > 0 {
+		tag = fmt.Sprintf("%s-src-%s", tag, contentDigest(req.Source.Tarball))
+	}
+	if req.Variant != "" {
+		tag = fmt.Sprintf("%s-%s", tag, req.Variant)
+	}
 
 	return tag
 }
 
-func (a *Activity) imageExistsInECR(ctx context.Context, tag string) (bool, error) {
-	stsClient := sts.NewFromConfig(*a.AwsConfig)
-	stsIdentity, err := stsClient.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
-	if err != nil {
-		return false, fmt.Errorf("failed to fetch STS identity: %w", err)
+// isCommitHash reports whether ref is a full git commit hash rather than a branch, tag or abbreviated hash
+func isCommitHash(ref string) bool {
+	if len(ref) != 40 {
+		return false
 	}
 
-	ecrClient := ecr.NewFromConfig(*a.AwsConfig, func(options *ecr.Options) {
-		options.Region = "us-east-2"
-	})
+	_, err := hex.DecodeString(ref)
+	return err == nil
+}
 
-	result, err := ecrClient.DescribeImages(ctx, &ecr.DescribeImagesInput{
-		RepositoryName: aws.String(a.Registry.ImageName),
-		RegistryId:     stsIdentity.Account,
-		ImageIds: []ecrtypes.ImageIdentifier{
-			{ImageTag: aws.String(tag)},
-		},
-	})
+func contentDigest(content []byte) string {
+	digest := sha256.Sum256(content)
+	return hex.EncodeToString(digest[:])[:12]
+}
 
+// fqdnTag returns the reference a tag of the image is pushed to, or loaded into the local daemon as
+func (a *Activity) fqdnTag(tag string) string {
+	if !a.Registry.IsRemote() {
+		return fmt.Sprintf("%s:%s", a.Registry.ImageName, tag)
+	}
+	return fmt.Sprintf("%s/%s:%s", a.Registry.URL, a.Registry.ImageName, tag)
+}
+
+// imageExists checks whether a tag was already built: in the local daemon for the local registry, otherwise through
+// the registry v2 API
+func (a *Activity) imageExists(ctx context.Context, tag string, credentials util.RegistryCredentials) (bool, error) {
+	if a.Registry.IsRemote() {
+		return util.ImageExistsInRegistry(ctx, a.Registry, credentials, tag)
+	}
+
+	output, err := exec.CommandContext(ctx, "docker", "image", "inspect", a.fqdnTag(tag)).CombinedOutput()
 	if err != nil {
-		var notFoundErr *ecrtypes.ImageNotFoundException
-		if errors.As(err, &notFoundErr) {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && strings.Contains(string(output), "No such image") {
 			return false, nil
 		}
-		return false, err
+		return false, fmt.Errorf("failed to inspect local image: %w, output: %s", err, output)
 	}
 
-	return len(result.ImageDetails) > 0, nil
+	return true, nil
 }
 
 func (a *Activity) BuildDockerImage(ctx context.Context, req messages.BuildDockerImageRequest) (messages.BuildDockerImageResponse, error) {
 	logger, _ := zap.NewDevelopment()
 
+	if err := messages.ValidateGoModReplaces(req.GoModReplaces); err != nil {
+		return messages.BuildDockerImageResponse{}, fmt.Errorf("invalid go.mod replaces: %w", err)
+	}
+
+	if err := req.Source.Validate(req.Repo); err != nil {
+		return messages.BuildDockerImageResponse{}, fmt.Errorf("invalid chain source: %w", err)
+	}
+
+	if err := req.Variant.Validate(req.Repo); err != nil {
+		return messages.BuildDockerImageResponse{}, err
+	}
+
+	var gitAuthToken string
+	if req.Source.GitAuth != "" {
+		var ok bool
+		if gitAuthToken, ok = a.BuilderConfig.AuthEnvConfigs[req.Source.GitAuth]; !ok {
+			return messages.BuildDockerImageResponse{}, fmt.Errorf("git auth %s is not configured", req.Source.GitAuth)
+		}
+	}
+
 	tag := generateTag(req)
 
-	var username, password string
-	var err error
+	credentials, err := util.GetRegistryCredentials(ctx, a.Registry, a.AwsConfig)
+	if err != nil {
+		return messages.BuildDockerImageResponse{}, fmt.Errorf("failed to get registry credentials: %w", err)
+	}
+
 	if a.Registry.Type == "ecr" {
 		if err := a.createRepositoryIfNotExists(ctx); err != nil {
 			return messages.BuildDockerImageResponse{}, err
 		}
+	}
 
-		exists, err := a.imageExistsInECR(ctx, tag)
-		if err != nil {
-			return messages.BuildDockerImageResponse{}, fmt.Errorf("failed to check if image exists: %w", err)
-		}
-		if exists {
-			fqdnTag := fmt.Sprintf("%s/%s:%s", a.Registry.URL, a.Registry.ImageName, tag)
-			logger.Info("Image already exists in ECR, skipping build", zap.String("tag", fqdnTag))
-			return messages.BuildDockerImageResponse{
-				FQDNTag: fqdnTag,
-				Logs:    []byte("Image already exists in ECR, skipped build\n"),
-			}, nil
-		}
+	fqdnTag := a.fqdnTag(tag)
 
-		username, password, err = a.getAuthenticationToken(ctx)
-		if err != nil {
-			return messages.BuildDockerImageResponse{}, err
-		}
+	exists, err := a.imageExists(ctx, tag, credentials)
+	if err != nil {
+		return messages.BuildDockerImageResponse{}, fmt.Errorf("failed to check if image exists: %w", err)
+	}
+	if exists {
+		logger.Info("Image already exists, skipping build", zap.String("registry", a.Registry.Type),
+			zap.String("tag", fqdnTag))
+		return messages.BuildDockerImageResponse{
+			FQDNTag: fqdnTag,
+			Logs:    []byte(fmt.Sprintf("Image already exists in %s registry, skipped build\n", a.Registry.Type)),
+		}, nil
 	}
 
 	bkClient, err := client.New(ctx, a.BuilderConfig.BuildKitAddress)
@@ -270,17 +344,33 @@ func (a *Activity) BuildDockerImage(ctx context.Context, req messages.BuildDocke
 		fs.Add(baseName, &fstypes.Stat{Mode: 0644}, fileContent)
 	}
 
+	if len(req.Source.Patch) > 0 {
+		fs.Add(sourcePatchFile, &fstypes.Stat{Mode: 0644}, req.Source.Patch)
+	}
+
+	if len(req.Source.Tarball) > 0 {
+		fs.Add(sourceTarballFile, &fstypes.Stat{Mode: 0644}, req.Source.Tarball)
+	}
+
 	authConfigs := make(map[string]configtypes.AuthConfig)
-	if a.Registry.Type == "ecr" && username != "" && password != "" {
+	if a.Registry.IsRemote() && !credentials.IsEmpty() {
 		authConfigs[a.Registry.URL] = configtypes.AuthConfig{
-			Username: username,
-			Password: password,
+			Username:      credentials.Username,
+			Password:      credentials.Password,
+			RegistryToken: credentials.Token,
 		}
 	}
 	authProvider := authprovider.NewDockerAuthProvider(&configfile.ConfigFile{
 		AuthConfigs: authConfigs,
 	}, map[string]*authprovider.AuthTLSConfig{})
 
+	attachables := []session.Attachable{authProvider}
+	if gitAuthToken != "" {
+		attachables = append(attachables, secretsprovider.FromMap(map[string][]byte{
+			gitAuthTokenSecret: []byte(gitAuthToken),
+		}))
+	}
+
 	frontendAttrs := map[string]string{
 		"filename": "Dockerfile",
 		"target":   "",
@@ -289,6 +379,9 @@ func (a *Activity) BuildDockerImage(ctx context.Context, req messages.BuildDocke
 
 	buildArguments := make(map[string]string)
 	buildArguments["GIT_SHA"] = tag
+	if req.Variant != "" {
+		buildArguments["BUILD_VARIANT"] = string(req.Variant)
+	}
 
 	// Generate replace commands for go.mod modifications
 	replaceCmd := generateMultipleReplaces(req)
@@ -302,6 +395,9 @@ func (a *Activity) BuildDockerImage(ctx context.Context, req messages.BuildDocke
 	} else {
 		buildArguments["CHAIN_TAG"] = req.SHA
 		buildArguments["CHAIN_SRC"] = fmt.Sprintf("https://github.com/%s/%s", repoOwners[req.Repo], req.Repo)
+		if req.Source.GitURL != "" {
+			buildArguments["CHAIN_SRC"] = req.Source.GitURL
+		}
 		// For EVM builds with optional replacements
 		if replaceCmd != "" {
 			buildArguments["REPLACE_CMD"] = replaceCmd
@@ -315,24 +411,24 @@ func (a *Activity) BuildDockerImage(ctx context.Context, req messages.BuildDocke
 	logger.Info("building docker image", zap.Any("build_arguments", buildArguments),
 		zap.Any("frontend_attrs", frontendAttrs), zap.String("dockerfile_path", image.Dockerfile))
 
-	var fqdnTag string
 	var exports []client.ExportEntry
 
-	fqdnTag = fmt.Sprintf("%s:%s", a.Registry.ImageName, tag)
-
-	if a.Registry.Type == "ecr" {
-		// ECR mode: push directly to registry
-		fqdnTag = fmt.Sprintf("%s/%s:%s", a.Registry.URL, a.Registry.ImageName, tag)
+	if a.Registry.IsRemote() {
+		// ECR and OCI mode: push directly to registry
+		attrs := map[string]string{
+			"name": fqdnTag,
+			"push": "true",
+		}
+		if a.Registry.Insecure {
+			attrs["registry.insecure"] = "true"
+		}
 		exports = []client.ExportEntry{
 			{
-				Type: client.ExporterImage,
-				Attrs: map[string]string{
-					"name": fqdnTag,
-					"push": "true",
-				},
+				Type:  client.ExporterImage,
+				Attrs: attrs,
 			},
 		}
-		logger.Info("Using ECR registry", zap.String("tag", fqdnTag))
+		logger.Info("Using remote registry", zap.String("registry", a.Registry.Type), zap.String("tag", fqdnTag))
 	} else {
 		// Local mode: export to Docker tarball format, then load into Docker
 		tmpFile, err := os.CreateTemp("", "image-*.tar")
@@ -363,26 +459,11 @@ func (a *Activity) BuildDockerImage(ctx context.Context, req messages.BuildDocke
 				"context":    fs,
 				"dockerfile": fs,
 			},
-			Session: []session.Attachable{
-				authProvider,
-			},
+			Session: attachables,
 			Exports: exports,
 		}
 
-		statusChan := make(chan *client.SolveStatus)
-		var logs bytes.Buffer
-
-		go func() {
-			for status := range statusChan {
-				for _, v := range status.Logs {
-					logLine := fmt.Sprintf("[%s]: %s\n", v.Timestamp.String(), string(v.Data))
-					logs.WriteString(logLine)
-					fmt.Print(logLine)
-				}
-			}
-		}()
-
-		_, err = bkClient.Solve(ctx, nil, solveOpt, statusChan)
+		progress, err := a.solve(ctx, logger, bkClient, solveOpt, tag)
 		if err != nil {
 			return messages.BuildDockerImageResponse{}, err
 		}
@@ -398,7 +479,7 @@ func (a *Activity) BuildDockerImage(ctx context.Context, req messages.BuildDocke
 
 		return messages.BuildDockerImageResponse{
 			FQDNTag: fqdnTag,
-			Logs:    logs.Bytes(),
+			Logs:    progress.logs(),
 		}, nil
 	}
 
@@ -409,32 +490,46 @@ func (a *Activity) BuildDockerImage(ctx context.Context, req messages.BuildDocke
 			"context":    fs,
 			"dockerfile": fs,
 		},
-		Session: []session.Attachable{
-			authProvider,
-		},
+		Session: attachables,
 		Exports: exports,
 	}
 
+	progress, err := a.solve(ctx, logger, bkClient, solveOpt, tag)
+	if err != nil {
+		return messages.BuildDockerImageResponse{}, err
+	}
+
+	return messages.BuildDockerImageResponse{
+		FQDNTag: fqdnTag,
+		Logs:    progress.logs(),
+	}, nil
+}
+
+// solve runs a build and streams its progress to the server under the workflow the activity belongs to. A failed
+// build returns the output of the step that failed
+func (a *Activity) solve(ctx context.Context, logger *zap.Logger, bkClient *client.Client, solveOpt client.SolveOpt,
+	tag string,
+) (*buildProgress, error) {
+	var workflowID string
+	if activity.IsActivity(ctx) {
+		workflowID = activity.GetInfo(ctx).WorkflowExecution.ID
+	}
+
+	progress := newBuildProgress(logger, a.GRPCClient, workflowID, tag)
 	statusChan := make(chan *client.SolveStatus)
-	var logs bytes.Buffer
+	done := make(chan struct{})
 
 	go func() {
-		for status := range statusChan {
-			for _, v := range status.Logs {
-				logLine := fmt.Sprintf("[%s]: %s\n", v.Timestamp.String(), string(v.Data))
-				logs.WriteString(logLine)
-				fmt.Print(logLine)
-			}
-		}
+		defer close(done)
+		progress.run(ctx, statusChan)
 	}()
 
-	_, err = bkClient.Solve(ctx, nil, solveOpt, statusChan)
+	_, err := bkClient.Solve(ctx, nil, solveOpt, statusChan)
+	<-done
+
 	if err != nil {
-		return messages.BuildDockerImageResponse{}, err
+		return progress, progress.failure(err)
 	}
 
-	return messages.BuildDockerImageResponse{
-		FQDNTag: fqdnTag,
-		Logs:    logs.Bytes(),
-	}, nil
+	return progress, nil
 }
@@ -0,0 +1,117 @@
+package builder
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/moby/buildkit/client"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/ironbird/messages"
+)
+
+func TestGoModReplaces(t *testing.T) {
+	req := messages.BuildDockerImageRequest{
+		Repo:         "gaia",
+		SHA:          "abc123",
+		CosmosSdkSha: "v0.53.4",
+		GoModReplaces: map[string]string{
+			"github.com/cosmos/iavl":       "github.com/cosmos/iavl@v1.2.6",
+			"github.com/cosmos/ibc-go/v10": "https://github.com/org/ibc-go.git@def456",
+		},
+	}
+
+	require.Equal(t, "go mod edit -replace github.com/cosmos/cosmos-sdk=github.com/cosmos/cosmos-sdk@v0.53.4 && "+
+		"go mod edit -replace github.com/cosmos/iavl=github.com/cosmos/iavl@v1.2.6 && "+
+		"go mod edit -replace github.com/cosmos/ibc-go/v10=github.com/org/ibc-go@def456",
+		generateMultipleReplaces(req))
+
+	tag := generateTag(req)
+	require.Regexp(t, `^gaia-abc123-sdk-v0\.53\.4-replace-[0-9a-f]{12}$`, tag)
+
+	// the tag only depends on the replacements, not on how the fork URL is spelled
+	equivalent := req
+	equivalent.GoModReplaces = map[string]string{
+		"github.com/cosmos/ibc-go/v10": "github.com/org/ibc-go@def456",
+		"github.com/cosmos/iavl":       "github.com/cosmos/iavl@v1.2.6",
+	}
+	require.Equal(t, tag, generateTag(equivalent))
+
+	different := req
+	different.GoModReplaces = map[string]string{"github.com/cosmos/iavl": "github.com/cosmos/iavl@v1.2.7"}
+	require.NotEqual(t, tag, generateTag(different))
+}
+
+func TestChainSourceTag(t *testing.T) {
+	req := messages.BuildDockerImageRequest{Repo: "gaia", SHA: "abc123"}
+	require.Equal(t, "gaia-abc123", generateTag(req))
+
+	// forks are identified by full commit hashes alone
+	req.Source.GitURL = "https://github.com/org/gaia"
+	req.SHA = "0123456789abcdef0123456789abcdef01234567"
+	require.Equal(t, "gaia-0123456789abcdef0123456789abcdef01234567", generateTag(req))
+
+	// branches, tags and abbreviated hashes of a fork can point at other commits than upstream's
+	req.SHA = "main"
+	fork := generateTag(req)
+	require.Regexp(t, `^gaia-main-git-[0-9a-f]{12}$`, fork)
+
+	req.Source.GitURL = "https://github.com/other/gaia"
+	require.NotEqual(t, fork, generateTag(req))
+
+	req.Source.GitURL = ""
+	req.SHA = "abc123"
+	req.Source.Patch = []byte("diff --git a/app/app.go b/app/app.go\n")
+	patched := generateTag(req)
+	require.Regexp(t, `^gaia-abc123-patch-[0-9a-f]{12}$`, patched)
+
+	req.Source.Patch = []byte("diff --git a/app/app.go b/app/app.go\n+// changed\n")
+	require.NotEqual(t, patched, generateTag(req))
+}
+
+func TestBuildProgressFailure(t *testing.T) {
+	progress := newBuildProgress(zap.NewNop(), nil, "", "gaia-abc123")
+	started := time.Now()
+	completed := started.Add(3 * time.Second)
+
+	progress.handle(&client.SolveStatus{
+		Vertexes: []*client.Vertex{
+			{Digest: "sha256:a", Name: "[internal] load build definition", Started: &started, Completed: &completed},
+			{Digest: "sha256:b", Name: "[builder 4/6] RUN make build", Started: &started},
+			{Digest: "sha256:c", Name: "[builder 2/6] RUN apk add git", Started: &started},
+		},
+	})
+	progress.handle(&client.SolveStatus{
+		Logs: []*client.VertexLog{
+			{Vertex: "sha256:b", Data: []byte("go: downloading github.com/cosmos/iavl v1.2.6\n")},
+			{Vertex: "sha256:b", Data: []byte("app.go:12: undefined: foo\n")},
+		},
+	})
+	progress.handle(&client.SolveStatus{
+		Vertexes: []*client.Vertex{
+			{Digest: "sha256:c", Name: "[builder 2/6] RUN apk add git", Started: &started, Completed: &completed,
+				Error: "context canceled"},
+			{Digest: "sha256:b", Name: "[builder 4/6] RUN make build", Started: &started, Completed: &completed,
+				Error: "process did not complete successfully: exit code: 2"},
+		},
+	})
+
+	require.Contains(t, string(progress.logs()), "#1 DONE 3.0s\n#2 [builder 4/6] RUN make build\n")
+
+	err := progress.failure(errors.New("exit code: 2"))
+	require.EqualError(t, err, "build step \"[builder 4/6] RUN make build\" failed: exit code: 2\n"+
+		"go: downloading github.com/cosmos/iavl v1.2.6\napp.go:12: undefined: foo")
+}
+
+func TestBuildVariantTag(t *testing.T) {
+	req := messages.BuildDockerImageRequest{Repo: "gaia", SHA: "abc123", Variant: messages.CoverBuild}
+	require.Equal(t, "gaia-abc123-cover", generateTag(req))
+
+	req.Variant = messages.RaceBuild
+	require.Equal(t, "gaia-abc123-race", generateTag(req))
+
+	req.Source.Patch = []byte("diff --git a/app/app.go b/app/app.go\n")
+	require.Regexp(t, `^gaia-abc123-patch-[0-9a-f]{12}-race$`, generateTag(req))
+}
@@ -0,0 +1,184 @@
+package builder
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/moby/buildkit/client"
+	"github.com/opencontainers/go-digest"
+	"go.uber.org/zap"
+
+	pb "github.com/skip-mev/ironbird/server/proto"
+)
+
+const (
+	// progressFlushInterval is how often buffered build output is sent to the server
+	progressFlushInterval = 2 * time.Second
+
+	// failedStepOutputLines is how many trailing lines of a failed step's output end up in the build error
+	failedStepOutputLines = 40
+
+	// maxResponseLogs caps the logs returned in the activity result, the full log is stored by the server
+	maxResponseLogs = 64 << 10
+)
+
+// buildProgress renders the solve status of a build in the style of BuildKit's plain progress output. It keeps the
+// full log, sends it to the server in batches while the build runs and remembers the output of every step, so that
+// a failed build can report the output of the step that failed
+type buildProgress struct {
+	logger     *zap.Logger
+	client     pb.IronbirdServiceClient
+	workflowID string
+	build      string
+
+	log      bytes.Buffer
+	pending  bytes.Buffer
+	vertices map[digest.Digest]*vertexProgress
+	order    []digest.Digest
+}
+
+type vertexProgress struct {
+	index     int
+	name      string
+	started   bool
+	completed bool
+	err       string
+	output    []string
+}
+
+// newBuildProgress creates a buildProgress for the given build. Without a client or a workflow ID the output is
+// only kept locally
+func newBuildProgress(logger *zap.Logger, grpcClient pb.IronbirdServiceClient, workflowID, build string) *buildProgress {
+	return &buildProgress{
+		logger:     logger,
+		client:     grpcClient,
+		workflowID: workflowID,
+		build:      build,
+		vertices:   make(map[digest.Digest]*vertexProgress),
+	}
+}
+
+// run consumes statusChan until BuildKit closes it and sends the remaining output afterwards
+func (p *buildProgress) run(ctx context.Context, statusChan <-chan *client.SolveStatus) {
+	ticker := time.NewTicker(progressFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case status, ok := <-statusChan:
+			if !ok {
+				p.flush(context.WithoutCancel(ctx))
+				return
+			}
+			p.handle(status)
+		case <-ticker.C:
+			p.flush(ctx)
+		}
+	}
+}
+
+func (p *buildProgress) handle(status *client.SolveStatus) {
+	for _, v := range status.Vertexes {
+		vertex := p.vertex(v.Digest)
+		vertex.name = v.Name
+
+		if v.Started != nil && !vertex.started {
+			vertex.started = true
+			p.writef("#%d %s\n", vertex.index, vertex.name)
+		}
+
+		if v.Error != "" && vertex.err == "" {
+			vertex.err = v.Error
+			p.writef("#%d ERROR: %s\n", vertex.index, v.Error)
+		} else if v.Completed != nil && !vertex.completed {
+			vertex.completed = true
+			if v.Cached {
+				p.writef("#%d CACHED\n", vertex.index)
+			} else if v.Started != nil {
+				p.writef("#%d DONE %.1fs\n", vertex.index, v.Completed.Sub(*v.Started).Seconds())
+			}
+		}
+	}
+
+	for _, l := range status.Logs {
+		vertex := p.vertex(l.Vertex)
+
+		for _, line := range strings.Split(strings.TrimRight(string(l.Data), "\n"), "\n") {
+			vertex.output = append(vertex.output, line)
+			if len(vertex.output) > failedStepOutputLines {
+				vertex.output = vertex.output[1:]
+			}
+			p.writef("#%d %s\n", vertex.index, line)
+		}
+	}
+}
+
+func (p *buildProgress) vertex(d digest.Digest) *vertexProgress {
+	vertex, ok := p.vertices[d]
+	if !ok {
+		vertex = &vertexProgress{index: len(p.order) + 1}
+		p.vertices[d] = vertex
+		p.order = append(p.order, d)
+	}
+	return vertex
+}
+
+func (p *buildProgress) writef(format string, args ...interface{}) {
+	line := fmt.Sprintf(format, args...)
+	p.log.WriteString(line)
+	p.pending.WriteString(line)
+	fmt.Print(line)
+}
+
+// flush sends the output buffered since the last flush to the server. Output that can't be sent is dropped from the
+// stream, it is still part of the full log
+func (p *buildProgress) flush(ctx context.Context) {
+	if p.pending.Len() == 0 || p.client == nil || p.workflowID == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	_, err := p.client.AppendBuildLogs(ctx, &pb.AppendBuildLogsRequest{
+		WorkflowId: p.workflowID,
+		Build:      p.build,
+		Content:    p.pending.String(),
+	})
+	if err != nil {
+		p.logger.Warn("failed to send build logs", zap.String("build", p.build), zap.Error(err))
+	}
+
+	p.pending.Reset()
+}
+
+// failure returns the error of a failed solve with the name and the last lines of output of the step that failed
+func (p *buildProgress) failure(err error) error {
+	// steps that run in parallel to the failed one are cancelled, their errors are only reported if no other step
+	// failed
+	var failed *vertexProgress
+	for _, d := range p.order {
+		vertex := p.vertices[d]
+		if vertex.err != "" && (failed == nil || strings.Contains(failed.err, context.Canceled.Error())) {
+			failed = vertex
+		}
+	}
+
+	if failed != nil {
+		return fmt.Errorf("build step %q failed: %w\n%s", failed.name, err, strings.Join(failed.output, "\n"))
+	}
+
+	return fmt.Errorf("failed to build image: %w", err)
+}
+
+// logs returns the tail of the full log
+func (p *buildProgress) logs() []byte {
+	logs := p.log.Bytes()
+	if len(logs) > maxResponseLogs {
+		logs = logs[len(logs)-maxResponseLogs:]
+	}
+	return bytes.Clone(logs)
+}
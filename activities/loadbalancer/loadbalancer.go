@@ -7,15 +7,21 @@ import (
 
 	pb "github.com/skip-mev/ironbird/server/proto"
 
+	"github.com/skip-mev/ironbird/activities/testnet"
 	"github.com/skip-mev/ironbird/messages"
 	"github.com/skip-mev/ironbird/petri/core/apps"
+	"github.com/skip-mev/ironbird/petri/core/provider"
 	"github.com/skip-mev/ironbird/petri/core/provider/digitalocean"
+	"github.com/skip-mev/ironbird/petri/core/provider/docker"
+	petritypes "github.com/skip-mev/ironbird/petri/core/types"
 	"github.com/skip-mev/ironbird/util"
+	"go.temporal.io/sdk/temporal"
 	"go.uber.org/zap"
 )
 
 type Activity struct {
 	RootDomain        string
+	Image             string
 	SSLCertificate    []byte
 	SSLKey            []byte
 	DOToken           string
@@ -24,31 +30,48 @@ type Activity struct {
 	GRPCClient        pb.IronbirdServiceClient
 }
 
-func (a *Activity) LaunchLoadBalancer(ctx context.Context, req messages.LaunchLoadBalancerRequest) (messages.LaunchLoadBalancerResponse, error) {
-	logger, _ := zap.NewDevelopment()
-
-	if req.RunnerType != messages.DigitalOcean {
-		return messages.LaunchLoadBalancerResponse{}, fmt.Errorf("only digitalocean provider supported for load balancer")
-	}
-
-	decompressedProviderState, err := util.DecompressData(req.ProviderState)
+func (a *Activity) restoreProvider(ctx context.Context, logger *zap.Logger, runnerType messages.RunnerType, providerState []byte) (provider.ProviderI, error) {
+	decompressedProviderState, err := util.DecompressData(providerState)
 	if err != nil {
-		return messages.LaunchLoadBalancerResponse{}, fmt.Errorf("failed to decompress provider state: %w", err)
+		return nil, fmt.Errorf("failed to decompress provider state: %w", err)
 	}
 
-	p, err := digitalocean.RestoreProvider(ctx, decompressedProviderState, a.DOToken, a.TailscaleSettings,
-		digitalocean.WithLogger(logger), digitalocean.WithDomain(a.RootDomain))
+	var p provider.ProviderI
+	if runnerType == messages.Docker {
+		p, err = docker.RestoreProvider(ctx, logger, decompressedProviderState)
+	} else {
+		p, err = digitalocean.RestoreProvider(ctx, decompressedProviderState, a.DOToken, a.TailscaleSettings,
+			digitalocean.WithLogger(logger), digitalocean.WithDomain(a.RootDomain))
+	}
 
 	if err != nil {
-		return messages.LaunchLoadBalancerResponse{}, fmt.Errorf("failed to restore provider: %w", err)
+		return nil, fmt.Errorf("failed to restore provider: %w", err)
 	}
 
-	lb, err := apps.LaunchLoadBalancer(ctx, p, a.RootDomain, apps.LoadBalancerDefinition{
+	return p, nil
+}
+
+func (a *Activity) definition(domains []apps.LoadBalancerDomain) apps.LoadBalancerDefinition {
+	return apps.LoadBalancerDefinition{
+		Image:              a.Image,
 		SSLKey:             a.SSLKey,
 		SSLCertificate:     a.SSLCertificate,
 		DigitalOceanConfig: messages.DigitalOceanDefaultOpts,
-		Domains:            req.Domains,
-	})
+		Domains:            domains,
+	}
+}
+
+func (a *Activity) LaunchLoadBalancer(ctx context.Context, req messages.LaunchLoadBalancerRequest) (messages.LaunchLoadBalancerResponse, error) {
+	logger, _ := zap.NewDevelopment()
+
+	p, err := a.restoreProvider(ctx, logger, req.RunnerType, req.ProviderState)
+	if err != nil {
+		return messages.LaunchLoadBalancerResponse{}, err
+	}
+
+	definition := a.definition(req.Domains)
+
+	lb, err := apps.LaunchLoadBalancer(ctx, p, a.RootDomain, definition)
 
 	if err != nil {
 		return messages.LaunchLoadBalancerResponse{}, fmt.Errorf("failed to launch load balancer: %w", err)
@@ -86,17 +109,26 @@ func (a *Activity) LaunchLoadBalancer(ctx context.Context, req messages.LaunchLo
 
 		var loadBalancers []*pb.Node
 		for nodeName := range nodeNames {
-			node := &pb.Node{
-				Name:    nodeName,
-				Address: a.RootDomain,
-				Rpc:     fmt.Sprintf("https://%s-rpc.%s", nodeName, a.RootDomain),
-				Lcd:     fmt.Sprintf("https://%s-lcd.%s", nodeName, a.RootDomain),
-				Grpc:    fmt.Sprintf("%s-grpc.%s", nodeName, a.RootDomain),
-			}
+			var node *pb.Node
+			if req.RunnerType == messages.Docker {
+				node, err = localLoadBalancerNode(ctx, lb, definition.DomainPorts(), nodeName, req.IsEvmChain)
+				if err != nil {
+					logger.Error("Failed to get load balancer addresses", zap.String("node", nodeName), zap.Error(err))
+					continue
+				}
+			} else {
+				node = &pb.Node{
+					Name:    nodeName,
+					Address: a.RootDomain,
+					Rpc:     fmt.Sprintf("https://%s-rpc.%s", nodeName, a.RootDomain),
+					Lcd:     fmt.Sprintf("https://%s-lcd.%s", nodeName, a.RootDomain),
+					Grpc:    fmt.Sprintf("%s-grpc.%s", nodeName, a.RootDomain),
+				}
 
-			if req.IsEvmChain {
-				node.Evmrpc = fmt.Sprintf("https://%s-evmrpc.%s", nodeName, a.RootDomain)
-				node.Evmws = fmt.Sprintf("wss://%s-evmws.%s", nodeName, a.RootDomain)
+				if req.IsEvmChain {
+					node.Evmrpc = fmt.Sprintf("https://%s-evmrpc.%s", nodeName, a.RootDomain)
+					node.Evmws = fmt.Sprintf("wss://%s-evmws.%s", nodeName, a.RootDomain)
+				}
 			}
 
 			loadBalancers = append(loadBalancers, node)
@@ -117,3 +149,115 @@ func (a *Activity) LaunchLoadBalancer(ctx context.Context, req messages.LaunchLo
 
 	return messages.LaunchLoadBalancerResponse{ProviderState: compressedProviderState, LoadBalancerState: loadBalancerState, RootDomain: a.RootDomain}, nil
 }
+
+// localLoadBalancerNode returns the host addresses of a load balancer that serves every domain on its own port
+func localLoadBalancerNode(ctx context.Context, lb provider.TaskI, domainPorts map[string]string, nodeName string,
+	isEvmChain bool,
+) (*pb.Node, error) {
+	address := func(domainType string) (string, error) {
+		port, ok := domainPorts[fmt.Sprintf("%s-%s", nodeName, domainType)]
+		if !ok {
+			return "", fmt.Errorf("load balancer has no %s domain", domainType)
+		}
+		return lb.GetExternalAddress(ctx, port)
+	}
+
+	rpc, err := address("rpc")
+	if err != nil {
+		return nil, err
+	}
+
+	lcd, err := address("lcd")
+	if err != nil {
+		return nil, err
+	}
+
+	grpc, err := address("grpc")
+	if err != nil {
+		return nil, err
+	}
+
+	node := &pb.Node{
+		Name:    nodeName,
+		Address: "localhost",
+		Rpc:     fmt.Sprintf("http://%s", rpc),
+		Lcd:     fmt.Sprintf("http://%s", lcd),
+		Grpc:    grpc,
+	}
+
+	if isEvmChain {
+		evmRpc, err := address("evmrpc")
+		if err != nil {
+			return nil, err
+		}
+		node.Evmrpc = fmt.Sprintf("http://%s", evmRpc)
+
+		evmWs, err := address("evmws")
+		if err != nil {
+			return nil, err
+		}
+		node.Evmws = fmt.Sprintf("ws://%s", evmWs)
+	}
+
+	return node, nil
+}
+
+// UpdateLoadBalancer regenerates the Caddyfile of a running load balancer from the current nodes of the chain and
+// hot-reloads Caddy, so that the load balancer follows nodes that were added, removed or recreated with a new IP
+func (a *Activity) UpdateLoadBalancer(ctx context.Context, req messages.UpdateLoadBalancerRequest) (resp messages.UpdateLoadBalancerResponse, err error) {
+	logger, _ := zap.NewDevelopment()
+
+	p, err := a.restoreProvider(ctx, logger, req.RunnerType, req.ProviderState)
+	if err != nil {
+		return resp, err
+	}
+
+	lb, err := p.DeserializeTask(ctx, req.LoadBalancerState)
+	if err != nil {
+		return resp, fmt.Errorf("failed to restore load balancer task: %w", err)
+	}
+
+	decompressedChainState, err := util.DecompressData(req.ChainState)
+	if err != nil {
+		return resp, fmt.Errorf("failed to decompress chain state: %w", err)
+	}
+
+	chain, err := testnet.RestoreChain(ctx, logger, p, decompressedChainState)
+	if err != nil {
+		return resp, temporal.NewApplicationErrorWithOptions("failed to restore chain", err.Error(), temporal.ApplicationErrorOptions{NonRetryable: true})
+	}
+
+	addresses := func(nodes []petritypes.NodeI) ([]*pb.Node, error) {
+		var pbNodes []*pb.Node
+		for _, n := range nodes {
+			ip, err := n.GetIP(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get IP of %s: %w", n.GetDefinition().Name, err)
+			}
+			pbNodes = append(pbNodes, &pb.Node{Name: n.GetDefinition().Name, Address: ip})
+		}
+		return pbNodes, nil
+	}
+
+	nodes, err := addresses(chain.Nodes)
+	if err != nil {
+		return resp, err
+	}
+
+	validators, err := addresses(chain.Validators)
+	if err != nil {
+		return resp, err
+	}
+
+	domains := req.LoadBalancer.Domains(req.ChainName, nodes, validators, req.IsEvmChain)
+
+	resp.Reloaded, err = apps.UpdateLoadBalancer(ctx, lb, a.RootDomain, a.definition(domains))
+	if err != nil {
+		return resp, fmt.Errorf("failed to update load balancer: %w", err)
+	}
+
+	logger.Info("updated load balancer", zap.Bool("reloaded", resp.Reloaded), zap.Int("nodes", len(nodes)),
+		zap.Int("validators", len(validators)))
+
+	return resp, nil
+}
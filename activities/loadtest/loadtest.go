@@ -17,7 +17,6 @@ import (
 	"github.com/skip-mev/ironbird/petri/core/provider"
 	"github.com/skip-mev/ironbird/petri/core/provider/digitalocean"
 	"github.com/skip-mev/ironbird/petri/cosmos/chain"
-	"github.com/skip-mev/ironbird/petri/cosmos/node"
 	"go.uber.org/zap"
 	"gopkg.in/yaml.v3"
 )
@@ -171,13 +170,7 @@ func (a *Activity) RunLoadTest(ctx context.Context, req messages.RunLoadTestRequ
 		return messages.RunLoadTestResponse{}, fmt.Errorf("failed to decompress chain state: %w", err)
 	}
 
-	walletConfig := testnet.CosmosWalletConfig
-	if req.IsEvmChain {
-		walletConfig = testnet.EvmCosmosWalletConfig
-		logger.Info("updated load test to evm walletconfig")
-	}
-
-	chain, err := chain.RestoreChain(ctx, logger, p, decompressedChainState, node.RestoreNode, walletConfig)
+	chain, err := testnet.RestoreChain(ctx, logger, p, decompressedChainState)
 	if err != nil {
 		return handleLoadTestError(ctx, logger, p, nil, err, "failed to restore chain")
 	}
@@ -122,6 +122,26 @@ func (m MockNode) OverwriteGenesisFile(ctx context.Context, bytes []byte) error
 	panic("implement me")
 }
 
+func (m MockNode) ExportGenesis(ctx context.Context, height uint64) ([]byte, error) {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (m MockNode) MigrateGenesis(ctx context.Context, bytes []byte, strings []string) ([]byte, error) {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (m MockNode) ResetState(ctx context.Context) error {
+	//TODO implement me
+	panic("implement me")
+}
+
+func (m MockNode) ModifyTomlConfigFile(ctx context.Context, s string, m2 map[string]interface{}) error {
+	//TODO implement me
+	panic("implement me")
+}
+
 func (m MockNode) CreateWallet(ctx context.Context, s string, config types.WalletConfig) (types.WalletI, error) {
 	//TODO implement me
 	panic("implement me")
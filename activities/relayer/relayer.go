@@ -0,0 +1,237 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/ironbird/activities/testnet"
+	"github.com/skip-mev/ironbird/messages"
+	"github.com/skip-mev/ironbird/petri/core/apps"
+	"github.com/skip-mev/ironbird/petri/core/provider"
+	"github.com/skip-mev/ironbird/petri/core/provider/digitalocean"
+	petritypes "github.com/skip-mev/ironbird/petri/core/types"
+	"github.com/skip-mev/ironbird/util"
+)
+
+const (
+	defaultTransferAmount    = 1
+	defaultTransferMsgsPerTx = 10
+	transferInterval         = 5 * time.Second
+)
+
+type Activity struct {
+	DOToken           string
+	TailscaleSettings digitalocean.TailscaleSettings
+	TelemetrySettings digitalocean.TelemetrySettings
+}
+
+func (a *Activity) restoreProvider(ctx context.Context, logger *zap.Logger, runnerType messages.RunnerType, providerState []byte) (provider.ProviderI, error) {
+	decompressedProviderState, err := util.DecompressData(providerState)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress provider state: %w", err)
+	}
+
+	p, err := util.RestoreProvider(ctx, logger, runnerType, decompressedProviderState, util.ProviderOptions{
+		DOToken: a.DOToken, TailscaleSettings: a.TailscaleSettings, TelemetrySettings: a.TelemetrySettings,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore provider: %w", err)
+	}
+
+	return p, nil
+}
+
+func (a *Activity) LaunchRelayer(ctx context.Context, req messages.LaunchRelayerRequest) (resp messages.LaunchRelayerResponse, err error) {
+	logger, _ := zap.NewDevelopment()
+
+	p, err := a.restoreProvider(ctx, logger, req.RunnerType, req.ProviderState)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.Denoms = make(map[string]string, len(req.Chains))
+	relayerChains := make([]apps.RelayerChain, 0, len(req.Chains))
+
+	for _, chainState := range req.Chains {
+		relayerChain, denom, err := restoreRelayerChain(ctx, logger, p, chainState)
+		if err != nil {
+			return resp, temporal.NewApplicationErrorWithOptions("failed to restore chain", err.Error(), temporal.ApplicationErrorOptions{NonRetryable: true})
+		}
+
+		relayerChains = append(relayerChains, relayerChain)
+		resp.Denoms[relayerChain.ChainID] = denom
+	}
+
+	paths := make([]apps.RelayerPath, 0, len(relayerChains)-1)
+	for _, counterparty := range relayerChains[1:] {
+		paths = append(paths, apps.RelayerPath{ChainA: relayerChains[0].ChainID, ChainB: counterparty.ChainID})
+	}
+
+	var providerSpecificConfig map[string]string
+	if req.RunnerType == messages.DigitalOcean {
+		providerSpecificConfig = maps.Clone(messages.DigitalOceanDefaultOpts)
+		maps.Copy(providerSpecificConfig, req.ProviderSpecificConfig)
+	}
+
+	logger.Info("launching relayer", zap.Any("paths", paths))
+	task, channels, launchErr := apps.LaunchRelayer(ctx, p, apps.RelayerDefinition{
+		Image:                  req.Image,
+		Chains:                 relayerChains,
+		Paths:                  paths,
+		ProviderSpecificConfig: providerSpecificConfig,
+	})
+
+	// the relayer task is part of the provider state even if the channels couldn't be created, so the provider
+	// state is attached to the error details to let the workflow tear it down
+	providerState, err := p.SerializeProvider(ctx)
+	if err != nil {
+		return resp, temporal.NewApplicationErrorWithOptions("failed to serialize provider", err.Error(), temporal.ApplicationErrorOptions{NonRetryable: true})
+	}
+
+	resp.ProviderState, err = util.CompressData(providerState)
+	if err != nil {
+		return resp, temporal.NewApplicationErrorWithOptions("failed to compress provider state", err.Error(), temporal.ApplicationErrorOptions{NonRetryable: true})
+	}
+
+	if launchErr != nil {
+		return resp, temporal.NewApplicationErrorWithOptions("failed to launch relayer", launchErr.Error(), temporal.ApplicationErrorOptions{
+			NonRetryable: true,
+			Details:      []interface{}{resp.ProviderState},
+		})
+	}
+
+	resp.RelayerState, err = p.SerializeTask(ctx, task)
+	if err != nil {
+		return resp, temporal.NewApplicationErrorWithOptions("failed to serialize relayer task", err.Error(), temporal.ApplicationErrorOptions{
+			NonRetryable: true,
+			Details:      []interface{}{resp.ProviderState},
+		})
+	}
+
+	resp.Channels = channels
+	logger.Info("relayer launched", zap.Any("channels", channels))
+
+	return resp, nil
+}
+
+// RunIBCTransferLoad sends batches of IBC transfers in both directions over every channel until the
+// configured duration elapsed
+func (a *Activity) RunIBCTransferLoad(ctx context.Context, req messages.RunIBCTransferLoadRequest) (resp messages.RunIBCTransferLoadResponse, err error) {
+	logger, _ := zap.NewDevelopment()
+
+	duration, err := time.ParseDuration(req.Spec.Duration)
+	if err != nil {
+		return resp, temporal.NewApplicationErrorWithOptions("invalid ibc transfer load duration", err.Error(), temporal.ApplicationErrorOptions{NonRetryable: true})
+	}
+
+	p, err := a.restoreProvider(ctx, logger, req.RunnerType, req.ProviderState)
+	if err != nil {
+		return resp, err
+	}
+
+	task, err := p.DeserializeTask(ctx, req.RelayerState)
+	if err != nil {
+		return resp, temporal.NewApplicationErrorWithOptions("failed to restore relayer task", err.Error(), temporal.ApplicationErrorOptions{NonRetryable: true})
+	}
+
+	amount := req.Spec.Amount
+	if amount == 0 {
+		amount = defaultTransferAmount
+	}
+
+	msgsPerTx := req.Spec.MsgsPerTx
+	if msgsPerTx == 0 {
+		msgsPerTx = defaultTransferMsgsPerTx
+	}
+
+	logger.Info("running ibc transfer load", zap.Duration("duration", duration), zap.Uint64("amount", amount),
+		zap.Int("msgs_per_tx", msgsPerTx))
+
+	ticker := time.NewTicker(transferInterval)
+	defer ticker.Stop()
+
+	deadline := time.After(duration)
+	for {
+		sent, err := apps.RunIBCTransfers(ctx, task, req.Channels, req.Denoms, amount, msgsPerTx)
+		resp.TransfersSent += sent
+		if err != nil {
+			// a failed batch, e.g. because of a sequence mismatch, doesn't end the load
+			logger.Error("failed to send ibc transfers", zap.Error(err))
+		}
+
+		activity.RecordHeartbeat(ctx, resp.TransfersSent)
+
+		select {
+		case <-ctx.Done():
+			return resp, ctx.Err()
+		case <-deadline:
+			logger.Info("ibc transfer load completed", zap.Int("transfers_sent", resp.TransfersSent))
+			return resp, nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// restoreRelayerChain restores a chain and returns its relayer configuration and native denom. Packets
+// are relayed with the first validator's wallet and transfers are sent from the faucet
+func restoreRelayerChain(ctx context.Context, logger *zap.Logger, p provider.ProviderI,
+	state messages.RelayerChainState,
+) (apps.RelayerChain, string, error) {
+	decompressedChainState, err := util.DecompressData(state.ChainState)
+	if err != nil {
+		return apps.RelayerChain{}, "", fmt.Errorf("failed to decompress chain state: %w", err)
+	}
+
+	chain, err := testnet.RestoreChain(ctx, logger, p, decompressedChainState)
+	if err != nil {
+		return apps.RelayerChain{}, "", err
+	}
+
+	config := chain.GetConfig()
+
+	if len(chain.GetValidators()) == 0 || len(chain.GetValidatorWallets()) == 0 {
+		return apps.RelayerChain{}, "", fmt.Errorf("chain %s has no validators", config.ChainId)
+	}
+
+	ip, err := chain.GetValidators()[0].GetIP(ctx)
+	if err != nil {
+		return apps.RelayerChain{}, "", fmt.Errorf("failed to get validator ip of %s: %w", config.ChainId, err)
+	}
+
+	gasPrice, gasDenom := parseGasPrice(config)
+
+	relayerChain := apps.RelayerChain{
+		ChainID:         config.ChainId,
+		RPCAddress:      fmt.Sprintf("%s:26657", ip),
+		GRPCAddress:     fmt.Sprintf("%s:9090", ip),
+		Bech32Prefix:    config.Bech32Prefix,
+		GasPrice:        gasPrice,
+		GasDenom:        gasDenom,
+		IsEVMChain:      state.IsEvmChain,
+		RelayerMnemonic: chain.GetValidatorWallets()[0].Mnemonic(),
+	}
+
+	if faucet := chain.GetFaucetWallet(); faucet != nil {
+		relayerChain.TransferMnemonic = faucet.Mnemonic()
+	}
+
+	return relayerChain, config.Denom, nil
+}
+
+// parseGasPrice splits the chain's minimum gas price (e.g. 0.0005stake) into its amount and denom, falling back
+// to a zero gas price in the chain's denom
+func parseGasPrice(config petritypes.ChainConfig) (string, string) {
+	gasPrice, err := sdk.ParseDecCoin(config.GasPrices)
+	if err != nil {
+		return "0", config.Denom
+	}
+
+	return gasPrice.Amount.String(), gasPrice.Denom
+}
@@ -0,0 +1,151 @@
+package testnet
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/skip-mev/ironbird/petri/core/provider"
+	petrichain "github.com/skip-mev/ironbird/petri/cosmos/chain"
+	"github.com/skip-mev/ironbird/util"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+)
+
+// launchHeartbeatInterval is how often LaunchTestnet heartbeats while a step of the launch runs
+const launchHeartbeatInterval = 30 * time.Second
+
+// launchStep is the last completed step of a launch
+type launchStep int
+
+const (
+	launchNotStarted launchStep = iota
+	launchTasksCreated
+	launchNodesInitialized
+	launchGenesisGenerated
+	launchNodesConfigured
+	launchNodesStarted
+)
+
+func (s launchStep) String() string {
+	switch s {
+	case launchNotStarted:
+		return "not_started"
+	case launchTasksCreated:
+		return "tasks_created"
+	case launchNodesInitialized:
+		return "nodes_initialized"
+	case launchGenesisGenerated:
+		return "genesis_generated"
+	case launchNodesConfigured:
+		return "nodes_configured"
+	case launchNodesStarted:
+		return "nodes_started"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(s))
+	}
+}
+
+// launchCheckpoint is the progress of a launch, it's recorded in the heartbeat details of LaunchTestnet so that a
+// retried launch resumes after the last completed step. The states are compressed. The generated genesis can exceed
+// the size of heartbeat details, so it's kept on the first validator instead, see storeGenesis
+type launchCheckpoint struct {
+	Step          launchStep
+	ProviderState []byte
+	ChainState    []byte
+}
+
+// launchProgress records the checkpoints of a launch and heartbeats the last one
+type launchProgress struct {
+	mu         sync.Mutex
+	checkpoint launchCheckpoint
+}
+
+func (l *launchProgress) heartbeat(ctx context.Context) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	activity.RecordHeartbeat(ctx, l.checkpoint)
+}
+
+// keepAlive heartbeats the last checkpoint until ctx is done, so that a hung worker is detected by the heartbeat
+// timeout while a step runs
+func (l *launchProgress) keepAlive(ctx context.Context) {
+	ticker := time.NewTicker(launchHeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			l.heartbeat(ctx)
+		}
+	}
+}
+
+// complete checkpoints a completed step with the current provider and chain state
+func (l *launchProgress) complete(ctx context.Context, step launchStep, p provider.ProviderI,
+	chain *petrichain.Chain,
+) error {
+	providerState, err := p.SerializeProvider(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to serialize provider: %w", err)
+	}
+
+	chainState, err := chain.Serialize(ctx, p)
+	if err != nil {
+		return fmt.Errorf("failed to serialize chain: %w", err)
+	}
+
+	checkpoint := launchCheckpoint{Step: step}
+	if checkpoint.ProviderState, err = util.CompressData(providerState); err != nil {
+		return fmt.Errorf("failed to compress provider state: %w", err)
+	}
+	if checkpoint.ChainState, err = util.CompressData(chainState); err != nil {
+		return fmt.Errorf("failed to compress chain state: %w", err)
+	}
+	l.mu.Lock()
+	l.checkpoint = checkpoint
+	l.mu.Unlock()
+
+	l.heartbeat(ctx)
+	return nil
+}
+
+// storeGenesis writes the generated genesis to the first validator, where a retried launch reads it from until the
+// nodes are configured with it. Configuring the nodes writes the same genesis to every node
+func storeGenesis(ctx context.Context, chain *petrichain.Chain, genesis []byte) error {
+	validators := chain.GetValidators()
+	if len(validators) == 0 {
+		return fmt.Errorf("chain has no validator to store the genesis on")
+	}
+
+	return validators[0].OverwriteGenesisFile(ctx, genesis)
+}
+
+// storedGenesis reads the genesis written by storeGenesis
+func storedGenesis(ctx context.Context, chain *petrichain.Chain) ([]byte, error) {
+	validators := chain.GetValidators()
+	if len(validators) == 0 {
+		return nil, fmt.Errorf("chain has no validator to read the genesis from")
+	}
+
+	return validators[0].GenesisFileContent(ctx)
+}
+
+// launchFailed returns the error of a failed launch step. It's retryable, a retried launch resumes after the last
+// checkpoint. The compressed provider state is attached, so that the workflow can tear down the testnet once the
+// launch is not retried anymore
+func launchFailed(ctx context.Context, p provider.ProviderI, msg string, err error) error {
+	var details []interface{}
+	if providerState, serializeErr := p.SerializeProvider(ctx); serializeErr == nil {
+		if compressedProviderState, compressErr := util.CompressData(providerState); compressErr == nil {
+			details = append(details, compressedProviderState)
+		}
+	}
+
+	return temporal.NewApplicationErrorWithOptions(msg, err.Error(), temporal.ApplicationErrorOptions{
+		Details: details,
+	})
+}
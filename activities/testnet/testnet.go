@@ -2,11 +2,12 @@ package testnet
 
 import (
 	"context"
-	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"math/big"
-	"strings"
+	"path"
+	"slices"
+	"strconv"
 	"time"
 
 	pb "github.com/skip-mev/ironbird/server/proto"
@@ -31,6 +32,13 @@ import (
 	"go.uber.org/zap"
 )
 
+const (
+	// coverProfileArtifact is the artifact name of the merged coverage profile of an instrumented chain
+	coverProfileArtifact = "coverage.out"
+	// nodeHealthTimeout is how long a health check waits for a node to report its height
+	nodeHealthTimeout = 30 * time.Second
+)
+
 type Activity struct {
 	DOToken           string
 	TailscaleSettings digitalocean.TailscaleSettings
@@ -39,57 +47,79 @@ type Activity struct {
 	GrafanaConfig     types.GrafanaConfig
 	GRPCClient        pb.IronbirdServiceClient
 	AwsConfig         *aws.Config
-	RegistryType      string
+	Registry          types.RegistryConfig
 }
 
-// convertECRTokenToDockerAuth converts an ECR authorization token to Docker API RegistryAuth format
-func convertECRTokenToDockerAuth(ecrToken string) (string, error) {
-	decodedToken, err := base64.StdEncoding.DecodeString(ecrToken)
+// dockerAuth returns the registry auth used by nodes to pull chain images, or an empty string if the
+// registry does not require auth
+func (a *Activity) dockerAuth(ctx context.Context, logger *zap.Logger) string {
+	credentials, err := util.GetRegistryCredentials(ctx, a.Registry, a.AwsConfig)
 	if err != nil {
-		return "", fmt.Errorf("failed to decode ECR token: %w", err)
+		logger.Error("Failed to get registry credentials", zap.Error(err))
+		return ""
 	}
 
-	parts := strings.Split(string(decodedToken), ":")
-	if len(parts) != 2 {
-		return "", fmt.Errorf("invalid ECR token format")
+	dockerAuth, err := credentials.DockerAuth(a.Registry.URL)
+	if err != nil {
+		logger.Error("Failed to convert registry credentials to Docker auth format", zap.Error(err))
 	}
 
-	authConfig := map[string]string{
-		"username": parts[0],
-		"password": parts[1],
-	}
+	return dockerAuth
+}
 
-	authJSON, err := json.Marshal(authConfig)
+// evmGenesisDelegation is the unscaled self-delegation of evm chain validators
+const evmGenesisDelegation = "10000000000000000000000000"
+
+// WalletConfig returns the wallet config of a chain, keys are derived with the chain's signing algorithm and coin
+// type and addresses are formatted with its bech32 prefix
+func WalletConfig(config petritypes.ChainConfig) (petritypes.WalletConfig, error) {
+	coinType, err := strconv.ParseUint(config.CoinType, 10, 32)
 	if err != nil {
-		return "", fmt.Errorf("failed to marshal auth config: %w", err)
+		return petritypes.WalletConfig{}, fmt.Errorf("invalid coin type %s: %w", config.CoinType, err)
+	}
+
+	signingAlgorithm := config.SigningAlgorithm
+	if signingAlgorithm == "" {
+		signingAlgorithm = petritypes.Secp256k1
+		if config.IsEVMChain {
+			signingAlgorithm = petritypes.EthSecp256k1
+		}
+	}
+
+	walletConfig := petritypes.WalletConfig{
+		SigningAlgorithm: signingAlgorithm,
+		Bech32Prefix:     config.Bech32Prefix,
+		HDPath:           hd.CreateHDPath(uint32(coinType), 0, 0),
 	}
 
-	return base64.StdEncoding.EncodeToString(authJSON), nil
+	switch signingAlgorithm {
+	case petritypes.Secp256k1:
+		walletConfig.DerivationFn = hd.Secp256k1.Derive()
+		walletConfig.GenerationFn = hd.Secp256k1.Generate()
+	case petritypes.EthSecp256k1:
+		walletConfig.DerivationFn = evmhd.EthSecp256k1.Derive()
+		walletConfig.GenerationFn = evmhd.EthSecp256k1.Generate()
+	default:
+		return petritypes.WalletConfig{}, fmt.Errorf("unsupported signing algorithm %s", signingAlgorithm)
+	}
+
+	return walletConfig, nil
 }
 
-var (
-	CosmosWalletConfig = petritypes.WalletConfig{
-		SigningAlgorithm: "secp256k1",
-		Bech32Prefix:     "cosmos",
-		HDPath:           hd.CreateHDPath(118, 0, 0),
-		DerivationFn:     hd.Secp256k1.Derive(),
-		GenerationFn:     hd.Secp256k1.Generate(),
-	}
-	EvmCosmosWalletConfig = petritypes.WalletConfig{
-		SigningAlgorithm: "eth_secp256k1",
-		Bech32Prefix:     "cosmos",
-		HDPath:           hd.CreateHDPath(60, 0, 0),
-		DerivationFn:     evmhd.EthSecp256k1.Derive(),
-		GenerationFn:     evmhd.EthSecp256k1.Generate(),
+// RestoreChain restores a chain from its (decompressed) state with the wallet config of the chain's profile
+func RestoreChain(ctx context.Context, logger *zap.Logger, p provider.ProviderI, state []byte) (*petrichain.Chain, error) {
+	var packagedState petrichain.PackagedState
+	if err := json.Unmarshal(state, &packagedState); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal chain state: %w", err)
 	}
-)
 
-const (
-	cosmosDenom       = "stake"
-	evmDenom          = "atest"
-	cosmosDecimals    = 6
-	DefaultEvmChainID = "262144"
-)
+	walletConfig, err := WalletConfig(packagedState.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	return petrichain.RestoreChain(ctx, logger, p, state, node.RestoreNode, walletConfig)
+}
 
 func (a *Activity) CreateProvider(ctx context.Context, req messages.CreateProviderRequest) (messages.CreateProviderResponse, error) {
 	logger, _ := zap.NewDevelopment()
@@ -98,10 +128,11 @@ func (a *Activity) CreateProvider(ctx context.Context, req messages.CreateProvid
 	var err error
 
 	if req.RunnerType == messages.Docker {
-		p, err = docker.CreateProvider(ctx, logger, req.Name)
+		p, err = docker.CreateProvider(ctx, logger, req.Name, docker.WithExpiry(req.ExpiresAt))
 	} else {
 		p, err = digitalocean.NewProvider(ctx, req.Name, a.DOToken, a.TailscaleSettings,
-			digitalocean.WithLogger(logger), digitalocean.WithTelemetry(a.TelemetrySettings))
+			digitalocean.WithLogger(logger), digitalocean.WithTelemetry(a.TelemetrySettings),
+			digitalocean.WithExpiry(req.ExpiresAt))
 	}
 
 	if err != nil {
@@ -137,24 +168,81 @@ func (a *Activity) TeardownProvider(ctx context.Context, req messages.TeardownPr
 	return messages.TeardownProviderResponse{}, err
 }
 
-func (a *Activity) updateWorkflowData(ctx context.Context, workflowID string, nodes []*pb.Node, validators []*pb.Node, chainID string, startTime time.Time, provider string, logger *zap.Logger) {
+// SaveProviderState saves the provider state of a workflow to the server, so that its testnet can be torn down with
+// ForceTeardown when the workflow can no longer tear it down itself
+func (a *Activity) SaveProviderState(ctx context.Context, req messages.SaveProviderStateRequest) (messages.SaveProviderStateResponse, error) {
+	if a.GRPCClient == nil {
+		logger, _ := zap.NewDevelopment()
+		logger.Warn("GRPCClient is nil, skipping provider state save")
+		return messages.SaveProviderStateResponse{}, nil
+	}
+
+	if _, err := a.GRPCClient.SaveProviderState(ctx, &pb.SaveProviderStateRequest{
+		WorkflowId:    req.WorkflowID,
+		RunnerType:    string(req.RunnerType),
+		ProviderState: req.ProviderState,
+	}); err != nil {
+		return messages.SaveProviderStateResponse{}, fmt.Errorf("failed to save provider state: %w", err)
+	}
+
+	return messages.SaveProviderStateResponse{}, nil
+}
+
+// SetExpiry stamps the resources of the provider with a new expiry
+func (a *Activity) SetExpiry(ctx context.Context, req messages.SetExpiryRequest) (resp messages.SetExpiryResponse, err error) {
+	logger, _ := zap.NewDevelopment()
+
+	decompressedProviderState, err := util.DecompressData(req.ProviderState)
+	if err != nil {
+		return resp, fmt.Errorf("failed to decompress provider state: %w", err)
+	}
+
+	p, err := util.RestoreProvider(ctx, logger, req.RunnerType, decompressedProviderState, util.ProviderOptions{
+		DOToken: a.DOToken, TailscaleSettings: a.TailscaleSettings, TelemetrySettings: a.TelemetrySettings,
+	})
+	if err != nil {
+		return resp, fmt.Errorf("failed to restore provider: %w", err)
+	}
+
+	logger.Info("setting provider expiry", zap.String("provider", p.GetName()), zap.Time("expires_at", req.ExpiresAt))
+
+	if err := p.SetExpiry(ctx, req.ExpiresAt); err != nil {
+		return resp, fmt.Errorf("failed to set expiry: %w", err)
+	}
+
+	providerState, err := p.SerializeProvider(ctx)
+	if err != nil {
+		return resp, fmt.Errorf("failed to serialize provider: %w", err)
+	}
+
+	resp.ProviderState, err = util.CompressData(providerState)
+	if err != nil {
+		return resp, fmt.Errorf("failed to compress provider state: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (a *Activity) updateWorkflowData(ctx context.Context, workflowID string, nodes []*pb.Node, validators []*pb.Node, chainID string, startTime time.Time, provider string, withMonitoring bool, logger *zap.Logger) {
 	if a.GRPCClient == nil {
 		logger.Warn("GRPCClient is nil, skipping workflow data update")
 		return
 	}
 
-	monitoringLinks := types.GenerateMonitoringLinks(chainID, startTime, nil, provider, a.GrafanaConfig)
-	logger.Info("monitoring links", zap.String("chainID", chainID),
-		zap.Any("monitoringLinks", monitoringLinks))
-
 	updateReq := &pb.UpdateWorkflowDataRequest{
 		WorkflowId: workflowID,
 		Nodes:      nodes,
 		Validators: validators,
-		Monitoring: monitoringLinks,
 		Provider:   provider,
 	}
 
+	if withMonitoring {
+		monitoringLinks := types.GenerateMonitoringLinks(chainID, startTime, nil, provider, a.GrafanaConfig)
+		logger.Info("monitoring links", zap.String("chainID", chainID),
+			zap.Any("monitoringLinks", monitoringLinks))
+		updateReq.Monitoring = monitoringLinks
+	}
+
 	_, err := a.GRPCClient.UpdateWorkflowData(ctx, updateReq)
 	if err != nil {
 		logger.Error("Failed to update workflow data", zap.Error(err))
@@ -163,104 +251,187 @@ func (a *Activity) updateWorkflowData(ctx context.Context, workflowID string, no
 	}
 }
 
+// nodeOptions returns the options nodes are created with, i.e. with the registry credentials and the provider
+// specific config of the request
+func (a *Activity) nodeOptions(ctx context.Context, logger *zap.Logger, providerSpecificConfig map[string]string) petritypes.NodeOptions {
+	dockerAuth := a.dockerAuth(ctx, logger)
+
+	return petritypes.NodeOptions{
+		NodeDefinitionModifier: func(definition provider.TaskDefinition, config petritypes.NodeConfig) provider.TaskDefinition {
+			if definition.ProviderSpecificConfig == nil {
+				definition.ProviderSpecificConfig = make(map[string]string)
+			}
+			if dockerAuth != "" {
+				definition.ProviderSpecificConfig[provider.RegistryAuthKey] = dockerAuth
+			}
+			for k, v := range providerSpecificConfig {
+				definition.ProviderSpecificConfig[k] = v
+			}
+			return definition
+		},
+	}
+}
+
+// LaunchTestnet creates, initializes and starts a chain in checkpointed steps. A retried launch resumes after the
+// last step the previous attempt completed instead of creating the chain's tasks again
 func (a *Activity) LaunchTestnet(ctx context.Context, req messages.LaunchTestnetRequest) (resp messages.LaunchTestnetResponse, err error) {
 	logger, _ := zap.NewDevelopment()
 
 	workflowID := activity.GetInfo(ctx).WorkflowExecution.ID
 	startTime := time.Now()
 
-	p, err := util.RestoreProvider(ctx, logger, req.RunnerType, req.ProviderState, util.ProviderOptions{
+	progress := &launchProgress{}
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &progress.checkpoint); err != nil {
+			return resp, temporal.NewApplicationErrorWithOptions("failed to get launch checkpoint", err.Error(), temporal.ApplicationErrorOptions{NonRetryable: true})
+		}
+	}
+	resumedStep := progress.checkpoint.Step
+
+	providerState := req.ProviderState
+	if resumedStep != launchNotStarted {
+		logger.Info("resuming launch", zap.Stringer("completed_step", resumedStep))
+		providerState, err = util.DecompressData(progress.checkpoint.ProviderState)
+		if err != nil {
+			return resp, temporal.NewApplicationErrorWithOptions("failed to decompress provider state", err.Error(), temporal.ApplicationErrorOptions{NonRetryable: true})
+		}
+	}
+
+	p, err := util.RestoreProvider(ctx, logger, req.RunnerType, providerState, util.ProviderOptions{
 		DOToken: a.DOToken, TailscaleSettings: a.TailscaleSettings, TelemetrySettings: a.TelemetrySettings,
 	})
 	if err != nil {
 		return
 	}
 
-	nodeOptions := petritypes.NodeOptions{}
+	heartbeatCtx, stopHeartbeat := context.WithCancel(ctx)
+	defer stopHeartbeat()
+	go progress.keepAlive(heartbeatCtx)
+
+	nodeOptions := a.nodeOptions(ctx, logger, req.ProviderSpecificConfig)
 
-	var dockerAuth string
-	if a.RegistryType == "ecr" && a.AwsConfig != nil {
-		token, err := util.FetchDockerRepoToken(ctx, *a.AwsConfig)
+	var customGenesis []byte
+	var validatorKeys [][]byte
+	if req.CustomGenesis != nil {
+		logger.Info("fetching custom genesis", zap.String("url", req.CustomGenesis.GenesisURL))
+		customGenesis, validatorKeys, err = fetchCustomGenesis(ctx, *req.CustomGenesis)
 		if err != nil {
-			logger.Error("Failed to fetch docker repo token", zap.Error(err))
-		} else {
-			dockerAuth, err = convertECRTokenToDockerAuth(token)
-			if err != nil {
-				logger.Error("Failed to convert ECR token to Docker auth format", zap.Error(err))
-			}
+			return resp, temporal.NewApplicationErrorWithOptions("failed to fetch custom genesis", err.Error(), temporal.ApplicationErrorOptions{NonRetryable: true})
 		}
 	}
 
-	nodeOptions.NodeDefinitionModifier = func(definition provider.TaskDefinition, config petritypes.NodeConfig) provider.TaskDefinition {
-		if definition.ProviderSpecificConfig == nil {
-			definition.ProviderSpecificConfig = make(map[string]string)
-		}
-		if dockerAuth != "" {
-			definition.ProviderSpecificConfig["docker_auth"] = dockerAuth
-		}
-		for k, v := range req.ProviderSpecificConfig {
-			definition.ProviderSpecificConfig[k] = v
-		}
-		return definition
+	chainConfig, walletConfig, err := constructChainConfig(req, a.Chains)
+	if err != nil {
+		return resp, temporal.NewApplicationErrorWithOptions("invalid chain image config", err.Error(), temporal.ApplicationErrorOptions{NonRetryable: true})
+	}
+
+	chainOptions := petritypes.ChainOptions{
+		ModifyGenesis:      petrichain.ModifyGenesis(req.GenesisModifications),
+		NodeCreator:        node.CreateNode,
+		WalletConfig:       walletConfig,
+		NodeOptions:        nodeOptions,
+		BaseMnemonic:       req.BaseMnemonic,
+		AdditionalAccounts: req.NumWallets,
+		CustomGenesis:      customGenesis,
+		ValidatorKeys:      validatorKeys,
 	}
 
-	chainConfig, walletConfig := constructChainConfig(req, a.Chains)
-	logger.Info("creating chain", zap.Any("chain_config", chainConfig))
-	chain, chainErr := petrichain.CreateChain(
-		ctx, logger, p, chainConfig,
-		petritypes.ChainOptions{
+	var chain *petrichain.Chain
+	if resumedStep == launchNotStarted {
+		logger.Info("creating chain", zap.Any("chain_config", chainConfig))
+		// the tasks of a chain that could not be created are destroyed, so creating it can be retried
+		chain, err = petrichain.CreateChain(ctx, logger, p, chainConfig, petritypes.ChainOptions{
 			NodeCreator:  node.CreateNode,
 			NodeOptions:  nodeOptions,
 			WalletConfig: walletConfig,
-		},
-	)
-
-	if chainErr != nil {
-		providerState, serializeErr := p.SerializeProvider(ctx)
-		if serializeErr != nil {
-			return resp, temporal.NewApplicationErrorWithOptions("failed to serialize provider", serializeErr.Error(), temporal.ApplicationErrorOptions{NonRetryable: true})
+		})
+		if err != nil {
+			return resp, launchFailed(ctx, p, "failed to create chain", err)
 		}
 
-		compressedProviderState, compressErr := util.CompressData(providerState)
-		if compressErr != nil {
-			return resp, temporal.NewApplicationErrorWithOptions("failed to compress provider state", compressErr.Error(), temporal.ApplicationErrorOptions{NonRetryable: true})
+		if err := progress.complete(ctx, launchTasksCreated, p, chain); err != nil {
+			return resp, launchFailed(ctx, p, "failed to checkpoint launch", err)
+		}
+	} else {
+		chainState, err := util.DecompressData(progress.checkpoint.ChainState)
+		if err != nil {
+			return resp, temporal.NewApplicationErrorWithOptions("failed to decompress chain state", err.Error(), temporal.ApplicationErrorOptions{NonRetryable: true})
 		}
-		resp.ProviderState = compressedProviderState
 
-		return resp, temporal.NewApplicationErrorWithOptions("failed to create chain", chainErr.Error(), temporal.ApplicationErrorOptions{NonRetryable: true})
+		chain, err = RestoreChain(ctx, logger, p, chainState)
+		if err != nil {
+			return resp, launchFailed(ctx, p, "failed to restore chain", err)
+		}
 	}
 
 	resp.ChainID = chainConfig.ChainId
 
-	initErr := chain.Init(ctx, petritypes.ChainOptions{
-		ModifyGenesis:      petrichain.ModifyGenesis(req.GenesisModifications),
-		NodeCreator:        node.CreateNode,
-		WalletConfig:       walletConfig,
-		NodeOptions:        nodeOptions,
-		BaseMnemonic:       req.BaseMnemonic,
-		AdditionalAccounts: req.NumWallets,
-	})
-	if initErr != nil {
-		providerState, serializeErr := p.SerializeProvider(ctx)
-		if serializeErr != nil {
-			return resp, temporal.NewApplicationErrorWithOptions("failed to serialize provider", serializeErr.Error(), temporal.ApplicationErrorOptions{NonRetryable: true})
+	if progress.checkpoint.Step < launchNodesInitialized {
+		// the previous attempt may have initialized some of the nodes before it failed
+		if resumedStep == launchTasksCreated {
+			if err := chain.ResetNodes(ctx); err != nil {
+				return resp, launchFailed(ctx, p, "failed to reset nodes", err)
+			}
+		}
+
+		if err := chain.InitNodes(ctx, chainOptions); err != nil {
+			return resp, launchFailed(ctx, p, "failed to init nodes", err)
+		}
+
+		if err := progress.complete(ctx, launchNodesInitialized, p, chain); err != nil {
+			return resp, launchFailed(ctx, p, "failed to checkpoint launch", err)
+		}
+	}
+
+	var genesis []byte
+	if progress.checkpoint.Step < launchGenesisGenerated {
+		genesis, err = chain.GenerateGenesis(ctx, chainOptions)
+		if err != nil {
+			return resp, launchFailed(ctx, p, "failed to generate genesis", err)
+		}
+
+		if err := storeGenesis(ctx, chain, genesis); err != nil {
+			return resp, launchFailed(ctx, p, "failed to store genesis", err)
+		}
+
+		if err := progress.complete(ctx, launchGenesisGenerated, p, chain); err != nil {
+			return resp, launchFailed(ctx, p, "failed to checkpoint launch", err)
+		}
+	}
+
+	if progress.checkpoint.Step < launchNodesConfigured {
+		if genesis == nil {
+			genesis, err = storedGenesis(ctx, chain)
+			if err != nil {
+				return resp, launchFailed(ctx, p, "failed to read stored genesis", err)
+			}
+		}
+
+		if err := chain.Configure(ctx, genesis); err != nil {
+			return resp, launchFailed(ctx, p, "failed to configure nodes", err)
+		}
+
+		if err := progress.complete(ctx, launchNodesConfigured, p, chain); err != nil {
+			return resp, launchFailed(ctx, p, "failed to checkpoint launch", err)
 		}
+	}
 
-		compressedProviderState, compressErr := util.CompressData(providerState)
-		if compressErr != nil {
-			return resp, temporal.NewApplicationErrorWithOptions("failed to compress provider state", compressErr.Error(), temporal.ApplicationErrorOptions{NonRetryable: true})
+	if progress.checkpoint.Step < launchNodesStarted {
+		if err := chain.Start(ctx); err != nil {
+			return resp, launchFailed(ctx, p, "failed to start nodes", err)
 		}
-		resp.ProviderState = compressedProviderState
 
-		return resp, temporal.NewApplicationErrorWithOptions("failed to init chain", initErr.Error(), temporal.ApplicationErrorOptions{NonRetryable: true})
+		if err := progress.complete(ctx, launchNodesStarted, p, chain); err != nil {
+			return resp, launchFailed(ctx, p, "failed to checkpoint launch", err)
+		}
 	}
 
 	err = chain.WaitForStartup(ctx)
 	if err != nil {
-		return resp, temporal.NewApplicationErrorWithOptions("failed to wait for chain startup", err.Error(), temporal.ApplicationErrorOptions{NonRetryable: true})
+		return resp, launchFailed(ctx, p, "failed to wait for chain startup", err)
 	}
 
-	providerState, err := p.SerializeProvider(ctx)
+	providerState, err = p.SerializeProvider(ctx)
 	if err != nil {
 		return resp, temporal.NewApplicationErrorWithOptions("failed to serialize provider", err.Error(), temporal.ApplicationErrorOptions{NonRetryable: true})
 	}
@@ -282,48 +453,435 @@ func (a *Activity) LaunchTestnet(ctx context.Context, req messages.LaunchTestnet
 	}
 	resp.ChainState = compressedChainState
 
-	testnetValidators := make([]*pb.Node, 0, len(chain.GetValidators()))
-	testnetNodes := make([]*pb.Node, 0, len(chain.GetNodes()))
+	testnetNodes, testnetValidators, err := getChainExternalAddresses(ctx, chain, req.IsEvmChain)
+	if err != nil {
+		return resp, launchFailed(ctx, p, "failed to get node addresses", err)
+	}
+
+	resp.Nodes = testnetNodes
+	resp.Validators = testnetValidators
 
-	for _, validator := range chain.GetValidators() {
-		validatorInfo, err := getNodeExternalAddresses(ctx, validator, req.IsEvmChain)
+	if a.GRPCClient != nil {
+		// monitoring links keep pointing at the primary chain when additional chains are launched
+		isPrimaryChain := len(req.ExistingValidators) == 0
+		a.updateWorkflowData(ctx, workflowID, append(req.ExistingNodes, testnetNodes...),
+			append(req.ExistingValidators, testnetValidators...), chainConfig.ChainId, startTime, p.GetName(), isPrimaryChain, logger)
+	}
+
+	return resp, nil
+}
+
+// fetchCustomGenesis downloads the genesis and the optional validator keys referenced by a custom genesis spec
+func fetchCustomGenesis(ctx context.Context, spec messages.CustomGenesisSpec) ([]byte, [][]byte, error) {
+	genesis, err := util.FetchArtifact(ctx, spec.GenesisURL, spec.GenesisSHA256)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch genesis: %w", err)
+	}
+
+	if spec.ValidatorKeysURL == "" {
+		return genesis, nil, nil
+	}
+
+	keysBz, err := util.FetchArtifact(ctx, spec.ValidatorKeysURL, spec.ValidatorKeysSHA256)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch validator keys: %w", err)
+	}
+
+	var keys []json.RawMessage
+	if err := json.Unmarshal(keysBz, &keys); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal validator keys: %w", err)
+	}
+
+	validatorKeys := make([][]byte, len(keys))
+	for i, key := range keys {
+		validatorKeys[i] = key
+	}
+
+	return genesis, validatorKeys, nil
+}
+
+func (a *Activity) MigrateGenesis(ctx context.Context, req messages.MigrateGenesisRequest) (resp messages.MigrateGenesisResponse, err error) {
+	logger, _ := zap.NewDevelopment()
+
+	decompressedProviderState, err := util.DecompressData(req.ProviderState)
+	if err != nil {
+		return resp, fmt.Errorf("failed to decompress provider state: %w", err)
+	}
+
+	p, err := util.RestoreProvider(ctx, logger, req.RunnerType, decompressedProviderState, util.ProviderOptions{
+		DOToken: a.DOToken, TailscaleSettings: a.TailscaleSettings, TelemetrySettings: a.TelemetrySettings,
+	})
+	if err != nil {
+		return resp, fmt.Errorf("failed to restore provider: %w", err)
+	}
+
+	decompressedChainState, err := util.DecompressData(req.ChainState)
+	if err != nil {
+		return resp, fmt.Errorf("failed to decompress chain state: %w", err)
+	}
+
+	chain, err := RestoreChain(ctx, logger, p, decompressedChainState)
+	if err != nil {
+		return resp, temporal.NewApplicationErrorWithOptions("failed to restore chain", err.Error(), temporal.ApplicationErrorOptions{NonRetryable: true})
+	}
+
+	opts := petrichain.GenesisMigrationOptions{
+		ExportHeight:     req.ExportHeight,
+		MigrationCommand: req.MigrationCommand,
+		ModifyGenesis:    petrichain.ModifyGenesis(req.GenesisModifications),
+	}
+
+	if req.Image != "" {
+		opts.NodeDefinitionModifier = imageModifier(req.Image, a.dockerAuth(ctx, logger))
+	}
+
+	logger.Info("migrating chain genesis", zap.Uint64("export_height", req.ExportHeight), zap.String("image", req.Image))
+	migrateErr := chain.MigrateGenesis(ctx, opts)
+
+	// tasks may have been recreated during the migration, so the provider state is attached to the error
+	// details if the migration failed so that the workflow can still tear everything down
+	providerState, err := p.SerializeProvider(ctx)
+	if err != nil {
+		return resp, temporal.NewApplicationErrorWithOptions("failed to serialize provider", err.Error(), temporal.ApplicationErrorOptions{NonRetryable: true})
+	}
+
+	resp.ProviderState, err = util.CompressData(providerState)
+	if err != nil {
+		return resp, temporal.NewApplicationErrorWithOptions("failed to compress provider state", err.Error(), temporal.ApplicationErrorOptions{NonRetryable: true})
+	}
+
+	if migrateErr != nil {
+		return resp, temporal.NewApplicationErrorWithOptions("failed to migrate genesis", migrateErr.Error(), temporal.ApplicationErrorOptions{
+			NonRetryable: true,
+			Details:      []interface{}{resp.ProviderState},
+		})
+	}
+
+	chainState, err := chain.Serialize(ctx, p)
+	if err != nil {
+		return resp, temporal.NewApplicationErrorWithOptions("failed to serialize chain", err.Error(), temporal.ApplicationErrorOptions{NonRetryable: true})
+	}
+
+	resp.ChainState, err = util.CompressData(chainState)
+	if err != nil {
+		return resp, temporal.NewApplicationErrorWithOptions("failed to compress chain state", err.Error(), temporal.ApplicationErrorOptions{NonRetryable: true})
+	}
+
+	// nodes restarted on a new image may have been recreated with new addresses
+	resp.Nodes, resp.Validators, err = getChainExternalAddresses(ctx, chain, req.IsEvmChain)
+	if err != nil {
+		return resp, temporal.NewApplicationErrorWithOptions("failed to get node addresses", err.Error(), temporal.ApplicationErrorOptions{
+			NonRetryable: true,
+			Details:      []interface{}{resp.ProviderState},
+		})
+	}
+
+	a.updateWorkflowData(ctx, activity.GetInfo(ctx).WorkflowExecution.ID,
+		messages.ReplaceNodes(req.ExistingNodes, resp.Nodes), messages.ReplaceNodes(req.ExistingValidators, resp.Validators),
+		"", time.Time{}, p.GetName(), false, logger)
+
+	return resp, nil
+}
+
+// imageModifier swaps the image of a node's task definition
+func imageModifier(image, dockerAuth string) petritypes.NodeDefinitionModifier {
+	return func(definition provider.TaskDefinition, _ petritypes.NodeConfig) provider.TaskDefinition {
+		definition.Image.Image = image
+		if definition.ProviderSpecificConfig == nil {
+			definition.ProviderSpecificConfig = make(map[string]string)
+		}
+		if dockerAuth != "" {
+			definition.ProviderSpecificConfig[provider.RegistryAuthKey] = dockerAuth
+		}
+		return definition
+	}
+}
+
+func (a *Activity) UpgradeChain(ctx context.Context, req messages.UpgradeChainRequest) (resp messages.UpgradeChainResponse, err error) {
+	logger, _ := zap.NewDevelopment()
+
+	decompressedProviderState, err := util.DecompressData(req.ProviderState)
+	if err != nil {
+		return resp, fmt.Errorf("failed to decompress provider state: %w", err)
+	}
+
+	p, err := util.RestoreProvider(ctx, logger, req.RunnerType, decompressedProviderState, util.ProviderOptions{
+		DOToken: a.DOToken, TailscaleSettings: a.TailscaleSettings, TelemetrySettings: a.TelemetrySettings,
+	})
+	if err != nil {
+		return resp, fmt.Errorf("failed to restore provider: %w", err)
+	}
+
+	decompressedChainState, err := util.DecompressData(req.ChainState)
+	if err != nil {
+		return resp, fmt.Errorf("failed to decompress chain state: %w", err)
+	}
+
+	chain, err := RestoreChain(ctx, logger, p, decompressedChainState)
+	if err != nil {
+		return resp, temporal.NewApplicationErrorWithOptions("failed to restore chain", err.Error(), temporal.ApplicationErrorOptions{NonRetryable: true})
+	}
+
+	var modifier petritypes.NodeDefinitionModifier
+	if req.Image != "" {
+		modifier = imageModifier(req.Image, a.dockerAuth(ctx, logger))
+	}
+
+	logger.Info("upgrading chain", zap.String("mode", string(req.Mode)), zap.String("image", req.Image),
+		zap.Uint64("halt_height", req.HaltHeight))
+
+	var upgradeErr error
+	switch req.Mode {
+	case messages.RollingRestart:
+		upgradeErr = chain.RollingRestart(ctx, modifier)
+	case messages.HaltHeightUpgrade:
+		upgradeErr = chain.HaltHeightUpgrade(ctx, req.HaltHeight, modifier)
+	default:
+		return resp, temporal.NewApplicationErrorWithOptions("invalid upgrade mode", string(req.Mode), temporal.ApplicationErrorOptions{NonRetryable: true})
+	}
+
+	// nodes may have been recreated during the upgrade, so the provider state is attached to the error
+	// details if the upgrade failed so that the workflow can still tear everything down
+	providerState, err := p.SerializeProvider(ctx)
+	if err != nil {
+		return resp, temporal.NewApplicationErrorWithOptions("failed to serialize provider", err.Error(), temporal.ApplicationErrorOptions{NonRetryable: true})
+	}
+
+	resp.ProviderState, err = util.CompressData(providerState)
+	if err != nil {
+		return resp, temporal.NewApplicationErrorWithOptions("failed to compress provider state", err.Error(), temporal.ApplicationErrorOptions{NonRetryable: true})
+	}
+
+	if upgradeErr != nil {
+		return resp, temporal.NewApplicationErrorWithOptions("failed to upgrade chain", upgradeErr.Error(), temporal.ApplicationErrorOptions{
+			NonRetryable: true,
+			Details:      []interface{}{resp.ProviderState},
+		})
+	}
+
+	chainState, err := chain.Serialize(ctx, p)
+	if err != nil {
+		return resp, temporal.NewApplicationErrorWithOptions("failed to serialize chain", err.Error(), temporal.ApplicationErrorOptions{NonRetryable: true})
+	}
+
+	resp.ChainState, err = util.CompressData(chainState)
+	if err != nil {
+		return resp, temporal.NewApplicationErrorWithOptions("failed to compress chain state", err.Error(), temporal.ApplicationErrorOptions{NonRetryable: true})
+	}
+
+	// nodes restarted on a new image may have been recreated with new addresses
+	resp.Nodes, resp.Validators, err = getChainExternalAddresses(ctx, chain, req.IsEvmChain)
+	if err != nil {
+		return resp, temporal.NewApplicationErrorWithOptions("failed to get node addresses", err.Error(), temporal.ApplicationErrorOptions{
+			NonRetryable: true,
+			Details:      []interface{}{resp.ProviderState},
+		})
+	}
+
+	a.updateWorkflowData(ctx, activity.GetInfo(ctx).WorkflowExecution.ID,
+		messages.ReplaceNodes(req.ExistingNodes, resp.Nodes), messages.ReplaceNodes(req.ExistingValidators, resp.Validators),
+		"", time.Time{}, p.GetName(), false, logger)
+
+	return resp, nil
+}
+
+// CheckHealth queries the height of every node of a running chain. Nodes that don't respond are reported in the
+// response instead of failing the activity
+func (a *Activity) CheckHealth(ctx context.Context, req messages.CheckHealthRequest) (resp messages.CheckHealthResponse, err error) {
+	logger, _ := zap.NewDevelopment()
+
+	decompressedProviderState, err := util.DecompressData(req.ProviderState)
+	if err != nil {
+		return resp, fmt.Errorf("failed to decompress provider state: %w", err)
+	}
+
+	p, err := util.RestoreProvider(ctx, logger, req.RunnerType, decompressedProviderState, util.ProviderOptions{
+		DOToken: a.DOToken, TailscaleSettings: a.TailscaleSettings, TelemetrySettings: a.TelemetrySettings,
+	})
+	if err != nil {
+		return resp, fmt.Errorf("failed to restore provider: %w", err)
+	}
+
+	decompressedChainState, err := util.DecompressData(req.ChainState)
+	if err != nil {
+		return resp, fmt.Errorf("failed to decompress chain state: %w", err)
+	}
+
+	chain, err := RestoreChain(ctx, logger, p, decompressedChainState)
+	if err != nil {
+		return resp, temporal.NewApplicationErrorWithOptions("failed to restore chain", err.Error(), temporal.ApplicationErrorOptions{NonRetryable: true})
+	}
+
+	for _, n := range slices.Concat(chain.GetValidators(), chain.GetNodes()) {
+		nodeCtx, cancel := context.WithTimeout(ctx, nodeHealthTimeout)
+		height, err := n.Height(nodeCtx)
+		cancel()
+
+		health := messages.NodeHealth{Name: n.GetDefinition().Name, Height: height}
 		if err != nil {
+			logger.Warn("node failed health check", zap.String("node", health.Name), zap.Error(err))
+			health.Error = err.Error()
+		}
+
+		resp.Height = max(resp.Height, height)
+		resp.Nodes = append(resp.Nodes, health)
+	}
+
+	return resp, nil
+}
+
+// InjectFault injects a fault into a running chain and waits for the chain to react to it
+func (a *Activity) InjectFault(ctx context.Context, req messages.InjectFaultRequest) (resp messages.InjectFaultResponse, err error) {
+	logger, _ := zap.NewDevelopment()
+
+	decompressedProviderState, err := util.DecompressData(req.ProviderState)
+	if err != nil {
+		return resp, fmt.Errorf("failed to decompress provider state: %w", err)
+	}
+
+	p, err := util.RestoreProvider(ctx, logger, req.RunnerType, decompressedProviderState, util.ProviderOptions{
+		DOToken: a.DOToken, TailscaleSettings: a.TailscaleSettings, TelemetrySettings: a.TelemetrySettings,
+	})
+	if err != nil {
+		return resp, fmt.Errorf("failed to restore provider: %w", err)
+	}
+
+	decompressedChainState, err := util.DecompressData(req.ChainState)
+	if err != nil {
+		return resp, fmt.Errorf("failed to decompress chain state: %w", err)
+	}
+
+	chain, err := RestoreChain(ctx, logger, p, decompressedChainState)
+	if err != nil {
+		return resp, temporal.NewApplicationErrorWithOptions("failed to restore chain", err.Error(), temporal.ApplicationErrorOptions{NonRetryable: true})
+	}
+
+	logger.Info("injecting fault", zap.String("type", string(req.Fault.Type)), zap.Int("validator", req.Fault.Validator))
+
+	faultCtx, cancel := context.WithTimeout(ctx, req.Fault.GetTimeout())
+	defer cancel()
+
+	var faultErr error
+	switch req.Fault.Type {
+	case messages.DoubleSignFault:
+		var result petrichain.DoubleSignResult
+		result, faultErr = chain.DoubleSign(faultCtx, p, petritypes.ChainOptions{
+			NodeCreator: node.CreateNode,
+			NodeOptions: a.nodeOptions(ctx, logger, req.ProviderSpecificConfig),
+		}, req.Fault.Validator)
+
+		resp.OperatorAddress = result.OperatorAddress
+		resp.Jailed, resp.Tombstoned = result.Jailed, result.Tombstoned
+		resp.TokensBefore, resp.TokensAfter = result.TokensBefore, result.TokensAfter
+	default:
+		return resp, temporal.NewApplicationErrorWithOptions("invalid fault type", string(req.Fault.Type), temporal.ApplicationErrorOptions{NonRetryable: true})
+	}
+
+	// faults may leave tasks behind if they fail, so the provider state is attached to the error details
+	providerState, err := p.SerializeProvider(ctx)
+	if err != nil {
+		return resp, temporal.NewApplicationErrorWithOptions("failed to serialize provider", err.Error(), temporal.ApplicationErrorOptions{NonRetryable: true})
+	}
+
+	resp.ProviderState, err = util.CompressData(providerState)
+	if err != nil {
+		return resp, temporal.NewApplicationErrorWithOptions("failed to compress provider state", err.Error(), temporal.ApplicationErrorOptions{NonRetryable: true})
+	}
+
+	if faultErr != nil {
+		return resp, temporal.NewApplicationErrorWithOptions("fault assertion failed", faultErr.Error(), temporal.ApplicationErrorOptions{
+			NonRetryable: true,
+			Details:      []interface{}{resp.ProviderState},
+		})
+	}
+
+	return resp, nil
+}
+
+// CollectInstrumentation stops the nodes of an instrumented chain and uploads their merged coverage profile and
+// race reports as artifacts of the workflow
+func (a *Activity) CollectInstrumentation(ctx context.Context, req messages.CollectInstrumentationRequest) (resp messages.CollectInstrumentationResponse, err error) {
+	logger, _ := zap.NewDevelopment()
+
+	decompressedProviderState, err := util.DecompressData(req.ProviderState)
+	if err != nil {
+		return resp, fmt.Errorf("failed to decompress provider state: %w", err)
+	}
+
+	p, err := util.RestoreProvider(ctx, logger, req.RunnerType, decompressedProviderState, util.ProviderOptions{
+		DOToken: a.DOToken, TailscaleSettings: a.TailscaleSettings, TelemetrySettings: a.TelemetrySettings,
+	})
+	if err != nil {
+		return resp, fmt.Errorf("failed to restore provider: %w", err)
+	}
+
+	decompressedChainState, err := util.DecompressData(req.ChainState)
+	if err != nil {
+		return resp, fmt.Errorf("failed to decompress chain state: %w", err)
+	}
+
+	chain, err := RestoreChain(ctx, logger, p, decompressedChainState)
+	if err != nil {
+		return resp, temporal.NewApplicationErrorWithOptions("failed to restore chain", err.Error(), temporal.ApplicationErrorOptions{NonRetryable: true})
+	}
+
+	report, err := chain.CollectInstrumentation(ctx)
+	if err != nil {
+		return resp, fmt.Errorf("failed to collect instrumentation: %w", err)
+	}
+
+	workflowID := activity.GetInfo(ctx).WorkflowExecution.ID
+
+	if len(report.CoverProfile) > 0 {
+		resp.Coverage = petrichain.CoverPercentage(report.CoverProfile)
+		logger.Info("collected coverage profile", zap.Float64("coverage", resp.Coverage))
+
+		if err := a.uploadArtifact(ctx, workflowID, coverProfileArtifact, report.CoverProfile); err != nil {
 			return resp, err
 		}
-		testnetValidators = append(testnetValidators, validatorInfo)
 	}
 
-	for _, node := range chain.GetNodes() {
-		nodeInfo, err := getNodeExternalAddresses(ctx, node, req.IsEvmChain)
-		if err != nil {
+	for _, raceReport := range report.RaceReports {
+		name := path.Join(petritypes.RaceReportDir, raceReport.Node, raceReport.Name)
+		logger.Warn("collected data race report", zap.String("node", raceReport.Node), zap.String("report", raceReport.Name))
+
+		if err := a.uploadArtifact(ctx, workflowID, name, raceReport.Report); err != nil {
 			return resp, err
 		}
-		testnetNodes = append(testnetNodes, nodeInfo)
+		resp.RaceReports = append(resp.RaceReports, name)
 	}
 
-	resp.Nodes = testnetNodes
-	resp.Validators = testnetValidators
+	return resp, nil
+}
 
-	if a.GRPCClient != nil {
-		a.updateWorkflowData(ctx, workflowID, testnetNodes, testnetValidators, chainConfig.ChainId, startTime, p.GetName(), logger)
+func (a *Activity) uploadArtifact(ctx context.Context, workflowID, name string, content []byte) error {
+	if a.GRPCClient == nil {
+		return fmt.Errorf("GRPCClient is nil, cannot upload artifact %s", name)
 	}
 
-	//go func() {
-	//	emitHeartbeats(ctx, chain, logger)
-	//}()
+	if _, err := a.GRPCClient.UploadWorkflowArtifact(ctx, &pb.UploadWorkflowArtifactRequest{
+		WorkflowId: workflowID,
+		Name:       name,
+		Content:    content,
+	}); err != nil {
+		return fmt.Errorf("failed to upload artifact %s: %w", name, err)
+	}
 
-	return resp, nil
+	return nil
 }
 
 func constructChainConfig(req messages.LaunchTestnetRequest,
 	chains types.Chains,
-) (petritypes.ChainConfig, petritypes.WalletConfig) {
-	chainImage := chains[req.BaseImage]
+) (petritypes.ChainConfig, petritypes.WalletConfig, error) {
+	chainImage, ok := chains[req.BaseImage]
+	if !ok {
+		return petritypes.ChainConfig{}, petritypes.WalletConfig{}, fmt.Errorf("chain image %s is not configured", req.BaseImage)
+	}
 
 	config := petritypes.ChainConfig{
 		Name:          req.Name,
-		Denom:         cosmosDenom,
-		Decimals:      cosmosDecimals,
+		Denom:         chainImage.Denom,
+		Decimals:      chainImage.GetDecimals(),
 		NumValidators: int(req.NumOfValidators),
 		NumNodes:      int(req.NumOfNodes),
 		BinaryName:    chainImage.BinaryName,
@@ -334,34 +892,35 @@ func constructChainConfig(req messages.LaunchTestnetRequest,
 			GID:   chainImage.GID,
 		},
 		GasPrices:             chainImage.GasPrices,
-		Bech32Prefix:          "cosmos",
+		Bech32Prefix:          chainImage.Bech32Prefix,
 		HomeDir:               chainImage.HomeDir,
-		CoinType:              "118",
+		CoinType:              chainImage.CoinType,
+		SigningAlgorithm:      chainImage.SigningAlgorithm,
 		ChainId:               req.Name,
 		UseGenesisSubCommand:  true,
+		AdditionalStartFlags:  chainImage.AdditionalStartFlags,
+		AdditionalPorts:       chainImage.AdditionalPorts,
 		CustomAppConfig:       req.CustomAppConfig,
 		CustomConsensusConfig: req.CustomConsensusConfig,
 		CustomClientConfig:    req.CustomClientConfig,
 		SetPersistentPeers:    req.SetPersistentPeers,
 		SetSeedNode:           req.SetSeedNode,
 		RegionConfig:          req.RegionConfigs,
+		ImageGroups:           req.ImageGroups,
+		ConfigOverrides:       req.ConfigOverrides,
+		StakeDistribution:     req.StakeDistribution,
+		Topology:              req.Topology,
+		RemoteSigner:          req.RemoteSigner,
+		Instrumented:          req.Instrumented,
 	}
-	walletConfig := CosmosWalletConfig
 
 	if req.IsEvmChain {
-		config.Denom = evmDenom
-		chainID := DefaultEvmChainID
+		if chainImage.EVMChainID == "" {
+			return petritypes.ChainConfig{}, petritypes.WalletConfig{}, fmt.Errorf("chain image %s has no evm chain id", req.BaseImage)
+		}
+
 		config.IsEVMChain = true
-		config.ChainId = chainID
-		config.CoinType = "60"
-		config.AdditionalStartFlags = []string{
-			"--json-rpc.api", "eth,net,web3,txpool,debug",
-			"--json-rpc.address", "0.0.0.0:8545",
-			"--json-rpc.ws-address", "0.0.0.0:8546",
-			"--json-rpc.enable",
-		}
-		config.AdditionalPorts = []string{"8545", "8546", "8100"} // geth rpc, geth ws rpc, evmd geth metrics
-		walletConfig = EvmCosmosWalletConfig
+		config.ChainId = chainImage.EVMChainID
 		if config.CustomAppConfig == nil {
 			config.CustomAppConfig = make(map[string]interface{})
 		}
@@ -369,17 +928,45 @@ func constructChainConfig(req messages.LaunchTestnetRequest,
 			config.CustomAppConfig["evm"] = make(map[string]interface{})
 		}
 		if evmConfig, ok := config.CustomAppConfig["evm"].(map[string]interface{}); ok {
-			evmConfig["evm-chain-id"] = chainID
+			evmConfig["evm-chain-id"] = chainImage.EVMChainID
 		}
 
 		deleg := new(big.Int)
-		deleg.SetString("10000000000000000000000000", 10)
+		deleg.SetString(evmGenesisDelegation, 10)
 		genBal := deleg.Mul(deleg, big.NewInt(int64(req.NumOfValidators+2)))
 		config.GenesisDelegation = deleg
 		config.GenesisBalance = genBal
 	}
 
-	return config, walletConfig
+	walletConfig, err := WalletConfig(config)
+	if err != nil {
+		return petritypes.ChainConfig{}, petritypes.WalletConfig{}, err
+	}
+
+	return config, walletConfig, nil
+}
+
+// getChainExternalAddresses returns the addresses of the nodes and validators of a chain
+func getChainExternalAddresses(ctx context.Context, chain *petrichain.Chain, isEvmChain bool) ([]*pb.Node, []*pb.Node, error) {
+	nodes := make([]*pb.Node, 0, len(chain.GetNodes()))
+	for _, node := range chain.GetNodes() {
+		nodeInfo, err := getNodeExternalAddresses(ctx, node, isEvmChain)
+		if err != nil {
+			return nil, nil, err
+		}
+		nodes = append(nodes, nodeInfo)
+	}
+
+	validators := make([]*pb.Node, 0, len(chain.GetValidators()))
+	for _, validator := range chain.GetValidators() {
+		validatorInfo, err := getNodeExternalAddresses(ctx, validator, isEvmChain)
+		if err != nil {
+			return nil, nil, err
+		}
+		validators = append(validators, validatorInfo)
+	}
+
+	return nodes, validators, nil
 }
 
 func getNodeExternalAddresses(ctx context.Context, nodeProvider petritypes.NodeI, isEvmChain bool) (*pb.Node, error) {
@@ -409,6 +996,7 @@ func getNodeExternalAddresses(ctx context.Context, nodeProvider petritypes.NodeI
 		Lcd:     fmt.Sprintf("http://%s", lcdIp),
 		Grpc:    grpcIp,
 		Address: ip,
+		Image:   nodeProvider.GetDefinition().Image.Image,
 	}
 
 	if isEvmChain {
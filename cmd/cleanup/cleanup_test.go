@@ -0,0 +1,206 @@
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/docker/docker/api/types/volume"
+	"github.com/skip-mev/ironbird/petri/core/provider"
+	"github.com/skip-mev/ironbird/petri/core/provider/digitalocean"
+	"github.com/skip-mev/ironbird/petri/core/provider/digitalocean/mocks"
+	"github.com/skip-mev/ironbird/petri/core/provider/docker"
+	"github.com/skip-mev/ironbird/server/db"
+	pb "github.com/skip-mev/ironbird/server/proto"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/enums/v1"
+	"go.uber.org/zap"
+)
+
+func TestLoadOwners(t *testing.T) {
+	logger := zap.NewNop()
+	database, err := db.NewSQLiteDB(filepath.Join(t.TempDir(), "ironbird.db"), logger)
+	require.NoError(t, err)
+	defer database.Close()
+	require.NoError(t, database.RunMigrations("../../migrations"))
+
+	for _, workflow := range []db.Workflow{
+		{WorkflowID: "running", Status: enums.WORKFLOW_EXECUTION_STATUS_RUNNING, Provider: "ib-running"},
+		{WorkflowID: "completed", Status: enums.WORKFLOW_EXECUTION_STATUS_COMPLETED, Provider: "ib-completed"},
+		{WorkflowID: "pending", Status: enums.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED},
+	} {
+		workflow.Nodes = []*pb.Node{}
+		workflow.Validators = []*pb.Node{}
+		workflow.MonitoringLinks = map[string]string{}
+		require.NoError(t, database.CreateWorkflow(&workflow))
+	}
+
+	o, err := loadOwners(database)
+	require.NoError(t, err)
+
+	require.Equal(t, map[string]string{"ib-running": "running"}, o.providers)
+	require.Equal(t, "pending", o.unattributedWorkflow)
+
+	require.Contains(t, o.reason("ib-running", time.Now().Add(-time.Hour)), "running")
+	require.Contains(t, o.reason("ib-completed", time.Now().Add(time.Hour)), "pending")
+	require.Contains(t, o.reason("ib-completed", time.Time{}), "pending")
+	require.Empty(t, o.reason("ib-completed", o.unattributedSince.Add(-time.Hour)))
+}
+
+func TestOpenOwners(t *testing.T) {
+	logger := zap.NewNop()
+
+	o, err := openOwners("", logger)
+	require.NoError(t, err)
+	require.Empty(t, o.reason("ib-any", time.Time{}))
+
+	path := filepath.Join(t.TempDir(), "ironbird.db")
+	_, err = openOwners(path, logger)
+	require.Error(t, err)
+	require.NoFileExists(t, path)
+}
+
+func TestResourceExpiry(t *testing.T) {
+	labeledAt := time.Unix(1700000000, 0)
+	extendedAt := labeledAt.Add(time.Hour)
+
+	labels := func(owner string, expiresAt time.Time) map[string]string {
+		return map[string]string{
+			docker.ProviderLabelName: owner,
+			docker.ExpiryLabelName:   provider.FormatExpiry(expiresAt),
+		}
+	}
+
+	record := labels("ib-extended", extendedAt)
+	record[docker.ExpiryRecordLabelName] = "true"
+	staleRecord := labels("ib-extended", labeledAt)
+	staleRecord[docker.ExpiryRecordLabelName] = "true"
+
+	records := expiryRecords([]*volume.Volume{
+		{Name: "ib-extended-expiry-1700003600", Labels: record},
+		{Name: "ib-extended-expiry-1700000000", Labels: staleRecord},
+		{Name: "ib-extended-validator-0", Labels: labels("ib-extended", labeledAt)},
+	})
+
+	require.True(t, resourceExpiry(labels("ib-extended", labeledAt), records).Equal(extendedAt))
+	require.True(t, resourceExpiry(labels("ib-other", labeledAt), records).Equal(labeledAt))
+	require.True(t, resourceExpiry(map[string]string{docker.ProviderLabelName: "ib-none"}, records).IsZero())
+}
+
+func testDroplet(id int, name, ip string, created time.Time, tags ...string) godo.Droplet {
+	return godo.Droplet{
+		ID:      id,
+		Name:    name,
+		Tags:    tags,
+		Created: created.Format(time.RFC3339),
+		Networks: &godo.Networks{
+			V4: []godo.NetworkV4{{IPAddress: ip, Type: "public"}},
+		},
+	}
+}
+
+func TestDoReaper(t *testing.T) {
+	ctx := context.Background()
+	old := time.Now().Add(-2 * time.Hour)
+	expired := digitalocean.ExpiryTag(time.Now().Add(-time.Hour))
+	leased := digitalocean.ExpiryTag(time.Now().Add(time.Hour))
+
+	setup := func(t *testing.T, dryRun bool) (*doReaper, *mocks.MockDoClient) {
+		client := mocks.NewMockDoClient(t)
+
+		client.On("ListDomainRecords", mock.Anything, "example.com", mock.Anything).Return([]godo.DomainRecord{
+			{ID: 1, Type: "A", Name: "chain-rpc", Data: "1.1.1.1"},
+			{ID: 2, Type: "A", Name: "chain-lcd", Data: "2.2.2.2"},
+			{ID: 3, Type: "A", Name: "www", Data: "9.9.9.9"},
+			{ID: 4, Type: "A", Name: "other-rpc", Data: "8.8.8.8"},
+			{ID: 5, Type: "A", Name: "fresh-rpc", Data: "3.3.3.3"},
+		}, nil)
+		client.On("ListDroplets", mock.Anything, mock.Anything).Return([]godo.Droplet{
+			testDroplet(10, "petri-ib-orphan-validator-0", "1.1.1.1", old),
+			testDroplet(11, "petri-ib-running-validator-0", "2.2.2.2", old),
+			testDroplet(12, "petri-ib-fresh-validator-0", "3.3.3.3", time.Now()),
+			testDroplet(13, "unrelated", "4.4.4.4", old),
+			testDroplet(14, "petri-ib-expired-validator-0", "5.5.5.5", old, "LONG_RUNNING", expired),
+			testDroplet(15, "petri-ib-leased-validator-0", "6.6.6.6", old, leased),
+		}, nil)
+		client.On("ListFirewalls", mock.Anything, mock.Anything).Return([]godo.Firewall{
+			{ID: "fw-orphan", Name: "petri-ib-orphan", Created: old.Format(time.RFC3339)},
+			{ID: "fw-running", Name: "petri-ib-running", Created: old.Format(time.RFC3339)},
+			{ID: "fw-unparsable", Name: "petri-ib-unparsable", Created: "yesterday"},
+		}, nil)
+		client.On("ListTags", mock.Anything, mock.Anything).Return([]godo.Tag{
+			{Name: "petri-ib-orphan"},
+			{Name: "petri-ib-running"},
+			{Name: "petri-ib-gone"},
+			{Name: "LONG_RUNNING"},
+			{Name: expired, Resources: &godo.TaggedResources{Count: 0}},
+			{Name: leased, Resources: &godo.TaggedResources{Count: 1}},
+		}, nil)
+		client.On("ListKeys", mock.Anything, mock.Anything).Return([]godo.Key{
+			{ID: 20, Name: "petri-ib-gone-key", Fingerprint: "fp-gone"},
+			{ID: 21, Name: "petri-ib-running-key", Fingerprint: "fp-running"},
+		}, nil)
+
+		return &doReaper{
+			reaper: &reaper{
+				logger: zap.NewNop(),
+				dryRun: dryRun,
+				prefix: "petri",
+				minAge: 30 * time.Minute,
+				now:    time.Now(),
+				owners: &owners{providers: map[string]string{"ib-running": "workflow-1"}},
+				report: NewReport(dryRun),
+			},
+			client:      client,
+			longRunning: "LONG_RUNNING",
+			rootDomain:  "example.com",
+		}, client
+	}
+
+	t.Run("deletes orphaned resources", func(t *testing.T) {
+		r, client := setup(t, false)
+
+		client.On("DeleteDropletByID", mock.Anything, 10).Return(nil).Once()
+		client.On("DeleteDropletByID", mock.Anything, 14).Return(nil).Once()
+		client.On("DeleteTag", mock.Anything, expired).Return(nil).Once()
+		client.On("DeleteFirewall", mock.Anything, "fw-orphan").Return(nil).Once()
+		client.On("DeleteTag", mock.Anything, "petri-ib-orphan").Return(nil).Once()
+		client.On("DeleteTag", mock.Anything, "petri-ib-gone").Return(nil).Once()
+		client.On("DeleteKeyByFingerprint", mock.Anything, "fp-gone").Return(nil).Once()
+		client.On("DeleteDomain", mock.Anything, "example.com", 1).Return(nil).Once()
+
+		remainingPrefixes, err := r.reap(ctx)
+		require.NoError(t, err)
+		require.Equal(t, map[string]bool{"petri-ib-running": true, "petri-ib-fresh": true, "petri-ib-leased": true},
+			remainingPrefixes)
+		require.Equal(t, 8, r.report.Summary[ActionDeleted])
+
+		for _, entry := range r.report.Resources {
+			switch entry.Name {
+			case "petri-ib-unparsable", "fresh-rpc.example.com":
+				require.Equal(t, ActionSkipped, entry.Action, entry.Name)
+			case "other-rpc.example.com":
+				require.Fail(t, "record not pointing at a petri droplet was reaped")
+			}
+		}
+	})
+
+	t.Run("dry run deletes nothing", func(t *testing.T) {
+		r, _ := setup(t, true)
+
+		_, err := r.reap(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 8, r.report.Summary[ActionWouldDelete])
+		require.Zero(t, r.report.Summary[ActionDeleted])
+
+		for _, entry := range r.report.Resources {
+			if entry.Owner == "ib-running" {
+				require.Equal(t, ActionSkipped, entry.Action, entry.Name)
+				require.NotEmpty(t, entry.Reason, entry.Name)
+			}
+		}
+	})
+}
@@ -0,0 +1,381 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/skip-mev/ironbird/messages"
+	"github.com/skip-mev/ironbird/petri/core/provider/digitalocean"
+	"go.uber.org/zap"
+)
+
+const listPageSize = 200
+
+type doReaper struct {
+	*reaper
+	client      digitalocean.DoClient
+	longRunning string
+	rootDomain  string
+}
+
+func listAll[T any](ctx context.Context, list func(context.Context, *godo.ListOptions) ([]T, error)) ([]T, error) {
+	opts := &godo.ListOptions{
+		Page:    1,
+		PerPage: listPageSize,
+	}
+
+	var all []T
+	for {
+		items, err := list(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, items...)
+
+		if len(items) < opts.PerPage {
+			return all, nil
+		}
+
+		opts.Page++
+	}
+}
+
+// reap deletes every orphaned DigitalOcean resource and returns the run prefixes that still have droplets
+func (r *doReaper) reap(ctx context.Context) (map[string]bool, error) {
+	// records are listed before droplets so that every record points at a droplet which is already listed
+	var records []godo.DomainRecord
+	if r.rootDomain != "" {
+		var err error
+		records, err = listAll(ctx, func(ctx context.Context, opts *godo.ListOptions) ([]godo.DomainRecord, error) {
+			return r.client.ListDomainRecords(ctx, r.rootDomain, opts)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list domain records: %w", err)
+		}
+	}
+
+	droplets, err := listAll(ctx, r.client.ListDroplets)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list droplets: %w", err)
+	}
+
+	logger := r.logger.With(zap.String("provider", "digitalocean"))
+	logger.Info("Retrieved droplets", zap.Int("total_count", len(droplets)))
+
+	skippedPrefixes := r.skippedDropletPrefixes(droplets)
+	remaining := r.reapDroplets(ctx, droplets, skippedPrefixes)
+
+	remainingPrefixes := make(map[string]bool)
+	for _, droplet := range remaining {
+		if r.isPetriResource(droplet.Name) {
+			remainingPrefixes[extractPrefix(droplet.Name)] = true
+		}
+	}
+
+	var errs []error
+	if err := r.reapFirewalls(ctx, skippedPrefixes); err != nil {
+		errs = append(errs, err)
+	}
+	if err := r.reapTags(ctx, skippedPrefixes, remainingPrefixes); err != nil {
+		errs = append(errs, err)
+	}
+	if err := r.reapKeys(ctx, skippedPrefixes, remainingPrefixes); err != nil {
+		errs = append(errs, err)
+	}
+	r.reapDomainRecords(ctx, records, droplets, remaining)
+
+	return remainingPrefixes, errors.Join(errs...)
+}
+
+// skippedDropletPrefixes returns the run prefixes whose resources must be kept along with the reason
+func (r *doReaper) skippedDropletPrefixes(droplets []godo.Droplet) map[string]string {
+	skippedPrefixes := make(map[string]string)
+
+	for _, droplet := range droplets {
+		if !r.isPetriResource(droplet.Name) {
+			continue
+		}
+
+		prefix := extractPrefix(droplet.Name)
+		if _, ok := skippedPrefixes[prefix]; ok {
+			continue
+		}
+
+		// the expiry of a droplet takes precedence over the long-running tag, which could otherwise keep it forever
+		expiresAt := dropletExpiry(droplet)
+		if expiresAt.IsZero() && slices.Contains(droplet.Tags, r.longRunning) {
+			skippedPrefixes[prefix] = fmt.Sprintf("droplet %s is tagged %s", droplet.Name, r.longRunning)
+			continue
+		}
+
+		createdAt, err := time.Parse(time.RFC3339, droplet.Created)
+		if err != nil {
+			r.logger.Error("Failed to parse droplet creation time, marking as skipped",
+				zap.String("name", droplet.Name),
+				zap.String("created_at", droplet.Created),
+				zap.Error(err))
+			skippedPrefixes[prefix] = fmt.Sprintf("failed to parse creation time of droplet %s", droplet.Name)
+			continue
+		}
+
+		if reason := r.protect(r.providerName(prefix), createdAt, expiresAt); reason != "" {
+			skippedPrefixes[prefix] = fmt.Sprintf("droplet %s is %s", droplet.Name, reason)
+		}
+	}
+
+	return skippedPrefixes
+}
+
+// dropletExpiry returns the latest expiry a droplet is tagged with, droplets are retagged when their expiry changes
+func dropletExpiry(droplet godo.Droplet) time.Time {
+	var expiresAt time.Time
+	for _, tag := range droplet.Tags {
+		if tagExpiry, ok := digitalocean.ParseExpiryTag(tag); ok && tagExpiry.After(expiresAt) {
+			expiresAt = tagExpiry
+		}
+	}
+	return expiresAt
+}
+
+// reapDroplets deletes petri droplets of unprotected runs and returns the droplets that are left
+func (r *doReaper) reapDroplets(ctx context.Context, droplets []godo.Droplet, skippedPrefixes map[string]string) []godo.Droplet {
+	var remaining []godo.Droplet
+
+	for _, droplet := range droplets {
+		if !r.isPetriResource(droplet.Name) {
+			remaining = append(remaining, droplet)
+			continue
+		}
+
+		prefix := extractPrefix(droplet.Name)
+		entry := ReportEntry{
+			Kind:  "droplet",
+			ID:    strconv.Itoa(droplet.ID),
+			Name:  droplet.Name,
+			Owner: r.providerName(prefix),
+		}
+
+		if reason, ok := skippedPrefixes[prefix]; ok {
+			r.skip(entry, reason)
+			remaining = append(remaining, droplet)
+			continue
+		}
+
+		if !r.delete(entry, func() error { return r.client.DeleteDropletByID(ctx, droplet.ID) }) {
+			remaining = append(remaining, droplet)
+		}
+	}
+
+	return remaining
+}
+
+func (r *doReaper) reapFirewalls(ctx context.Context, skippedPrefixes map[string]string) error {
+	firewalls, err := listAll(ctx, r.client.ListFirewalls)
+	if err != nil {
+		return fmt.Errorf("failed to list firewalls: %w", err)
+	}
+
+	for _, firewall := range firewalls {
+		if !r.isPetriResource(firewall.Name) {
+			continue
+		}
+
+		prefix := extractPrefix(firewall.Name)
+		entry := ReportEntry{
+			Kind:  "firewall",
+			ID:    firewall.ID,
+			Name:  firewall.Name,
+			Owner: r.providerName(prefix),
+		}
+
+		if reason, ok := skippedPrefixes[prefix]; ok {
+			r.skip(entry, reason)
+			continue
+		}
+
+		// firewalls are created before droplets, so a run without droplets may still be launching
+		createdAt, err := time.Parse(time.RFC3339, firewall.Created)
+		if err != nil {
+			r.logger.Error("Failed to parse firewall creation time, skipping",
+				zap.String("name", firewall.Name),
+				zap.String("created_at", firewall.Created),
+				zap.Error(err))
+			r.skip(entry, "failed to parse creation time")
+			continue
+		}
+
+		if reason := r.protect(entry.Owner, createdAt, time.Time{}); reason != "" {
+			r.skip(entry, reason)
+			continue
+		}
+
+		r.delete(entry, func() error { return r.client.DeleteFirewall(ctx, firewall.ID) })
+	}
+
+	return nil
+}
+
+// reapTags deletes petri tags that are no longer attached to any droplet
+func (r *doReaper) reapTags(ctx context.Context, skippedPrefixes map[string]string, remainingPrefixes map[string]bool) error {
+	tags, err := listAll(ctx, r.client.ListTags)
+	if err != nil {
+		return fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	for _, tag := range tags {
+		if expiresAt, ok := digitalocean.ParseExpiryTag(tag.Name); ok {
+			r.reapExpiryTag(ctx, tag, expiresAt)
+			continue
+		}
+
+		if !r.isPetriResource(tag.Name) {
+			continue
+		}
+
+		prefix := extractPrefix(tag.Name)
+		entry := ReportEntry{
+			Kind:  "tag",
+			ID:    tag.Name,
+			Name:  tag.Name,
+			Owner: r.providerName(prefix),
+		}
+
+		if reason := r.unusedRunReason(prefix, skippedPrefixes, remainingPrefixes); reason != "" {
+			r.skip(entry, reason)
+			continue
+		}
+
+		r.delete(entry, func() error { return r.client.DeleteTag(ctx, tag.Name) })
+	}
+
+	return nil
+}
+
+// reapExpiryTag deletes an expiry tag once it passed and no droplet carries it anymore. Expiry tags are not owned by a
+// single provider since providers expiring at the same second share them
+func (r *doReaper) reapExpiryTag(ctx context.Context, tag godo.Tag, expiresAt time.Time) {
+	entry := ReportEntry{
+		Kind: "tag",
+		ID:   tag.Name,
+		Name: tag.Name,
+	}
+
+	if r.now.Before(expiresAt) {
+		r.skip(entry, fmt.Sprintf("expires at %s", expiresAt.UTC().Format(time.RFC3339)))
+		return
+	}
+
+	if tag.Resources != nil && tag.Resources.Count > 0 {
+		r.skip(entry, "still used by droplets")
+		return
+	}
+
+	r.delete(entry, func() error { return r.client.DeleteTag(ctx, tag.Name) })
+}
+
+func (r *doReaper) reapKeys(ctx context.Context, skippedPrefixes map[string]string, remainingPrefixes map[string]bool) error {
+	keys, err := listAll(ctx, r.client.ListKeys)
+	if err != nil {
+		return fmt.Errorf("failed to list ssh keys: %w", err)
+	}
+
+	for _, key := range keys {
+		if !r.isPetriResource(key.Name) {
+			continue
+		}
+
+		prefix := extractPrefix(key.Name)
+		entry := ReportEntry{
+			Kind:  "ssh_key",
+			ID:    key.Fingerprint,
+			Name:  key.Name,
+			Owner: r.providerName(prefix),
+		}
+
+		if reason := r.unusedRunReason(prefix, skippedPrefixes, remainingPrefixes); reason != "" {
+			r.skip(entry, reason)
+			continue
+		}
+
+		r.delete(entry, func() error { return r.client.DeleteKeyByFingerprint(ctx, key.Fingerprint) })
+	}
+
+	return nil
+}
+
+// unusedRunReason returns why resources without a creation time belonging to a run prefix must be kept
+func (r *doReaper) unusedRunReason(prefix string, skippedPrefixes map[string]string, remainingPrefixes map[string]bool) string {
+	if reason, ok := skippedPrefixes[prefix]; ok {
+		return reason
+	}
+
+	if remainingPrefixes[prefix] {
+		return "still used by droplets"
+	}
+
+	return r.owners.reason(r.providerName(prefix), time.Time{})
+}
+
+// reapDomainRecords deletes load balancer records pointing at petri droplets deleted by this run. Records can't be
+// tagged, so only the droplet they point at ties them to petri, and only once that droplet passed its expiry or minimum
+// age. Records pointing at no petri droplet are left alone since they may belong to anything else in the domain
+func (r *doReaper) reapDomainRecords(ctx context.Context, records []godo.DomainRecord, droplets, remaining []godo.Droplet) {
+	petriIPs := make(map[string]string)
+	for _, droplet := range droplets {
+		if !r.isPetriResource(droplet.Name) {
+			continue
+		}
+		if ip, err := droplet.PublicIPv4(); err == nil && ip != "" {
+			petriIPs[ip] = droplet.Name
+		}
+	}
+
+	liveIPs := make(map[string]string)
+	for _, droplet := range remaining {
+		if ip, err := droplet.PublicIPv4(); err == nil && ip != "" {
+			liveIPs[ip] = droplet.Name
+		}
+	}
+
+	for _, record := range records {
+		if record.Type != "A" || !isLoadBalancerRecord(record.Name) {
+			continue
+		}
+
+		petriDroplet, ok := petriIPs[record.Data]
+		if !ok {
+			continue
+		}
+
+		entry := ReportEntry{
+			Kind:  "domain_record",
+			ID:    strconv.Itoa(record.ID),
+			Name:  fmt.Sprintf("%s.%s", record.Name, r.rootDomain),
+			Owner: r.providerName(extractPrefix(petriDroplet)),
+		}
+
+		if droplet, ok := liveIPs[record.Data]; ok {
+			r.skip(entry, fmt.Sprintf("points at droplet %s", droplet))
+			continue
+		}
+
+		r.delete(entry, func() error { return r.client.DeleteDomain(ctx, r.rootDomain, record.ID) })
+	}
+}
+
+// isLoadBalancerRecord reports whether a record name matches the domains created for load balancers, e.g. <chain>-rpc
+func isLoadBalancerRecord(name string) bool {
+	for _, domainType := range messages.LoadBalancerDomainTypes {
+		if strings.HasSuffix(name, "-"+domainType) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/api/types/volume"
+	dockerclient "github.com/docker/docker/client"
+	"github.com/skip-mev/ironbird/petri/core/provider"
+	"github.com/skip-mev/ironbird/petri/core/provider/docker"
+)
+
+// reapDocker deletes containers, networks and volumes labeled by the docker provider of workflows that are gone
+func (r *reaper) reapDocker(ctx context.Context, client *dockerclient.Client) error {
+	providerFilter := filters.NewArgs(filters.Arg("label", docker.ProviderLabelName))
+
+	// volumes are listed first since they hold the expiry records replacing the expiry labels of every resource, which
+	// could otherwise have passed for a provider whose expiry was extended
+	volumes, err := client.VolumeList(ctx, volume.ListOptions{Filters: providerFilter})
+	if err != nil {
+		return fmt.Errorf("failed to list volumes: %w", err)
+	}
+	records := expiryRecords(volumes.Volumes)
+
+	containers, err := client.ContainerList(ctx, container.ListOptions{All: true, Filters: providerFilter})
+	if err != nil {
+		return fmt.Errorf("failed to list containers: %w", err)
+	}
+
+	// networks and volumes are kept as long as a container of their provider is left
+	remainingProviders := make(map[string]bool)
+
+	for _, c := range containers {
+		entry := ReportEntry{
+			Kind:  "docker_container",
+			ID:    c.ID,
+			Name:  containerName(c),
+			Owner: c.Labels[docker.ProviderLabelName],
+		}
+
+		if reason := r.protect(entry.Owner, time.Unix(c.Created, 0), resourceExpiry(c.Labels, records)); reason != "" {
+			r.skip(entry, reason)
+			remainingProviders[entry.Owner] = true
+			continue
+		}
+
+		if !r.delete(entry, func() error {
+			return client.ContainerRemove(ctx, c.ID, container.RemoveOptions{Force: true, RemoveVolumes: true})
+		}) {
+			remainingProviders[entry.Owner] = true
+		}
+	}
+
+	var errs []error
+
+	networks, err := client.NetworkList(ctx, network.ListOptions{Filters: providerFilter})
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed to list networks: %w", err))
+	}
+
+	for _, n := range networks {
+		entry := ReportEntry{
+			Kind:  "docker_network",
+			ID:    n.ID,
+			Name:  n.Name,
+			Owner: n.Labels[docker.ProviderLabelName],
+		}
+
+		expiresAt := resourceExpiry(n.Labels, records)
+		if reason := r.dockerResourceReason(entry.Owner, n.Created, expiresAt, remainingProviders); reason != "" {
+			r.skip(entry, reason)
+			continue
+		}
+
+		r.delete(entry, func() error { return client.NetworkRemove(ctx, n.ID) })
+	}
+
+	for _, v := range volumes.Volumes {
+		entry := ReportEntry{
+			Kind:  "docker_volume",
+			ID:    v.Name,
+			Name:  v.Name,
+			Owner: v.Labels[docker.ProviderLabelName],
+		}
+
+		createdAt, _ := time.Parse(time.RFC3339, v.CreatedAt)
+		expiresAt := resourceExpiry(v.Labels, records)
+		if reason := r.dockerResourceReason(entry.Owner, createdAt, expiresAt, remainingProviders); reason != "" {
+			r.skip(entry, reason)
+			continue
+		}
+
+		r.delete(entry, func() error { return client.VolumeRemove(ctx, v.Name, false) })
+	}
+
+	return errors.Join(errs...)
+}
+
+func (r *reaper) dockerResourceReason(owner string, createdAt, expiresAt time.Time, remainingProviders map[string]bool) string {
+	if remainingProviders[owner] {
+		return "still used by containers"
+	}
+
+	return r.protect(owner, createdAt, expiresAt)
+}
+
+// expiryRecords returns the expiry recorded for each provider by its expiry records. A provider left with several
+// records, e.g. when removing the previous one failed, expires at the latest of them
+func expiryRecords(volumes []*volume.Volume) map[string]time.Time {
+	records := make(map[string]time.Time)
+	for _, v := range volumes {
+		if _, ok := v.Labels[docker.ExpiryRecordLabelName]; !ok {
+			continue
+		}
+
+		owner := v.Labels[docker.ProviderLabelName]
+		if expiresAt := labelExpiry(v.Labels); expiresAt.After(records[owner]) {
+			records[owner] = expiresAt
+		}
+	}
+	return records
+}
+
+// resourceExpiry returns the expiry recorded for the provider of a resource, or the one it is labeled with otherwise
+func resourceExpiry(labels map[string]string, records map[string]time.Time) time.Time {
+	if expiresAt, ok := records[labels[docker.ProviderLabelName]]; ok {
+		return expiresAt
+	}
+	return labelExpiry(labels)
+}
+
+// labelExpiry returns the expiry a resource is labeled with, or a zero time if it has none
+func labelExpiry(labels map[string]string) time.Time {
+	expiry, ok := labels[docker.ExpiryLabelName]
+	if !ok {
+		return time.Time{}
+	}
+
+	expiresAt, err := provider.ParseExpiry(expiry)
+	if err != nil {
+		return time.Time{}
+	}
+	return expiresAt
+}
+
+func containerName(c container.Summary) string {
+	if len(c.Names) == 0 {
+		return c.ID
+	}
+	return strings.TrimPrefix(c.Names[0], "/")
+}
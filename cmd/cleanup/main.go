@@ -5,19 +5,25 @@ import (
 	"flag"
 	"fmt"
 	"os"
-	"strings"
 	"time"
 
-	"github.com/digitalocean/godo"
+	dockerclient "github.com/docker/docker/client"
 	"github.com/skip-mev/ironbird/petri/core/provider/digitalocean"
+	"github.com/skip-mev/ironbird/server/db"
 	"go.uber.org/zap"
 )
 
 var (
-	token       = flag.String("token", "", "DigitalOcean API token")
-	dryRun      = flag.Bool("dry-run", false, "Perform a dry run without actually deleting droplets")
-	namePrefix  = flag.String("prefix", "petri", "Name prefix to filter droplets")
-	longRunning = flag.String("long-running-tag", "LONG_RUNNING", "Tag name that indicates a droplet should not be deleted")
+	token        = flag.String("token", "", "DigitalOcean API token")
+	dryRun       = flag.Bool("dry-run", false, "Perform a dry run without actually deleting resources")
+	namePrefix   = flag.String("prefix", "petri", "Name prefix to filter resources")
+	longRunning  = flag.String("long-running-tag", "LONG_RUNNING", "Tag name that indicates a droplet without an expiry tag should not be deleted")
+	dbPath       = flag.String("db", "", "Path to an existing ironbird server database, resources of running workflows are never deleted. Without it only the age and expiry of resources protect them")
+	minAge       = flag.Duration("min-age", 30*time.Minute, "Minimum age of a resource without an expiry before it is deleted")
+	rootDomain   = flag.String("root-domain", "", "DigitalOcean domain load balancer records are created in, records are not cleaned up when empty")
+	tsSecret     = flag.String("tailscale-oauth-secret", "", "Tailscale OAuth client secret used to delete devices of petri nodes")
+	cleanDocker  = flag.Bool("docker", false, "Clean up containers, networks and volumes of the local docker provider")
+	reportOutput = flag.String("report", "", "File to write the JSON report to, defaults to stdout")
 )
 
 func main() {
@@ -28,267 +34,120 @@ func main() {
 
 	if *token == "" {
 		*token = os.Getenv("DIGITALOCEAN_TOKEN")
-		if *token == "" {
-			logger.Fatal("DigitalOcean token is required. Set via --token flag or DIGITALOCEAN_TOKEN environment variable")
-		}
-	}
-
-	ctx := context.Background()
-
-	doClient := digitalocean.NewGodoClient(*token)
-
-	logger.Info("Starting resource cleanup",
-		zap.String("prefix", *namePrefix),
-		zap.String("long_running_tag", *longRunning),
-		zap.Bool("dry_run", *dryRun))
-
-	skippedPrefixes, err := getSkippedDropletPrefixes(ctx, doClient, logger)
-	if err != nil {
-		logger.Fatal("Failed to get skipped droplet prefixes", zap.Error(err))
 	}
-
-	if err := cleanupDroplets(ctx, doClient, logger, skippedPrefixes); err != nil {
-		logger.Fatal("Failed to cleanup droplets", zap.Error(err))
+	if *tsSecret == "" {
+		*tsSecret = os.Getenv("TS_SERVER_OAUTH_SECRET")
 	}
 
-	if err := cleanupFirewalls(ctx, doClient, logger, skippedPrefixes); err != nil {
-		logger.Fatal("Failed to cleanup firewalls", zap.Error(err))
+	if *token == "" && !*cleanDocker {
+		logger.Fatal("Nothing to clean up. Set a DigitalOcean token via --token flag or DIGITALOCEAN_TOKEN environment variable, or pass --docker")
 	}
 
-	logger.Info("Resource cleanup completed successfully")
-}
+	ctx := context.Background()
 
-func extractPrefix(dropletName string) string {
-	// Extract prefix pattern like "petri-ib-XXXXXX" from "petri-ib-XXXXXX-validator-0"
-	parts := strings.Split(dropletName, "-")
-	if len(parts) >= 3 {
-		return strings.Join(parts[:3], "-")
+	o, err := openOwners(*dbPath, logger)
+	if err != nil {
+		logger.Fatal("Failed to load running workflows", zap.String("path", *dbPath), zap.Error(err))
 	}
-	return dropletName
-}
 
-func getSkippedDropletPrefixes(ctx context.Context, client digitalocean.DoClient, logger *zap.Logger) (map[string]bool, error) {
-	opts := &godo.ListOptions{
-		Page:    1,
-		PerPage: 200,
-	}
+	report := NewReport(*dryRun)
+	report.ActiveWorkflows = o.providers
 
-	var allDroplets []godo.Droplet
-	for {
-		droplets, err := client.ListDroplets(ctx, opts)
-		if err != nil {
-			return nil, fmt.Errorf("failed to list droplets: %w", err)
-		}
-
-		allDroplets = append(allDroplets, droplets...)
-
-		if len(droplets) < opts.PerPage {
-			break
-		}
-
-		opts.Page++
+	r := &reaper{
+		logger: logger,
+		dryRun: *dryRun,
+		prefix: *namePrefix,
+		minAge: *minAge,
+		now:    time.Now(),
+		owners: o,
+		report: report,
 	}
 
-	skippedPrefixes := make(map[string]bool)
-	now := time.Now()
-
-	for _, droplet := range allDroplets {
-		// Skip non-petri droplets
-		if !strings.HasPrefix(droplet.Name, *namePrefix) {
-			continue
-		}
-
-		prefix := extractPrefix(droplet.Name)
-		if skippedPrefixes[prefix] {
-			continue
-		}
+	logger.Info("Starting resource cleanup",
+		zap.String("prefix", *namePrefix),
+		zap.String("long_running_tag", *longRunning),
+		zap.Int("active_workflows", len(o.providers)),
+		zap.Bool("dry_run", *dryRun))
 
-		// Skip droplets with the LONG_RUNNING tag
-		hasLongRunningTag := false
-		for _, tag := range droplet.Tags {
-			if tag == *longRunning {
-				hasLongRunningTag = true
-				break
-			}
-		}
+	failed := false
 
-		if hasLongRunningTag {
-			prefix := extractPrefix(droplet.Name)
-			skippedPrefixes[prefix] = true
-			logger.Info("Marking droplet as skipped (long-running)",
-				zap.String("droplet_name", droplet.Name))
-			continue
+	var remainingPrefixes map[string]bool
+	if *token != "" {
+		doReaper := &doReaper{
+			reaper:      r,
+			client:      digitalocean.NewGodoClient(*token),
+			longRunning: *longRunning,
+			rootDomain:  *rootDomain,
 		}
 
-		// Skip if droplet was created in the last 30 minutes
-		createdAt, err := time.Parse(time.RFC3339, droplet.Created)
+		remainingPrefixes, err = doReaper.reap(ctx)
 		if err != nil {
-			logger.Error("Failed to parse droplet creation time, marking as skipped",
-				zap.String("name", droplet.Name),
-				zap.String("created_at", droplet.Created),
-				zap.Error(err))
-			prefix := extractPrefix(droplet.Name)
-			skippedPrefixes[prefix] = true
-			continue
+			logger.Error("Failed to cleanup DigitalOcean resources", zap.Error(err))
+			failed = true
 		}
 
-		if now.Sub(createdAt) < 30*time.Minute {
-			prefix := extractPrefix(droplet.Name)
-			skippedPrefixes[prefix] = true
-			logger.Info("Marking droplet as skipped (too recent)",
-				zap.String("droplet_name", droplet.Name),
-				zap.String("created_at", droplet.Created))
+		// devices can only be matched against droplets once droplets were listed
+		if *tsSecret != "" && remainingPrefixes != nil {
+			tsClient, err := digitalocean.NewTailscaleClient(ctx, *tsSecret)
+			if err == nil {
+				err = r.reapTailscaleDevices(ctx, tsClient, remainingPrefixes)
+			}
+			if err != nil {
+				logger.Error("Failed to cleanup Tailscale devices", zap.Error(err))
+				failed = true
+			}
 		}
 	}
 
-	return skippedPrefixes, nil
-}
-
-func cleanupDroplets(ctx context.Context, client digitalocean.DoClient, logger *zap.Logger, skippedPrefixes map[string]bool) error {
-	opts := &godo.ListOptions{
-		Page:    1,
-		PerPage: 200,
-	}
-
-	var allDroplets []godo.Droplet
-	for {
-		droplets, err := client.ListDroplets(ctx, opts)
-		if err != nil {
-			return fmt.Errorf("failed to list droplets: %w", err)
+	if *cleanDocker {
+		dockerClient, err := dockerclient.NewClientWithOpts(dockerclient.FromEnv, dockerclient.WithAPIVersionNegotiation())
+		if err == nil {
+			err = r.reapDocker(ctx, dockerClient)
+			_ = dockerClient.Close()
 		}
-
-		allDroplets = append(allDroplets, droplets...)
-
-		if len(droplets) < opts.PerPage {
-			break
+		if err != nil {
+			logger.Error("Failed to cleanup docker resources", zap.Error(err))
+			failed = true
 		}
-
-		opts.Page++
 	}
 
-	logger.Info("Retrieved droplets", zap.Int("total_count", len(allDroplets)))
-
-	var dropletsToDelete []godo.Droplet
-	for _, droplet := range allDroplets {
-		if !strings.HasPrefix(droplet.Name, *namePrefix) {
-			continue
-		}
-
-		if shouldSkipResource(droplet.Name, skippedPrefixes) {
-			logger.Info("Skipping droplet", zap.String("name", droplet.Name))
-			continue
+	out := os.Stdout
+	if *reportOutput != "" {
+		out, err = os.Create(*reportOutput)
+		if err != nil {
+			logger.Fatal("Failed to create report file", zap.String("path", *reportOutput), zap.Error(err))
 		}
-
-		dropletsToDelete = append(dropletsToDelete, droplet)
+		defer func() { _ = out.Close() }()
 	}
 
-	logger.Info("Found droplets to delete", zap.Int("count", len(dropletsToDelete)))
-
-	if *dryRun {
-		logger.Info("Dry run mode - would delete droplets",
-			zap.Int("count", len(dropletsToDelete)),
-			zap.Any("droplets", dropletsToDelete))
-		return nil
+	if err := report.Write(out); err != nil {
+		logger.Fatal("Failed to write report", zap.Error(err))
 	}
 
-	var deletedCount int
-	for _, droplet := range dropletsToDelete {
-		logger.Info("Deleting droplet",
-			zap.String("name", droplet.Name),
-			zap.Int("id", droplet.ID))
-
-		if err := client.DeleteDropletByID(ctx, droplet.ID); err != nil {
-			logger.Error("Failed to delete droplet",
-				zap.String("name", droplet.Name),
-				zap.Int("id", droplet.ID),
-				zap.Error(err))
-			continue
-		}
-
-		deletedCount++
-		logger.Info("Successfully deleted droplet",
-			zap.String("name", droplet.Name),
-			zap.Int("id", droplet.ID))
+	if failed {
+		logger.Fatal("Resource cleanup failed", zap.Any("summary", report.Summary))
 	}
 
-	logger.Info("Cleanup completed",
-		zap.Int("deleted_count", deletedCount),
-		zap.Int("total_candidates", len(dropletsToDelete)))
-
-	return nil
-}
-
-func shouldSkipResource(resourceName string, skippedPrefixes map[string]bool) bool {
-	prefix := extractPrefix(resourceName)
-	return skippedPrefixes[prefix]
+	logger.Info("Resource cleanup completed successfully", zap.Any("summary", report.Summary))
 }
 
-func cleanupFirewalls(ctx context.Context, client digitalocean.DoClient, logger *zap.Logger, skippedPrefixes map[string]bool) error {
-	opts := &godo.ListOptions{
-		Page:    1,
-		PerPage: 200,
+// openOwners loads the running workflows from the server database at path. The database must already exist, opening a
+// missing path would create an empty one. Without a path no resource is attributed to a running workflow
+func openOwners(path string, logger *zap.Logger) (*owners, error) {
+	if path == "" {
+		logger.Warn("No server database given, resources of running workflows are only protected by their age and expiry")
+		return &owners{providers: make(map[string]string)}, nil
 	}
 
-	var allFirewalls []godo.Firewall
-	for {
-		firewalls, err := client.ListFirewalls(ctx, opts)
-		if err != nil {
-			return fmt.Errorf("failed to list firewalls: %w", err)
-		}
-
-		allFirewalls = append(allFirewalls, firewalls...)
-
-		if len(firewalls) < opts.PerPage {
-			break
-		}
-
-		opts.Page++
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("failed to find server database: %w", err)
 	}
 
-	logger.Info("Retrieved firewalls", zap.Int("total_count", len(allFirewalls)))
-
-	var firewallsToDelete []godo.Firewall
-	for _, firewall := range allFirewalls {
-		if !strings.HasPrefix(firewall.Name, *namePrefix) {
-			continue
-		}
-
-		if shouldSkipResource(firewall.Name, skippedPrefixes) {
-			logger.Info("Skipping firewall ",
-				zap.String("name", firewall.Name))
-			continue
-		}
-
-		firewallsToDelete = append(firewallsToDelete, firewall)
-	}
-
-	logger.Info("Found firewalls to delete", zap.Int("count", len(firewallsToDelete)))
-
-	if *dryRun {
-		logger.Info("Dry run mode - would delete firewalls",
-			zap.Int("count", len(firewallsToDelete)),
-			zap.Any("firewalls", firewallsToDelete))
-		return nil
-	}
-
-	var deletedCount int
-	for _, firewall := range firewallsToDelete {
-		logger.Info("Deleting firewall", zap.String("name", firewall.Name))
-
-		if err := client.DeleteFirewall(ctx, firewall.ID); err != nil {
-			logger.Error("Failed to delete firewall",
-				zap.String("name", firewall.Name),
-				zap.Error(err))
-			continue
-		}
-
-		deletedCount++
-		logger.Info("Successfully deleted firewall",
-			zap.String("name", firewall.Name))
+	database, err := db.NewSQLiteDB(path, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open server database: %w", err)
 	}
+	defer func() { _ = database.Close() }()
 
-	logger.Info("Firewall cleanup completed",
-		zap.Int("deleted_count", deletedCount))
-
-	return nil
+	return loadOwners(database)
 }
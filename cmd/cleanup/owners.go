@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/skip-mev/ironbird/server/db"
+	"go.temporal.io/api/enums/v1"
+)
+
+const workflowsPageSize = 200
+
+// owners tracks which providers belong to workflows that are still active according to the server database
+type owners struct {
+	// providers maps provider names (e.g. ib-abc123) to the ID of the workflow that created them
+	providers map[string]string
+	// unattributedWorkflow is the oldest active workflow that has not reported its provider yet, any resource created
+	// after it started may belong to it
+	unattributedWorkflow string
+	unattributedSince    time.Time
+}
+
+func isActiveWorkflow(status db.WorkflowStatus) bool {
+	// pending workflows are stored with an unspecified status
+	return status == enums.WORKFLOW_EXECUTION_STATUS_RUNNING || status == enums.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED
+}
+
+func loadOwners(database db.DB) (*owners, error) {
+	o := &owners{providers: make(map[string]string)}
+
+	for offset := 0; ; offset += workflowsPageSize {
+		workflows, err := database.ListWorkflows(workflowsPageSize, offset)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list workflows: %w", err)
+		}
+
+		for _, workflow := range workflows {
+			if !isActiveWorkflow(workflow.Status) {
+				continue
+			}
+
+			if workflow.Provider != "" {
+				o.providers[workflow.Provider] = workflow.WorkflowID
+				continue
+			}
+
+			if o.unattributedSince.IsZero() || workflow.CreatedAt.Before(o.unattributedSince) {
+				o.unattributedWorkflow = workflow.WorkflowID
+				o.unattributedSince = workflow.CreatedAt
+			}
+		}
+
+		if len(workflows) < workflowsPageSize {
+			return o, nil
+		}
+	}
+}
+
+// reason returns why a resource of the given provider must not be deleted, or an empty string if no active workflow
+// can own it. A zero createdAt means the creation time of the resource is unknown.
+func (o *owners) reason(provider string, createdAt time.Time) string {
+	if workflowID, ok := o.providers[provider]; ok {
+		return fmt.Sprintf("owned by running workflow %s", workflowID)
+	}
+
+	if o.unattributedWorkflow != "" && (createdAt.IsZero() || !createdAt.Before(o.unattributedSince)) {
+		return fmt.Sprintf("may belong to running workflow %s which has not reported its provider yet", o.unattributedWorkflow)
+	}
+
+	return ""
+}
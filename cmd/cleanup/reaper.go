@@ -0,0 +1,104 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// reaper holds the settings shared by the cleanup of every kind of resource
+type reaper struct {
+	logger *zap.Logger
+	dryRun bool
+	prefix string
+	minAge time.Duration
+	now    time.Time
+	owners *owners
+	report *Report
+}
+
+// extractPrefix returns the run prefix of a resource, e.g. "petri-ib-XXXXXX" for "petri-ib-XXXXXX-validator-0"
+func extractPrefix(name string) string {
+	parts := strings.Split(name, "-")
+	if len(parts) >= 3 {
+		return strings.Join(parts[:3], "-")
+	}
+	return name
+}
+
+// isPetriResource reports whether a resource name was generated by a petri provider
+func (r *reaper) isPetriResource(name string) bool {
+	return strings.HasPrefix(name, r.prefix+"-")
+}
+
+// providerName returns the name of the provider owning a run prefix, e.g. "ib-XXXXXX" for "petri-ib-XXXXXX"
+func (r *reaper) providerName(prefix string) string {
+	return strings.TrimPrefix(prefix, r.prefix+"-")
+}
+
+// protect returns why a resource of the given provider must be kept, or an empty string if it can be deleted. Resources
+// stamped with an expiry are kept until it passed, others until they are older than minAge. A zero time means unknown
+func (r *reaper) protect(provider string, createdAt, expiresAt time.Time) string {
+	if !expiresAt.IsZero() {
+		if r.now.Before(expiresAt) {
+			return fmt.Sprintf("expires at %s", expiresAt.UTC().Format(time.RFC3339))
+		}
+	} else if !createdAt.IsZero() && r.now.Sub(createdAt) < r.minAge {
+		return fmt.Sprintf("created less than %s ago", r.minAge)
+	}
+
+	return r.owners.reason(provider, createdAt)
+}
+
+func (r *reaper) skip(entry ReportEntry, reason string) {
+	entry.Action = ActionSkipped
+	entry.Reason = reason
+	r.report.Add(entry)
+
+	r.logger.Info("Skipping resource",
+		zap.String("kind", entry.Kind),
+		zap.String("name", entry.Name),
+		zap.String("reason", reason))
+}
+
+// delete deletes a resource unless running in dry-run mode and reports whether it is gone (or would be)
+func (r *reaper) delete(entry ReportEntry, del func() error) bool {
+	if r.dryRun {
+		entry.Action = ActionWouldDelete
+		r.report.Add(entry)
+
+		r.logger.Info("Dry run mode - would delete resource",
+			zap.String("kind", entry.Kind),
+			zap.String("name", entry.Name),
+			zap.String("id", entry.ID))
+		return true
+	}
+
+	r.logger.Info("Deleting resource",
+		zap.String("kind", entry.Kind),
+		zap.String("name", entry.Name),
+		zap.String("id", entry.ID))
+
+	if err := del(); err != nil {
+		entry.Action = ActionFailed
+		entry.Error = err.Error()
+		r.report.Add(entry)
+
+		r.logger.Error("Failed to delete resource",
+			zap.String("kind", entry.Kind),
+			zap.String("name", entry.Name),
+			zap.String("id", entry.ID),
+			zap.Error(err))
+		return false
+	}
+
+	entry.Action = ActionDeleted
+	r.report.Add(entry)
+
+	r.logger.Info("Successfully deleted resource",
+		zap.String("kind", entry.Kind),
+		zap.String("name", entry.Name))
+	return true
+}
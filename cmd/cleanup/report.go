@@ -0,0 +1,66 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+)
+
+type Action string
+
+const (
+	ActionDeleted     Action = "deleted"
+	ActionWouldDelete Action = "would_delete"
+	ActionSkipped     Action = "skipped"
+	ActionFailed      Action = "failed"
+)
+
+// ReportEntry records what the reaper did with a single resource
+type ReportEntry struct {
+	Kind   string `json:"kind"`
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Owner  string `json:"owner,omitempty"`
+	Action Action `json:"action"`
+	Reason string `json:"reason,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Report is the structured output of a cleanup run
+type Report struct {
+	mu sync.Mutex
+
+	StartedAt time.Time `json:"started_at"`
+	DryRun    bool      `json:"dry_run"`
+	// ActiveWorkflows maps the provider name of every workflow that is still running to its workflow ID
+	ActiveWorkflows map[string]string `json:"active_workflows"`
+	Resources       []ReportEntry     `json:"resources"`
+	Summary         map[Action]int    `json:"summary"`
+}
+
+func NewReport(dryRun bool) *Report {
+	return &Report{
+		StartedAt:       time.Now(),
+		DryRun:          dryRun,
+		ActiveWorkflows: make(map[string]string),
+		Summary:         make(map[Action]int),
+	}
+}
+
+func (r *Report) Add(entry ReportEntry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.Resources = append(r.Resources, entry)
+	r.Summary[entry.Action]++
+}
+
+func (r *Report) Write(w io.Writer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
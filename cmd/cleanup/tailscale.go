@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"tailscale.com/client/tailscale/v2"
+)
+
+// reapTailscaleDevices deletes the devices of petri nodes that are gone. remainingPrefixes holds the run prefixes that
+// still have droplets, it is nil when droplets were not listed.
+func (r *reaper) reapTailscaleDevices(ctx context.Context, client *tailscale.Client, remainingPrefixes map[string]bool) error {
+	devices, err := client.Devices().List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list tailscale devices: %w", err)
+	}
+
+	for _, device := range devices {
+		if !r.isPetriResource(device.Hostname) {
+			continue
+		}
+
+		prefix := extractPrefix(device.Hostname)
+		entry := ReportEntry{
+			Kind:  "tailscale_device",
+			ID:    device.NodeID,
+			Name:  device.Hostname,
+			Owner: r.providerName(prefix),
+		}
+
+		if remainingPrefixes[prefix] {
+			r.skip(entry, "still used by droplets")
+			continue
+		}
+
+		if !device.LastSeen.IsZero() && r.now.Sub(device.LastSeen.Time) < r.minAge {
+			r.skip(entry, fmt.Sprintf("seen less than %s ago", r.minAge))
+			continue
+		}
+
+		if reason := r.protect(entry.Owner, device.Created.Time, time.Time{}); reason != "" {
+			r.skip(entry, reason)
+			continue
+		}
+
+		r.delete(entry, func() error { return client.Devices().Delete(ctx, device.NodeID) })
+	}
+
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/skip-mev/ironbird/activities/builder"
 	"github.com/skip-mev/ironbird/activities/loadbalancer"
 	"github.com/skip-mev/ironbird/activities/loadtest"
+	"github.com/skip-mev/ironbird/activities/relayer"
 	testnetactivity "github.com/skip-mev/ironbird/activities/testnet"
 	"github.com/skip-mev/ironbird/messages"
 	"github.com/skip-mev/ironbird/petri/core/provider/digitalocean"
@@ -75,13 +76,6 @@ func main() {
 		logger.Info("Skipping AWS config (using local registry)")
 	}
 
-	builderActivity := builder.Activity{
-		BuilderConfig: cfg.Builder,
-		AwsConfig:     awsConfig,
-		Chains:        cfg.Chains,
-		Registry:      activeRegistry,
-	}
-
 	var grpcClient pb.IronbirdServiceClient
 	if cfg.ServerAddress != "" {
 		logger.Info("Attempting to connect to gRPC server", zap.String("address", cfg.ServerAddress))
@@ -104,6 +98,14 @@ func main() {
 		logger.Warn("no grpc client configured - workflow data updates will be skipped")
 	}
 
+	builderActivity := builder.Activity{
+		BuilderConfig: cfg.Builder,
+		AwsConfig:     awsConfig,
+		Chains:        cfg.Chains,
+		Registry:      activeRegistry,
+		GRPCClient:    grpcClient,
+	}
+
 	var tailscaleSettings digitalocean.TailscaleSettings
 	if cfg.Tailscale.ServerOauthSecret != "" && cfg.Tailscale.NodeAuthKey != "" {
 		var err error
@@ -141,7 +143,7 @@ func main() {
 		GrafanaConfig:     cfg.Grafana,
 		GRPCClient:        grpcClient,
 		AwsConfig:         awsConfig,
-		RegistryType:      activeRegistry.Type,
+		Registry:          activeRegistry,
 	}
 
 	loadTestActivity := loadtest.Activity{
@@ -171,6 +173,7 @@ func main() {
 
 	loadBalancerActivity := loadbalancer.Activity{
 		RootDomain:        cfg.LoadBalancer.RootDomain,
+		Image:             cfg.LoadBalancer.Image,
 		SSLKey:            sslKey,
 		SSLCertificate:    sslCert,
 		DOToken:           cfg.DigitalOcean.Token,
@@ -179,16 +182,34 @@ func main() {
 		GRPCClient:        grpcClient,
 	}
 
+	relayerActivity := relayer.Activity{
+		DOToken:           cfg.DigitalOcean.Token,
+		TailscaleSettings: tailscaleSettings,
+		TelemetrySettings: telemetrySettings,
+	}
+
 	w := worker.New(c, messages.TaskQueue, worker.Options{})
 
 	w.RegisterWorkflow(testnetworkflow.Workflow)
+	w.RegisterWorkflow(testnetworkflow.TeardownWorkflow)
+	w.RegisterWorkflow(testnetworkflow.Supervise)
 
 	w.RegisterActivity(testnetActivity.LaunchTestnet)
 	w.RegisterActivity(testnetActivity.CreateProvider)
 	w.RegisterActivity(testnetActivity.TeardownProvider)
+	w.RegisterActivity(testnetActivity.SaveProviderState)
+	w.RegisterActivity(testnetActivity.SetExpiry)
+	w.RegisterActivity(testnetActivity.MigrateGenesis)
+	w.RegisterActivity(testnetActivity.UpgradeChain)
+	w.RegisterActivity(testnetActivity.InjectFault)
+	w.RegisterActivity(testnetActivity.CheckHealth)
+	w.RegisterActivity(testnetActivity.CollectInstrumentation)
 	w.RegisterActivity(loadTestActivity.RunLoadTest)
 	w.RegisterActivity(loadBalancerActivity.LaunchLoadBalancer)
+	w.RegisterActivity(loadBalancerActivity.UpdateLoadBalancer)
 	w.RegisterActivity(builderActivity.BuildDockerImage)
+	w.RegisterActivity(relayerActivity.LaunchRelayer)
+	w.RegisterActivity(relayerActivity.RunIBCTransferLoad)
 
 	err = w.Run(worker.InterruptCh())
 
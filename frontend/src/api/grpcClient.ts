@@ -10,6 +10,7 @@ import {
   WorkflowListResponse,
   CancelWorkflowRequest,
   SignalWorkflowRequest,
+  ForceTeardownRequest,
   CreateWorkflowTemplateRequest,
   GetWorkflowTemplateRequest,
   ListWorkflowTemplatesRequest,
@@ -21,6 +22,8 @@ import {
   ExecuteWorkflowTemplateRequest,
   GetTemplateRunHistoryRequest,
   TemplateRunHistoryResponse,
+  StreamBuildLogsRequest,
+  BuildLogChunk,
 } from "../gen/proto/ironbird_pb.js";
 
 console.log("VITE_IRONBIRD_GRPC_ADDRESS:", import.meta.env.VITE_IRONBIRD_GRPC_ADDRESS);
@@ -89,14 +92,22 @@ export const grpcWorkflowApi = {
     return await client.cancelWorkflow(request) as WorkflowResponse;
   },
 
-  signalWorkflow: async (workflowId: string, signalName: string): Promise<WorkflowResponse> => {
+  signalWorkflow: async (workflowId: string, signalName: string, payload?: object): Promise<WorkflowResponse> => {
     const request = new SignalWorkflowRequest({
       workflowId: workflowId,
-      signalName: signalName
+      signalName: signalName,
+      payload: payload ? JSON.stringify(payload) : ''
     });
     return await client.signalWorkflow(request) as WorkflowResponse;
   },
 
+  forceTeardown: async (workflowId: string): Promise<WorkflowResponse> => {
+    const request = new ForceTeardownRequest({
+      workflowId: workflowId
+    });
+    return await client.forceTeardown(request) as WorkflowResponse;
+  },
+
   // Template management methods
   createWorkflowTemplate: async (request: CreateWorkflowTemplateRequest): Promise<WorkflowTemplateResponse> => {
     try {
@@ -164,4 +175,16 @@ export const grpcWorkflowApi = {
     });
     return await client.getTemplateRunHistory(request) as TemplateRunHistoryResponse;
   },
+
+  // streamBuildLogs yields the build log chunks of a workflow, following new chunks until the workflow finishes
+  streamBuildLogs: async function* (workflowId: string, follow = true, afterId = BigInt(0)): AsyncGenerator<BuildLogChunk> {
+    const request = new StreamBuildLogsRequest({
+      workflowId,
+      follow,
+      afterId,
+    });
+    for await (const chunk of client.streamBuildLogs(request)) {
+      yield chunk as BuildLogChunk;
+    }
+  },
 };
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CancelWorkflowRequest, CreateWorkflowRequest, CreateWorkflowTemplateRequest, DeleteWorkflowTemplateRequest, ExecuteWorkflowTemplateRequest, GetTemplateRunHistoryRequest, GetWorkflowRequest, GetWorkflowTemplateRequest, ListWorkflowsRequest, ListWorkflowTemplatesRequest, RunLoadTestRequest, SignalWorkflowRequest, TemplateRunHistoryResponse, UpdateWorkflowDataRequest, UpdateWorkflowTemplateRequest, Workflow, WorkflowListResponse, WorkflowResponse, WorkflowTemplate, WorkflowTemplateListResponse, WorkflowTemplateResponse } from "./ironbird_pb.js";
+import { AppendBuildLogsRequest, AppendBuildLogsResponse, BuildLogChunk, CancelWorkflowRequest, CreateWorkflowRequest, CreateWorkflowTemplateRequest, DeleteWorkflowTemplateRequest, ExecuteWorkflowTemplateRequest, ForceTeardownRequest, GetTemplateRunHistoryRequest, GetWorkflowArtifactsRequest, GetWorkflowRequest, GetWorkflowTemplateRequest, ListWorkflowsRequest, ListWorkflowTemplatesRequest, RunLoadTestRequest, SaveProviderStateRequest, SaveProviderStateResponse, SignalWorkflowRequest, StreamBuildLogsRequest, TemplateRunHistoryResponse, UpdateWorkflowDataRequest, UpdateWorkflowTemplateRequest, UploadWorkflowArtifactRequest, UploadWorkflowArtifactResponse, Workflow, WorkflowArtifactsResponse, WorkflowListResponse, WorkflowResponse, WorkflowTemplate, WorkflowTemplateListResponse, WorkflowTemplateResponse } from "./ironbird_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -57,6 +57,15 @@ export const IronbirdService = {
       O: WorkflowResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc skip.ironbird.IronbirdService.ForceTeardown
+     */
+    forceTeardown: {
+      name: "ForceTeardown",
+      I: ForceTeardownRequest,
+      O: WorkflowResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc skip.ironbird.IronbirdService.RunLoadTest
      */
@@ -75,6 +84,51 @@ export const IronbirdService = {
       O: WorkflowResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc skip.ironbird.IronbirdService.AppendBuildLogs
+     */
+    appendBuildLogs: {
+      name: "AppendBuildLogs",
+      I: AppendBuildLogsRequest,
+      O: AppendBuildLogsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc skip.ironbird.IronbirdService.StreamBuildLogs
+     */
+    streamBuildLogs: {
+      name: "StreamBuildLogs",
+      I: StreamBuildLogsRequest,
+      O: BuildLogChunk,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * @generated from rpc skip.ironbird.IronbirdService.SaveProviderState
+     */
+    saveProviderState: {
+      name: "SaveProviderState",
+      I: SaveProviderStateRequest,
+      O: SaveProviderStateResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc skip.ironbird.IronbirdService.UploadWorkflowArtifact
+     */
+    uploadWorkflowArtifact: {
+      name: "UploadWorkflowArtifact",
+      I: UploadWorkflowArtifactRequest,
+      O: UploadWorkflowArtifactResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc skip.ironbird.IronbirdService.GetWorkflowArtifacts
+     */
+    getWorkflowArtifacts: {
+      name: "GetWorkflowArtifacts",
+      I: GetWorkflowArtifactsRequest,
+      O: WorkflowArtifactsResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc skip.ironbird.IronbirdService.CreateWorkflowTemplate
      */
@@ -94,6 +94,70 @@ export class CreateWorkflowRequest extends Message<CreateWorkflowRequest> {
    */
   providerConfig: { [key: string]: string } = {};
 
+  /**
+   * Optional: export-and-restart the testnet from its exported genesis.
+   *
+   * @generated from field: skip.ironbird.GenesisMigration genesis_migration = 17;
+   */
+  genesisMigration?: GenesisMigration;
+
+  /**
+   * Optional: start the testnet from a custom genesis instead of a generated one.
+   *
+   * @generated from field: skip.ironbird.CustomGenesis custom_genesis = 18;
+   */
+  customGenesis?: CustomGenesis;
+
+  /**
+   * Optional: chains launched next to the primary chain.
+   *
+   * @generated from field: repeated skip.ironbird.AdditionalChain additional_chains = 19;
+   */
+  additionalChains: AdditionalChain[] = [];
+
+  /**
+   * Optional: IBC relayer connecting the primary chain to every additional chain.
+   *
+   * @generated from field: skip.ironbird.Relayer relayer = 20;
+   */
+  relayer?: Relayer;
+
+  /**
+   * Optional: faults injected into the chain while the testnet is running.
+   *
+   * @generated from field: repeated skip.ironbird.Fault faults = 21;
+   */
+  faults: Fault[] = [];
+
+  /**
+   * Optional: go.mod replacements applied when building the chain image.
+   *
+   * @generated from field: repeated skip.ironbird.GoModReplace go_mod_replaces = 22;
+   */
+  goModReplaces: GoModReplace[] = [];
+
+  /**
+   * Optional: fork, patch or source tarball the chain is built from.
+   *
+   * @generated from field: skip.ironbird.ChainSource source = 23;
+   */
+  source?: ChainSource;
+
+  /**
+   * Optional: build the chain binary with -cover ("cover") or -race ("race") and collect the coverage profile or
+   * data race reports of every node at teardown.
+   *
+   * @generated from field: string build_variant = 24;
+   */
+  buildVariant = "";
+
+  /**
+   * Optional: health checks, upstream selection and limits of the load balancer, requires launch_load_balancer.
+   *
+   * @generated from field: skip.ironbird.LoadBalancerSpec load_balancer = 25;
+   */
+  loadBalancer?: LoadBalancerSpec;
+
   constructor(data?: PartialMessage<CreateWorkflowRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -117,6 +181,15 @@ export class CreateWorkflowRequest extends Message<CreateWorkflowRequest> {
     { no: 14, name: "cosmos_sdk_sha", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 15, name: "cometbft_sha", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 16, name: "provider_config", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 17, name: "genesis_migration", kind: "message", T: GenesisMigration },
+    { no: 18, name: "custom_genesis", kind: "message", T: CustomGenesis },
+    { no: 19, name: "additional_chains", kind: "message", T: AdditionalChain, repeated: true },
+    { no: 20, name: "relayer", kind: "message", T: Relayer },
+    { no: 21, name: "faults", kind: "message", T: Fault, repeated: true },
+    { no: 22, name: "go_mod_replaces", kind: "message", T: GoModReplace, repeated: true },
+    { no: 23, name: "source", kind: "message", T: ChainSource },
+    { no: 24, name: "build_variant", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 25, name: "load_balancer", kind: "message", T: LoadBalancerSpec },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateWorkflowRequest {
@@ -137,820 +210,2572 @@ export class CreateWorkflowRequest extends Message<CreateWorkflowRequest> {
 }
 
 /**
- * @generated from message skip.ironbird.GenesisKV
+ * LoadBalancerOptions configure how a load balancer domain routes traffic to its nodes.
+ *
+ * @generated from message skip.ironbird.LoadBalancerOptions
  */
-export class GenesisKV extends Message<GenesisKV> {
+export class LoadBalancerOptions extends Message<LoadBalancerOptions> {
   /**
-   * @generated from field: string key = 1;
+   * take nodes out of rotation while they catch up (http) or their gRPC server doesn't answer (grpc)
+   *
+   * @generated from field: bool health_check = 1;
    */
-  key = "";
+  healthCheck = false;
 
   /**
-   * @generated from field: string value = 2;
+   * Caddy lb_policy, e.g. round_robin or least_conn
+   *
+   * @generated from field: string policy = 2;
    */
-  value = "";
+  policy = "";
 
-  constructor(data?: PartialMessage<GenesisKV>) {
+  /**
+   * requests per second per client IP, requires a load balancer image with the caddy-ratelimit module
+   *
+   * @generated from field: int32 rate_limit = 3;
+   */
+  rateLimit = 0;
+
+  /**
+   * e.g. 1MB
+   *
+   * @generated from field: string max_request_body_size = 4;
+   */
+  maxRequestBodySize = "";
+
+  constructor(data?: PartialMessage<LoadBalancerOptions>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "skip.ironbird.GenesisKV";
+  static readonly typeName = "skip.ironbird.LoadBalancerOptions";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "health_check", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "policy", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "rate_limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "max_request_body_size", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenesisKV {
-    return new GenesisKV().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LoadBalancerOptions {
+    return new LoadBalancerOptions().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GenesisKV {
-    return new GenesisKV().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LoadBalancerOptions {
+    return new LoadBalancerOptions().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GenesisKV {
-    return new GenesisKV().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LoadBalancerOptions {
+    return new LoadBalancerOptions().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GenesisKV | PlainMessage<GenesisKV> | undefined, b: GenesisKV | PlainMessage<GenesisKV> | undefined): boolean {
-    return proto3.util.equals(GenesisKV, a, b);
+  static equals(a: LoadBalancerOptions | PlainMessage<LoadBalancerOptions> | undefined, b: LoadBalancerOptions | PlainMessage<LoadBalancerOptions> | undefined): boolean {
+    return proto3.util.equals(LoadBalancerOptions, a, b);
   }
 }
 
 /**
- * @generated from message skip.ironbird.RegionConfig
+ * @generated from message skip.ironbird.LoadBalancerDomainOptions
  */
-export class RegionConfig extends Message<RegionConfig> {
-  /**
-   * @generated from field: string name = 1;
-   */
-  name = "";
-
+export class LoadBalancerDomainOptions extends Message<LoadBalancerDomainOptions> {
   /**
-   * @generated from field: uint64 num_of_nodes = 2;
+   * rpc, lcd, grpc, evmrpc or evmws
+   *
+   * @generated from field: string domain_type = 1;
    */
-  numOfNodes = protoInt64.zero;
+  domainType = "";
 
   /**
-   * @generated from field: uint64 num_of_validators = 3;
+   * @generated from field: skip.ironbird.LoadBalancerOptions options = 2;
    */
-  numOfValidators = protoInt64.zero;
+  options?: LoadBalancerOptions;
 
-  constructor(data?: PartialMessage<RegionConfig>) {
+  constructor(data?: PartialMessage<LoadBalancerDomainOptions>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "skip.ironbird.RegionConfig";
+  static readonly typeName = "skip.ironbird.LoadBalancerDomainOptions";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "num_of_nodes", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 3, name: "num_of_validators", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 1, name: "domain_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "options", kind: "message", T: LoadBalancerOptions },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RegionConfig {
-    return new RegionConfig().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LoadBalancerDomainOptions {
+    return new LoadBalancerDomainOptions().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RegionConfig {
-    return new RegionConfig().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LoadBalancerDomainOptions {
+    return new LoadBalancerDomainOptions().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RegionConfig {
-    return new RegionConfig().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LoadBalancerDomainOptions {
+    return new LoadBalancerDomainOptions().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RegionConfig | PlainMessage<RegionConfig> | undefined, b: RegionConfig | PlainMessage<RegionConfig> | undefined): boolean {
-    return proto3.util.equals(RegionConfig, a, b);
+  static equals(a: LoadBalancerDomainOptions | PlainMessage<LoadBalancerDomainOptions> | undefined, b: LoadBalancerDomainOptions | PlainMessage<LoadBalancerDomainOptions> | undefined): boolean {
+    return proto3.util.equals(LoadBalancerDomainOptions, a, b);
   }
 }
 
 /**
- * @generated from message skip.ironbird.ChainConfig
+ * @generated from message skip.ironbird.LoadBalancerSpec
  */
-export class ChainConfig extends Message<ChainConfig> {
+export class LoadBalancerSpec extends Message<LoadBalancerSpec> {
   /**
-   * @generated from field: string name = 1;
+   * options of every domain without domain_options
+   *
+   * @generated from field: skip.ironbird.LoadBalancerOptions options = 1;
    */
-  name = "";
+  options?: LoadBalancerOptions;
 
   /**
-   * @generated from field: uint64 num_of_nodes = 2;
+   * @generated from field: repeated skip.ironbird.LoadBalancerDomainOptions domain_options = 2;
    */
-  numOfNodes = protoInt64.zero;
+  domainOptions: LoadBalancerDomainOptions[] = [];
 
   /**
-   * @generated from field: uint64 num_of_validators = 3;
+   * only route traffic to full nodes
+   *
+   * @generated from field: bool exclude_validators = 3;
    */
-  numOfValidators = protoInt64.zero;
+  excludeValidators = false;
 
-  /**
-   * @generated from field: repeated skip.ironbird.GenesisKV genesis_modifications = 4;
-   */
-  genesisModifications: GenesisKV[] = [];
+  constructor(data?: PartialMessage<LoadBalancerSpec>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
 
-  /**
-   * @generated from field: string image = 5;
-   */
-  image = "";
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "skip.ironbird.LoadBalancerSpec";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "options", kind: "message", T: LoadBalancerOptions },
+    { no: 2, name: "domain_options", kind: "message", T: LoadBalancerDomainOptions, repeated: true },
+    { no: 3, name: "exclude_validators", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
 
-  /**
-   * @generated from field: string custom_app_config = 6;
-   */
-  customAppConfig = "";
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LoadBalancerSpec {
+    return new LoadBalancerSpec().fromBinary(bytes, options);
+  }
 
-  /**
-   * @generated from field: string custom_consensus_config = 7;
-   */
-  customConsensusConfig = "";
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LoadBalancerSpec {
+    return new LoadBalancerSpec().fromJson(jsonValue, options);
+  }
 
-  /**
-   * @generated from field: string custom_client_config = 8;
-   */
-  customClientConfig = "";
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LoadBalancerSpec {
+    return new LoadBalancerSpec().fromJsonString(jsonString, options);
+  }
 
+  static equals(a: LoadBalancerSpec | PlainMessage<LoadBalancerSpec> | undefined, b: LoadBalancerSpec | PlainMessage<LoadBalancerSpec> | undefined): boolean {
+    return proto3.util.equals(LoadBalancerSpec, a, b);
+  }
+}
+
+/**
+ * ChainSource builds the chain from a different git remote and/or with local changes applied on top of the sha.
+ *
+ * @generated from message skip.ironbird.ChainSource
+ */
+export class ChainSource extends Message<ChainSource> {
   /**
-   * @generated from field: bool set_seed_node = 9;
+   * https url of the repository, e.g. a fork.
+   *
+   * @generated from field: string git_url = 1;
    */
-  setSeedNode = false;
+  gitUrl = "";
 
   /**
-   * @generated from field: bool set_persistent_peers = 10;
+   * Optional: name of the builder's auth_env_configs entry holding the token git_url is cloned with.
+   *
+   * @generated from field: string git_auth = 2;
    */
-  setPersistentPeers = false;
+  gitAuth = "";
 
   /**
-   * @generated from field: repeated skip.ironbird.RegionConfig region_configs = 11;
+   * Optional: git diff applied on top of the sha.
+   *
+   * @generated from field: bytes patch = 3;
    */
-  regionConfigs: RegionConfig[] = [];
+  patch = new Uint8Array(0);
 
   /**
-   * @generated from field: string version = 12;
+   * Optional: gzipped tarball of source files extracted over the checkout of the sha.
+   *
+   * @generated from field: bytes tarball = 4;
    */
-  version = "";
+  tarball = new Uint8Array(0);
 
-  constructor(data?: PartialMessage<ChainConfig>) {
+  constructor(data?: PartialMessage<ChainSource>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "skip.ironbird.ChainConfig";
+  static readonly typeName = "skip.ironbird.ChainSource";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "num_of_nodes", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 3, name: "num_of_validators", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
-    { no: 4, name: "genesis_modifications", kind: "message", T: GenesisKV, repeated: true },
-    { no: 5, name: "image", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 6, name: "custom_app_config", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 7, name: "custom_consensus_config", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 8, name: "custom_client_config", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 9, name: "set_seed_node", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 10, name: "set_persistent_peers", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 11, name: "region_configs", kind: "message", T: RegionConfig, repeated: true },
-    { no: 12, name: "version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "git_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "git_auth", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "patch", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 4, name: "tarball", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChainConfig {
-    return new ChainConfig().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChainSource {
+    return new ChainSource().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChainConfig {
-    return new ChainConfig().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChainSource {
+    return new ChainSource().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChainConfig {
-    return new ChainConfig().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChainSource {
+    return new ChainSource().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ChainConfig | PlainMessage<ChainConfig> | undefined, b: ChainConfig | PlainMessage<ChainConfig> | undefined): boolean {
-    return proto3.util.equals(ChainConfig, a, b);
+  static equals(a: ChainSource | PlainMessage<ChainSource> | undefined, b: ChainSource | PlainMessage<ChainSource> | undefined): boolean {
+    return proto3.util.equals(ChainSource, a, b);
   }
 }
 
 /**
- * @generated from message skip.ironbird.GetWorkflowRequest
+ * GoModReplace replaces a module of the chain's go.mod, e.g. github.com/cosmos/iavl with github.com/cosmos/iavl@v1.2.6
+ * or a fork like https://github.com/org/iavl@<sha>.
+ *
+ * @generated from message skip.ironbird.GoModReplace
  */
-export class GetWorkflowRequest extends Message<GetWorkflowRequest> {
+export class GoModReplace extends Message<GoModReplace> {
   /**
-   * @generated from field: string workflow_id = 1;
+   * @generated from field: string module = 1;
    */
-  workflowId = "";
+  module = "";
 
-  constructor(data?: PartialMessage<GetWorkflowRequest>) {
+  /**
+   * @generated from field: string replacement = 2;
+   */
+  replacement = "";
+
+  constructor(data?: PartialMessage<GoModReplace>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "skip.ironbird.GetWorkflowRequest";
+  static readonly typeName = "skip.ironbird.GoModReplace";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "workflow_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "module", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "replacement", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetWorkflowRequest {
-    return new GetWorkflowRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GoModReplace {
+    return new GoModReplace().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetWorkflowRequest {
-    return new GetWorkflowRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GoModReplace {
+    return new GoModReplace().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetWorkflowRequest {
-    return new GetWorkflowRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GoModReplace {
+    return new GoModReplace().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetWorkflowRequest | PlainMessage<GetWorkflowRequest> | undefined, b: GetWorkflowRequest | PlainMessage<GetWorkflowRequest> | undefined): boolean {
-    return proto3.util.equals(GetWorkflowRequest, a, b);
+  static equals(a: GoModReplace | PlainMessage<GoModReplace> | undefined, b: GoModReplace | PlainMessage<GoModReplace> | undefined): boolean {
+    return proto3.util.equals(GoModReplace, a, b);
   }
 }
 
 /**
- * @generated from message skip.ironbird.ListWorkflowsRequest
+ * Fault is injected into a validator of the chain once the testnet has been running for a delay.
+ *
+ * @generated from message skip.ironbird.Fault
  */
-export class ListWorkflowsRequest extends Message<ListWorkflowsRequest> {
+export class Fault extends Message<Fault> {
   /**
-   * @generated from field: int32 limit = 1;
+   * type is "double-sign".
+   *
+   * @generated from field: string type = 1;
    */
-  limit = 0;
+  type = "";
 
   /**
-   * @generated from field: int32 offset = 2;
+   * after is the delay after the testnet launched, e.g. "5m".
+   *
+   * @generated from field: string after = 2;
    */
-  offset = 0;
+  after = "";
 
-  constructor(data?: PartialMessage<ListWorkflowsRequest>) {
+  /**
+   * @generated from field: uint32 validator = 3;
+   */
+  validator = 0;
+
+  /**
+   * Optional: how long to wait for the chain to react to the fault, e.g. "10m".
+   *
+   * @generated from field: string timeout = 4;
+   */
+  timeout = "";
+
+  constructor(data?: PartialMessage<Fault>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "skip.ironbird.ListWorkflowsRequest";
+  static readonly typeName = "skip.ironbird.Fault";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 2, name: "offset", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 1, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "after", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "validator", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "timeout", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWorkflowsRequest {
-    return new ListWorkflowsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Fault {
+    return new Fault().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWorkflowsRequest {
-    return new ListWorkflowsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Fault {
+    return new Fault().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWorkflowsRequest {
-    return new ListWorkflowsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Fault {
+    return new Fault().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListWorkflowsRequest | PlainMessage<ListWorkflowsRequest> | undefined, b: ListWorkflowsRequest | PlainMessage<ListWorkflowsRequest> | undefined): boolean {
-    return proto3.util.equals(ListWorkflowsRequest, a, b);
+  static equals(a: Fault | PlainMessage<Fault> | undefined, b: Fault | PlainMessage<Fault> | undefined): boolean {
+    return proto3.util.equals(Fault, a, b);
   }
 }
 
 /**
- * @generated from message skip.ironbird.CancelWorkflowRequest
+ * @generated from message skip.ironbird.AdditionalChain
  */
-export class CancelWorkflowRequest extends Message<CancelWorkflowRequest> {
+export class AdditionalChain extends Message<AdditionalChain> {
   /**
-   * @generated from field: string workflow_id = 1;
+   * @generated from field: string repo = 1;
    */
-  workflowId = "";
+  repo = "";
 
-  constructor(data?: PartialMessage<CancelWorkflowRequest>) {
+  /**
+   * Optional: defaults to the sha of the workflow.
+   *
+   * @generated from field: string sha = 2;
+   */
+  sha = "";
+
+  /**
+   * @generated from field: bool isEvmChain = 3;
+   */
+  isEvmChain = false;
+
+  /**
+   * @generated from field: skip.ironbird.ChainConfig chain_config = 4;
+   */
+  chainConfig?: ChainConfig;
+
+  constructor(data?: PartialMessage<AdditionalChain>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "skip.ironbird.CancelWorkflowRequest";
+  static readonly typeName = "skip.ironbird.AdditionalChain";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "workflow_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "repo", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "sha", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "isEvmChain", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "chain_config", kind: "message", T: ChainConfig },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CancelWorkflowRequest {
-    return new CancelWorkflowRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AdditionalChain {
+    return new AdditionalChain().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CancelWorkflowRequest {
-    return new CancelWorkflowRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AdditionalChain {
+    return new AdditionalChain().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CancelWorkflowRequest {
-    return new CancelWorkflowRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AdditionalChain {
+    return new AdditionalChain().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CancelWorkflowRequest | PlainMessage<CancelWorkflowRequest> | undefined, b: CancelWorkflowRequest | PlainMessage<CancelWorkflowRequest> | undefined): boolean {
-    return proto3.util.equals(CancelWorkflowRequest, a, b);
+  static equals(a: AdditionalChain | PlainMessage<AdditionalChain> | undefined, b: AdditionalChain | PlainMessage<AdditionalChain> | undefined): boolean {
+    return proto3.util.equals(AdditionalChain, a, b);
   }
 }
 
 /**
- * @generated from message skip.ironbird.SignalWorkflowRequest
+ * @generated from message skip.ironbird.Relayer
  */
-export class SignalWorkflowRequest extends Message<SignalWorkflowRequest> {
+export class Relayer extends Message<Relayer> {
   /**
-   * @generated from field: string workflow_id = 1;
+   * Optional: Hermes image.
+   *
+   * @generated from field: string image = 1;
    */
-  workflowId = "";
+  image = "";
 
   /**
-   * @generated from field: string signal_name = 2;
+   * Optional: send IBC transfers over every channel.
+   *
+   * @generated from field: skip.ironbird.IBCTransferLoad ibc_transfer_load = 2;
    */
-  signalName = "";
+  ibcTransferLoad?: IBCTransferLoad;
 
-  constructor(data?: PartialMessage<SignalWorkflowRequest>) {
+  constructor(data?: PartialMessage<Relayer>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "skip.ironbird.SignalWorkflowRequest";
+  static readonly typeName = "skip.ironbird.Relayer";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "workflow_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "signal_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "image", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "ibc_transfer_load", kind: "message", T: IBCTransferLoad },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SignalWorkflowRequest {
-    return new SignalWorkflowRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Relayer {
+    return new Relayer().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SignalWorkflowRequest {
-    return new SignalWorkflowRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Relayer {
+    return new Relayer().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SignalWorkflowRequest {
-    return new SignalWorkflowRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Relayer {
+    return new Relayer().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SignalWorkflowRequest | PlainMessage<SignalWorkflowRequest> | undefined, b: SignalWorkflowRequest | PlainMessage<SignalWorkflowRequest> | undefined): boolean {
-    return proto3.util.equals(SignalWorkflowRequest, a, b);
+  static equals(a: Relayer | PlainMessage<Relayer> | undefined, b: Relayer | PlainMessage<Relayer> | undefined): boolean {
+    return proto3.util.equals(Relayer, a, b);
   }
 }
 
 /**
- * @generated from message skip.ironbird.RunLoadTestRequest
+ * @generated from message skip.ironbird.IBCTransferLoad
  */
-export class RunLoadTestRequest extends Message<RunLoadTestRequest> {
+export class IBCTransferLoad extends Message<IBCTransferLoad> {
   /**
-   * @generated from field: string workflow_id = 1;
+   * @generated from field: string duration = 1;
    */
-  workflowId = "";
+  duration = "";
 
   /**
-   * @generated from field: string load_test_spec = 2;
+   * @generated from field: uint64 amount = 2;
    */
-  loadTestSpec = "";
+  amount = protoInt64.zero;
 
-  constructor(data?: PartialMessage<RunLoadTestRequest>) {
+  /**
+   * @generated from field: int32 msgs_per_tx = 3;
+   */
+  msgsPerTx = 0;
+
+  constructor(data?: PartialMessage<IBCTransferLoad>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "skip.ironbird.RunLoadTestRequest";
+  static readonly typeName = "skip.ironbird.IBCTransferLoad";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "workflow_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "load_test_spec", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "duration", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "amount", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "msgs_per_tx", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RunLoadTestRequest {
-    return new RunLoadTestRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IBCTransferLoad {
+    return new IBCTransferLoad().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RunLoadTestRequest {
-    return new RunLoadTestRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IBCTransferLoad {
+    return new IBCTransferLoad().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RunLoadTestRequest {
-    return new RunLoadTestRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IBCTransferLoad {
+    return new IBCTransferLoad().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RunLoadTestRequest | PlainMessage<RunLoadTestRequest> | undefined, b: RunLoadTestRequest | PlainMessage<RunLoadTestRequest> | undefined): boolean {
-    return proto3.util.equals(RunLoadTestRequest, a, b);
+  static equals(a: IBCTransferLoad | PlainMessage<IBCTransferLoad> | undefined, b: IBCTransferLoad | PlainMessage<IBCTransferLoad> | undefined): boolean {
+    return proto3.util.equals(IBCTransferLoad, a, b);
   }
 }
 
 /**
- * @generated from message skip.ironbird.WorkflowResponse
+ * @generated from message skip.ironbird.CustomGenesis
  */
-export class WorkflowResponse extends Message<WorkflowResponse> {
+export class CustomGenesis extends Message<CustomGenesis> {
   /**
-   * @generated from field: string workflow_id = 1;
+   * http(s) URL of the genesis, optionally gzipped.
+   *
+   * @generated from field: string genesis_url = 1;
    */
-  workflowId = "";
+  genesisUrl = "";
 
-  constructor(data?: PartialMessage<WorkflowResponse>) {
+  /**
+   * Optional: expected sha256 digest of the genesis artifact.
+   *
+   * @generated from field: string genesis_sha256 = 2;
+   */
+  genesisSha256 = "";
+
+  /**
+   * Optional: URL of a JSON array of priv_validator_key.json files
+   * assigned to the validators in order.
+   *
+   * @generated from field: string validator_keys_url = 3;
+   */
+  validatorKeysUrl = "";
+
+  /**
+   * Optional: expected sha256 digest of the validator keys artifact.
+   *
+   * @generated from field: string validator_keys_sha256 = 4;
+   */
+  validatorKeysSha256 = "";
+
+  constructor(data?: PartialMessage<CustomGenesis>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "skip.ironbird.WorkflowResponse";
+  static readonly typeName = "skip.ironbird.CustomGenesis";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "workflow_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "genesis_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "genesis_sha256", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "validator_keys_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "validator_keys_sha256", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WorkflowResponse {
-    return new WorkflowResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CustomGenesis {
+    return new CustomGenesis().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WorkflowResponse {
-    return new WorkflowResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CustomGenesis {
+    return new CustomGenesis().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WorkflowResponse {
-    return new WorkflowResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CustomGenesis {
+    return new CustomGenesis().fromJsonString(jsonString, options);
   }
 
-  static equals(a: WorkflowResponse | PlainMessage<WorkflowResponse> | undefined, b: WorkflowResponse | PlainMessage<WorkflowResponse> | undefined): boolean {
-    return proto3.util.equals(WorkflowResponse, a, b);
+  static equals(a: CustomGenesis | PlainMessage<CustomGenesis> | undefined, b: CustomGenesis | PlainMessage<CustomGenesis> | undefined): boolean {
+    return proto3.util.equals(CustomGenesis, a, b);
   }
 }
 
 /**
- * @generated from message skip.ironbird.Node
+ * @generated from message skip.ironbird.GenesisMigration
  */
-export class Node extends Message<Node> {
-  /**
-   * @generated from field: string name = 1;
-   */
-  name = "";
-
-  /**
-   * @generated from field: string address = 2;
-   */
-  address = "";
-
-  /**
-   * @generated from field: string rpc = 3;
-   */
-  rpc = "";
-
+export class GenesisMigration extends Message<GenesisMigration> {
   /**
-   * @generated from field: string lcd = 4;
+   * @generated from field: uint64 export_height = 1;
    */
-  lcd = "";
+  exportHeight = protoInt64.zero;
 
   /**
-   * @generated from field: string grpc = 5;
+   * Optional: SHA to build the image the chain is restarted on.
+   *
+   * @generated from field: string sha = 2;
    */
-  grpc = "";
+  sha = "";
 
   /**
-   * @generated from field: string evmrpc = 6;
+   * Optional: binary subcommand run against the exported genesis,
+   * e.g. ["genesis", "migrate", "v0.50"].
+   *
+   * @generated from field: repeated string migration_command = 3;
    */
-  evmrpc = "";
+  migrationCommand: string[] = [];
 
   /**
-   * @generated from field: string evmws = 7;
+   * @generated from field: repeated skip.ironbird.GenesisKV genesis_modifications = 4;
    */
-  evmws = "";
+  genesisModifications: GenesisKV[] = [];
 
-  constructor(data?: PartialMessage<Node>) {
+  constructor(data?: PartialMessage<GenesisMigration>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "skip.ironbird.Node";
+  static readonly typeName = "skip.ironbird.GenesisMigration";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "address", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "rpc", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "lcd", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 5, name: "grpc", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 6, name: "evmrpc", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 7, name: "evmws", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "export_height", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "sha", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "migration_command", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "genesis_modifications", kind: "message", T: GenesisKV, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Node {
-    return new Node().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenesisMigration {
+    return new GenesisMigration().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Node {
-    return new Node().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GenesisMigration {
+    return new GenesisMigration().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Node {
-    return new Node().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GenesisMigration {
+    return new GenesisMigration().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Node | PlainMessage<Node> | undefined, b: Node | PlainMessage<Node> | undefined): boolean {
-    return proto3.util.equals(Node, a, b);
+  static equals(a: GenesisMigration | PlainMessage<GenesisMigration> | undefined, b: GenesisMigration | PlainMessage<GenesisMigration> | undefined): boolean {
+    return proto3.util.equals(GenesisMigration, a, b);
   }
 }
 
 /**
- * @generated from message skip.ironbird.WalletInfo
+ * @generated from message skip.ironbird.GenesisKV
  */
-export class WalletInfo extends Message<WalletInfo> {
-  /**
-   * @generated from field: string faucet_address = 1;
-   */
-  faucetAddress = "";
-
-  /**
-   * @generated from field: string faucet_mnemonic = 2;
-   */
-  faucetMnemonic = "";
-
+export class GenesisKV extends Message<GenesisKV> {
   /**
-   * @generated from field: repeated string user_addresses = 3;
+   * @generated from field: string key = 1;
    */
-  userAddresses: string[] = [];
+  key = "";
 
   /**
-   * @generated from field: repeated string user_mnemonics = 4;
+   * @generated from field: string value = 2;
    */
-  userMnemonics: string[] = [];
+  value = "";
 
-  constructor(data?: PartialMessage<WalletInfo>) {
+  constructor(data?: PartialMessage<GenesisKV>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "skip.ironbird.WalletInfo";
+  static readonly typeName = "skip.ironbird.GenesisKV";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "faucet_address", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "faucet_mnemonic", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "user_addresses", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
-    { no: 4, name: "user_mnemonics", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 1, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WalletInfo {
-    return new WalletInfo().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenesisKV {
+    return new GenesisKV().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WalletInfo {
-    return new WalletInfo().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GenesisKV {
+    return new GenesisKV().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WalletInfo {
-    return new WalletInfo().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GenesisKV {
+    return new GenesisKV().fromJsonString(jsonString, options);
   }
 
-  static equals(a: WalletInfo | PlainMessage<WalletInfo> | undefined, b: WalletInfo | PlainMessage<WalletInfo> | undefined): boolean {
-    return proto3.util.equals(WalletInfo, a, b);
+  static equals(a: GenesisKV | PlainMessage<GenesisKV> | undefined, b: GenesisKV | PlainMessage<GenesisKV> | undefined): boolean {
+    return proto3.util.equals(GenesisKV, a, b);
   }
 }
 
 /**
- * @generated from message skip.ironbird.Workflow
+ * @generated from message skip.ironbird.RegionConfig
  */
-export class Workflow extends Message<Workflow> {
+export class RegionConfig extends Message<RegionConfig> {
   /**
-   * @generated from field: string workflow_id = 1;
+   * @generated from field: string name = 1;
    */
-  workflowId = "";
+  name = "";
 
   /**
-   * @generated from field: string status = 2;
+   * @generated from field: uint64 num_of_nodes = 2;
    */
-  status = "";
+  numOfNodes = protoInt64.zero;
 
   /**
-   * @generated from field: repeated skip.ironbird.Node nodes = 3;
+   * @generated from field: uint64 num_of_validators = 3;
    */
-  nodes: Node[] = [];
+  numOfValidators = protoInt64.zero;
 
   /**
-   * @generated from field: repeated skip.ironbird.Node validators = 4;
+   * Optional: sha of the repo every validator and node of the region runs.
+   *
+   * @generated from field: string sha = 4;
    */
-  validators: Node[] = [];
+  sha = "";
 
   /**
-   * @generated from field: repeated skip.ironbird.Node load_balancers = 5;
+   * Optional: JSON config overrides applied to every validator and node of the region.
+   *
+   * @generated from field: string custom_app_config = 5;
    */
-  loadBalancers: Node[] = [];
+  customAppConfig = "";
 
   /**
-   * @generated from field: map<string, string> monitoring = 6;
+   * @generated from field: string custom_consensus_config = 6;
    */
-  monitoring: { [key: string]: string } = {};
+  customConsensusConfig = "";
 
   /**
-   * @generated from field: skip.ironbird.CreateWorkflowRequest config = 7;
+   * @generated from field: string custom_client_config = 7;
    */
-  config?: CreateWorkflowRequest;
+  customClientConfig = "";
 
+  constructor(data?: PartialMessage<RegionConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "skip.ironbird.RegionConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "num_of_nodes", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "num_of_validators", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "sha", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "custom_app_config", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "custom_consensus_config", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "custom_client_config", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RegionConfig {
+    return new RegionConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RegionConfig {
+    return new RegionConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RegionConfig {
+    return new RegionConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RegionConfig | PlainMessage<RegionConfig> | undefined, b: RegionConfig | PlainMessage<RegionConfig> | undefined): boolean {
+    return proto3.util.equals(RegionConfig, a, b);
+  }
+}
+
+/**
+ * ConfigOverride applies JSON config overrides to every validator or full node, or only to the given indices.
+ *
+ * @generated from message skip.ironbird.ConfigOverride
+ */
+export class ConfigOverride extends Message<ConfigOverride> {
   /**
-   * @generated from field: string load_test_spec = 17;
+   * role is either "validator" or "node".
+   *
+   * @generated from field: string role = 1;
    */
-  loadTestSpec = "";
+  role = "";
 
   /**
-   * @generated from field: skip.ironbird.WalletInfo wallets = 18;
+   * @generated from field: repeated uint32 indices = 2;
    */
-  wallets?: WalletInfo;
+  indices: number[] = [];
 
   /**
-   * @generated from field: string provider = 19;
+   * @generated from field: string custom_app_config = 3;
    */
-  provider = "";
+  customAppConfig = "";
 
   /**
-   * @generated from field: string start_time = 20;
+   * @generated from field: string custom_consensus_config = 4;
    */
-  startTime = "";
+  customConsensusConfig = "";
 
   /**
-   * @generated from field: string end_time = 21;
+   * @generated from field: string custom_client_config = 5;
    */
-  endTime = "";
+  customClientConfig = "";
 
-  constructor(data?: PartialMessage<Workflow>) {
+  constructor(data?: PartialMessage<ConfigOverride>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "skip.ironbird.Workflow";
+  static readonly typeName = "skip.ironbird.ConfigOverride";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "workflow_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "nodes", kind: "message", T: Node, repeated: true },
-    { no: 4, name: "validators", kind: "message", T: Node, repeated: true },
-    { no: 5, name: "load_balancers", kind: "message", T: Node, repeated: true },
-    { no: 6, name: "monitoring", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
-    { no: 7, name: "config", kind: "message", T: CreateWorkflowRequest },
-    { no: 17, name: "load_test_spec", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 18, name: "wallets", kind: "message", T: WalletInfo },
-    { no: 19, name: "provider", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 20, name: "start_time", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 21, name: "end_time", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "role", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "indices", kind: "scalar", T: 13 /* ScalarType.UINT32 */, repeated: true },
+    { no: 3, name: "custom_app_config", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "custom_consensus_config", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "custom_client_config", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Workflow {
-    return new Workflow().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConfigOverride {
+    return new ConfigOverride().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Workflow {
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConfigOverride {
+    return new ConfigOverride().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConfigOverride {
+    return new ConfigOverride().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConfigOverride | PlainMessage<ConfigOverride> | undefined, b: ConfigOverride | PlainMessage<ConfigOverride> | undefined): boolean {
+    return proto3.util.equals(ConfigOverride, a, b);
+  }
+}
+
+/**
+ * ImageGroup runs a subset of the validators and nodes on a different sha of the repo.
+ * Groups are assigned to validators and nodes in order.
+ *
+ * @generated from message skip.ironbird.ImageGroup
+ */
+export class ImageGroup extends Message<ImageGroup> {
+  /**
+   * @generated from field: string sha = 1;
+   */
+  sha = "";
+
+  /**
+   * @generated from field: uint64 num_of_validators = 2;
+   */
+  numOfValidators = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 num_of_nodes = 3;
+   */
+  numOfNodes = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ImageGroup>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "skip.ironbird.ImageGroup";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "sha", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "num_of_validators", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "num_of_nodes", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ImageGroup {
+    return new ImageGroup().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ImageGroup {
+    return new ImageGroup().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ImageGroup {
+    return new ImageGroup().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ImageGroup | PlainMessage<ImageGroup> | undefined, b: ImageGroup | PlainMessage<ImageGroup> | undefined): boolean {
+    return proto3.util.equals(ImageGroup, a, b);
+  }
+}
+
+/**
+ * @generated from message skip.ironbird.ChainConfig
+ */
+export class ChainConfig extends Message<ChainConfig> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: uint64 num_of_nodes = 2;
+   */
+  numOfNodes = protoInt64.zero;
+
+  /**
+   * @generated from field: uint64 num_of_validators = 3;
+   */
+  numOfValidators = protoInt64.zero;
+
+  /**
+   * @generated from field: repeated skip.ironbird.GenesisKV genesis_modifications = 4;
+   */
+  genesisModifications: GenesisKV[] = [];
+
+  /**
+   * @generated from field: string image = 5;
+   */
+  image = "";
+
+  /**
+   * @generated from field: string custom_app_config = 6;
+   */
+  customAppConfig = "";
+
+  /**
+   * @generated from field: string custom_consensus_config = 7;
+   */
+  customConsensusConfig = "";
+
+  /**
+   * @generated from field: string custom_client_config = 8;
+   */
+  customClientConfig = "";
+
+  /**
+   * @generated from field: bool set_seed_node = 9;
+   */
+  setSeedNode = false;
+
+  /**
+   * @generated from field: bool set_persistent_peers = 10;
+   */
+  setPersistentPeers = false;
+
+  /**
+   * @generated from field: repeated skip.ironbird.RegionConfig region_configs = 11;
+   */
+  regionConfigs: RegionConfig[] = [];
+
+  /**
+   * @generated from field: string version = 12;
+   */
+  version = "";
+
+  /**
+   * @generated from field: repeated skip.ironbird.ImageGroup image_groups = 13;
+   */
+  imageGroups: ImageGroup[] = [];
+
+  /**
+   * @generated from field: repeated skip.ironbird.ConfigOverride config_overrides = 14;
+   */
+  configOverrides: ConfigOverride[] = [];
+
+  /**
+   * @generated from field: skip.ironbird.StakeDistribution stake_distribution = 15;
+   */
+  stakeDistribution?: StakeDistribution;
+
+  /**
+   * Optional: replaces set_seed_node and set_persistent_peers.
+   *
+   * @generated from field: skip.ironbird.Topology topology = 16;
+   */
+  topology?: Topology;
+
+  /**
+   * Optional: signs the votes of every validator in separate signer tasks.
+   *
+   * @generated from field: skip.ironbird.RemoteSigner remote_signer = 17;
+   */
+  remoteSigner?: RemoteSigner;
+
+  constructor(data?: PartialMessage<ChainConfig>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "skip.ironbird.ChainConfig";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "num_of_nodes", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "num_of_validators", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "genesis_modifications", kind: "message", T: GenesisKV, repeated: true },
+    { no: 5, name: "image", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "custom_app_config", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "custom_consensus_config", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "custom_client_config", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "set_seed_node", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 10, name: "set_persistent_peers", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 11, name: "region_configs", kind: "message", T: RegionConfig, repeated: true },
+    { no: 12, name: "version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 13, name: "image_groups", kind: "message", T: ImageGroup, repeated: true },
+    { no: 14, name: "config_overrides", kind: "message", T: ConfigOverride, repeated: true },
+    { no: 15, name: "stake_distribution", kind: "message", T: StakeDistribution },
+    { no: 16, name: "topology", kind: "message", T: Topology },
+    { no: 17, name: "remote_signer", kind: "message", T: RemoteSigner },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChainConfig {
+    return new ChainConfig().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChainConfig {
+    return new ChainConfig().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChainConfig {
+    return new ChainConfig().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ChainConfig | PlainMessage<ChainConfig> | undefined, b: ChainConfig | PlainMessage<ChainConfig> | undefined): boolean {
+    return proto3.util.equals(ChainConfig, a, b);
+  }
+}
+
+/**
+ * RemoteSigner moves the consensus key of every validator into Horcrux signer tasks. With more than one share the
+ * key is threshold-split across the cosigners of the validator.
+ *
+ * @generated from message skip.ironbird.RemoteSigner
+ */
+export class RemoteSigner extends Message<RemoteSigner> {
+  /**
+   * image defaults to the Horcrux image.
+   *
+   * @generated from field: string image = 1;
+   */
+  image = "";
+
+  /**
+   * @generated from field: uint32 shares = 2;
+   */
+  shares = 0;
+
+  /**
+   * @generated from field: uint32 threshold = 3;
+   */
+  threshold = 0;
+
+  constructor(data?: PartialMessage<RemoteSigner>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "skip.ironbird.RemoteSigner";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "image", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "shares", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "threshold", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoteSigner {
+    return new RemoteSigner().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoteSigner {
+    return new RemoteSigner().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoteSigner {
+    return new RemoteSigner().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoteSigner | PlainMessage<RemoteSigner> | undefined, b: RemoteSigner | PlainMessage<RemoteSigner> | undefined): boolean {
+    return proto3.util.equals(RemoteSigner, a, b);
+  }
+}
+
+/**
+ * Topology determines the persistent peers of every node. Nodes are referenced as validator-<index> or node-<index>.
+ *
+ * @generated from message skip.ironbird.Topology
+ */
+export class Topology extends Message<Topology> {
+  /**
+   * type is one of "sentry", "ring", "k-regular" or "explicit".
+   *
+   * @generated from field: string type = 1;
+   */
+  type = "";
+
+  /**
+   * @generated from field: uint32 sentries_per_validator = 2;
+   */
+  sentriesPerValidator = 0;
+
+  /**
+   * @generated from field: uint32 degree = 3;
+   */
+  degree = 0;
+
+  /**
+   * @generated from field: int64 seed = 4;
+   */
+  seed = protoInt64.zero;
+
+  /**
+   * @generated from field: repeated skip.ironbird.TopologyPeers peers = 5;
+   */
+  peers: TopologyPeers[] = [];
+
+  constructor(data?: PartialMessage<Topology>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "skip.ironbird.Topology";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "sentries_per_validator", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "degree", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "seed", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "peers", kind: "message", T: TopologyPeers, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Topology {
+    return new Topology().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Topology {
+    return new Topology().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Topology {
+    return new Topology().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Topology | PlainMessage<Topology> | undefined, b: Topology | PlainMessage<Topology> | undefined): boolean {
+    return proto3.util.equals(Topology, a, b);
+  }
+}
+
+/**
+ * TopologyPeers are the persistent peers of a node in an explicit topology.
+ *
+ * @generated from message skip.ironbird.TopologyPeers
+ */
+export class TopologyPeers extends Message<TopologyPeers> {
+  /**
+   * @generated from field: string node = 1;
+   */
+  node = "";
+
+  /**
+   * @generated from field: repeated string peers = 2;
+   */
+  peers: string[] = [];
+
+  constructor(data?: PartialMessage<TopologyPeers>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "skip.ironbird.TopologyPeers";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "node", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "peers", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TopologyPeers {
+    return new TopologyPeers().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TopologyPeers {
+    return new TopologyPeers().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TopologyPeers {
+    return new TopologyPeers().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TopologyPeers | PlainMessage<TopologyPeers> | undefined, b: TopologyPeers | PlainMessage<TopologyPeers> | undefined): boolean {
+    return proto3.util.equals(TopologyPeers, a, b);
+  }
+}
+
+/**
+ * StakeDistribution skews the genesis self-delegations of the validators.
+ *
+ * @generated from message skip.ironbird.StakeDistribution
+ */
+export class StakeDistribution extends Message<StakeDistribution> {
+  /**
+   * type is one of "uniform", "explicit", "power-law", "zipf" or "top-n".
+   *
+   * @generated from field: string type = 1;
+   */
+  type = "";
+
+  /**
+   * amounts are the unscaled self-delegations of the validators for explicit distributions.
+   *
+   * @generated from field: repeated uint64 amounts = 2;
+   */
+  amounts: bigint[] = [];
+
+  /**
+   * @generated from field: double exponent = 3;
+   */
+  exponent = 0;
+
+  /**
+   * @generated from field: uint32 top_n = 4;
+   */
+  topN = 0;
+
+  /**
+   * top_share is the percentage of the stake held by the top_n validators.
+   *
+   * @generated from field: double top_share = 5;
+   */
+  topShare = 0;
+
+  constructor(data?: PartialMessage<StakeDistribution>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "skip.ironbird.StakeDistribution";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "amounts", kind: "scalar", T: 4 /* ScalarType.UINT64 */, repeated: true },
+    { no: 3, name: "exponent", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 4, name: "top_n", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "top_share", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StakeDistribution {
+    return new StakeDistribution().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StakeDistribution {
+    return new StakeDistribution().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StakeDistribution {
+    return new StakeDistribution().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StakeDistribution | PlainMessage<StakeDistribution> | undefined, b: StakeDistribution | PlainMessage<StakeDistribution> | undefined): boolean {
+    return proto3.util.equals(StakeDistribution, a, b);
+  }
+}
+
+/**
+ * @generated from message skip.ironbird.GetWorkflowRequest
+ */
+export class GetWorkflowRequest extends Message<GetWorkflowRequest> {
+  /**
+   * @generated from field: string workflow_id = 1;
+   */
+  workflowId = "";
+
+  constructor(data?: PartialMessage<GetWorkflowRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "skip.ironbird.GetWorkflowRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workflow_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetWorkflowRequest {
+    return new GetWorkflowRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetWorkflowRequest {
+    return new GetWorkflowRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetWorkflowRequest {
+    return new GetWorkflowRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetWorkflowRequest | PlainMessage<GetWorkflowRequest> | undefined, b: GetWorkflowRequest | PlainMessage<GetWorkflowRequest> | undefined): boolean {
+    return proto3.util.equals(GetWorkflowRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message skip.ironbird.ListWorkflowsRequest
+ */
+export class ListWorkflowsRequest extends Message<ListWorkflowsRequest> {
+  /**
+   * @generated from field: int32 limit = 1;
+   */
+  limit = 0;
+
+  /**
+   * @generated from field: int32 offset = 2;
+   */
+  offset = 0;
+
+  constructor(data?: PartialMessage<ListWorkflowsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "skip.ironbird.ListWorkflowsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "offset", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListWorkflowsRequest {
+    return new ListWorkflowsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListWorkflowsRequest {
+    return new ListWorkflowsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListWorkflowsRequest {
+    return new ListWorkflowsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListWorkflowsRequest | PlainMessage<ListWorkflowsRequest> | undefined, b: ListWorkflowsRequest | PlainMessage<ListWorkflowsRequest> | undefined): boolean {
+    return proto3.util.equals(ListWorkflowsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message skip.ironbird.CancelWorkflowRequest
+ */
+export class CancelWorkflowRequest extends Message<CancelWorkflowRequest> {
+  /**
+   * @generated from field: string workflow_id = 1;
+   */
+  workflowId = "";
+
+  constructor(data?: PartialMessage<CancelWorkflowRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "skip.ironbird.CancelWorkflowRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workflow_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CancelWorkflowRequest {
+    return new CancelWorkflowRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CancelWorkflowRequest {
+    return new CancelWorkflowRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CancelWorkflowRequest {
+    return new CancelWorkflowRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CancelWorkflowRequest | PlainMessage<CancelWorkflowRequest> | undefined, b: CancelWorkflowRequest | PlainMessage<CancelWorkflowRequest> | undefined): boolean {
+    return proto3.util.equals(CancelWorkflowRequest, a, b);
+  }
+}
+
+/**
+ * ForceTeardownRequest tears down the testnet of a workflow from its last saved provider state, terminating the
+ * workflow if it is still running. The response holds the ID of the teardown workflow
+ *
+ * @generated from message skip.ironbird.ForceTeardownRequest
+ */
+export class ForceTeardownRequest extends Message<ForceTeardownRequest> {
+  /**
+   * @generated from field: string workflow_id = 1;
+   */
+  workflowId = "";
+
+  constructor(data?: PartialMessage<ForceTeardownRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "skip.ironbird.ForceTeardownRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workflow_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ForceTeardownRequest {
+    return new ForceTeardownRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ForceTeardownRequest {
+    return new ForceTeardownRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ForceTeardownRequest {
+    return new ForceTeardownRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ForceTeardownRequest | PlainMessage<ForceTeardownRequest> | undefined, b: ForceTeardownRequest | PlainMessage<ForceTeardownRequest> | undefined): boolean {
+    return proto3.util.equals(ForceTeardownRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message skip.ironbird.SignalWorkflowRequest
+ */
+export class SignalWorkflowRequest extends Message<SignalWorkflowRequest> {
+  /**
+   * @generated from field: string workflow_id = 1;
+   */
+  workflowId = "";
+
+  /**
+   * @generated from field: string signal_name = 2;
+   */
+  signalName = "";
+
+  /**
+   * Optional: json encoded signal payload, e.g. {"sha": "...", "halt_height": 100}
+   * for the rolling_restart and halt_height_upgrade signals.
+   *
+   * @generated from field: string payload = 3;
+   */
+  payload = "";
+
+  constructor(data?: PartialMessage<SignalWorkflowRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "skip.ironbird.SignalWorkflowRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workflow_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "signal_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "payload", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SignalWorkflowRequest {
+    return new SignalWorkflowRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SignalWorkflowRequest {
+    return new SignalWorkflowRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SignalWorkflowRequest {
+    return new SignalWorkflowRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SignalWorkflowRequest | PlainMessage<SignalWorkflowRequest> | undefined, b: SignalWorkflowRequest | PlainMessage<SignalWorkflowRequest> | undefined): boolean {
+    return proto3.util.equals(SignalWorkflowRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message skip.ironbird.RunLoadTestRequest
+ */
+export class RunLoadTestRequest extends Message<RunLoadTestRequest> {
+  /**
+   * @generated from field: string workflow_id = 1;
+   */
+  workflowId = "";
+
+  /**
+   * @generated from field: string load_test_spec = 2;
+   */
+  loadTestSpec = "";
+
+  constructor(data?: PartialMessage<RunLoadTestRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "skip.ironbird.RunLoadTestRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workflow_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "load_test_spec", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RunLoadTestRequest {
+    return new RunLoadTestRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RunLoadTestRequest {
+    return new RunLoadTestRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RunLoadTestRequest {
+    return new RunLoadTestRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RunLoadTestRequest | PlainMessage<RunLoadTestRequest> | undefined, b: RunLoadTestRequest | PlainMessage<RunLoadTestRequest> | undefined): boolean {
+    return proto3.util.equals(RunLoadTestRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message skip.ironbird.WorkflowResponse
+ */
+export class WorkflowResponse extends Message<WorkflowResponse> {
+  /**
+   * @generated from field: string workflow_id = 1;
+   */
+  workflowId = "";
+
+  constructor(data?: PartialMessage<WorkflowResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "skip.ironbird.WorkflowResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workflow_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WorkflowResponse {
+    return new WorkflowResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WorkflowResponse {
+    return new WorkflowResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WorkflowResponse {
+    return new WorkflowResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WorkflowResponse | PlainMessage<WorkflowResponse> | undefined, b: WorkflowResponse | PlainMessage<WorkflowResponse> | undefined): boolean {
+    return proto3.util.equals(WorkflowResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message skip.ironbird.Node
+ */
+export class Node extends Message<Node> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string address = 2;
+   */
+  address = "";
+
+  /**
+   * @generated from field: string rpc = 3;
+   */
+  rpc = "";
+
+  /**
+   * @generated from field: string lcd = 4;
+   */
+  lcd = "";
+
+  /**
+   * @generated from field: string grpc = 5;
+   */
+  grpc = "";
+
+  /**
+   * @generated from field: string evmrpc = 6;
+   */
+  evmrpc = "";
+
+  /**
+   * @generated from field: string evmws = 7;
+   */
+  evmws = "";
+
+  /**
+   * image the node runs, nodes of image groups run a different image than the chain
+   *
+   * @generated from field: string image = 8;
+   */
+  image = "";
+
+  constructor(data?: PartialMessage<Node>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "skip.ironbird.Node";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "address", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "rpc", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "lcd", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "grpc", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "evmrpc", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "evmws", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "image", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Node {
+    return new Node().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Node {
+    return new Node().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Node {
+    return new Node().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Node | PlainMessage<Node> | undefined, b: Node | PlainMessage<Node> | undefined): boolean {
+    return proto3.util.equals(Node, a, b);
+  }
+}
+
+/**
+ * @generated from message skip.ironbird.WalletInfo
+ */
+export class WalletInfo extends Message<WalletInfo> {
+  /**
+   * @generated from field: string faucet_address = 1;
+   */
+  faucetAddress = "";
+
+  /**
+   * @generated from field: string faucet_mnemonic = 2;
+   */
+  faucetMnemonic = "";
+
+  /**
+   * @generated from field: repeated string user_addresses = 3;
+   */
+  userAddresses: string[] = [];
+
+  /**
+   * @generated from field: repeated string user_mnemonics = 4;
+   */
+  userMnemonics: string[] = [];
+
+  constructor(data?: PartialMessage<WalletInfo>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "skip.ironbird.WalletInfo";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "faucet_address", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "faucet_mnemonic", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "user_addresses", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "user_mnemonics", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WalletInfo {
+    return new WalletInfo().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WalletInfo {
+    return new WalletInfo().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WalletInfo {
+    return new WalletInfo().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WalletInfo | PlainMessage<WalletInfo> | undefined, b: WalletInfo | PlainMessage<WalletInfo> | undefined): boolean {
+    return proto3.util.equals(WalletInfo, a, b);
+  }
+}
+
+/**
+ * @generated from message skip.ironbird.Workflow
+ */
+export class Workflow extends Message<Workflow> {
+  /**
+   * @generated from field: string workflow_id = 1;
+   */
+  workflowId = "";
+
+  /**
+   * @generated from field: string status = 2;
+   */
+  status = "";
+
+  /**
+   * @generated from field: repeated skip.ironbird.Node nodes = 3;
+   */
+  nodes: Node[] = [];
+
+  /**
+   * @generated from field: repeated skip.ironbird.Node validators = 4;
+   */
+  validators: Node[] = [];
+
+  /**
+   * @generated from field: repeated skip.ironbird.Node load_balancers = 5;
+   */
+  loadBalancers: Node[] = [];
+
+  /**
+   * @generated from field: map<string, string> monitoring = 6;
+   */
+  monitoring: { [key: string]: string } = {};
+
+  /**
+   * @generated from field: skip.ironbird.CreateWorkflowRequest config = 7;
+   */
+  config?: CreateWorkflowRequest;
+
+  /**
+   * @generated from field: string load_test_spec = 17;
+   */
+  loadTestSpec = "";
+
+  /**
+   * @generated from field: skip.ironbird.WalletInfo wallets = 18;
+   */
+  wallets?: WalletInfo;
+
+  /**
+   * @generated from field: string provider = 19;
+   */
+  provider = "";
+
+  /**
+   * @generated from field: string start_time = 20;
+   */
+  startTime = "";
+
+  /**
+   * @generated from field: string end_time = 21;
+   */
+  endTime = "";
+
+  /**
+   * live state queried from running workflows
+   *
+   * @generated from field: string phase = 22;
+   */
+  phase = "";
+
+  /**
+   * @generated from field: string chain_id = 23;
+   */
+  chainId = "";
+
+  /**
+   * highest height reported by a node at the last health check of a long-running testnet
+   *
+   * @generated from field: uint64 height = 24;
+   */
+  height = protoInt64.zero;
+
+  /**
+   * @generated from field: repeated skip.ironbird.NodeHealth node_health = 25;
+   */
+  nodeHealth: NodeHealth[] = [];
+
+  /**
+   * @generated from field: string health_checked_at = 26;
+   */
+  healthCheckedAt = "";
+
+  /**
+   * @generated from field: skip.ironbird.LoadTestProgress load_test = 27;
+   */
+  loadTest?: LoadTestProgress;
+
+  /**
+   * empty for long-running testnets, which run until cancelled
+   *
+   * @generated from field: string expected_end_time = 28;
+   */
+  expectedEndTime = "";
+
+  constructor(data?: PartialMessage<Workflow>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "skip.ironbird.Workflow";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workflow_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "nodes", kind: "message", T: Node, repeated: true },
+    { no: 4, name: "validators", kind: "message", T: Node, repeated: true },
+    { no: 5, name: "load_balancers", kind: "message", T: Node, repeated: true },
+    { no: 6, name: "monitoring", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 7, name: "config", kind: "message", T: CreateWorkflowRequest },
+    { no: 17, name: "load_test_spec", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 18, name: "wallets", kind: "message", T: WalletInfo },
+    { no: 19, name: "provider", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 20, name: "start_time", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 21, name: "end_time", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 22, name: "phase", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 23, name: "chain_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 24, name: "height", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 25, name: "node_health", kind: "message", T: NodeHealth, repeated: true },
+    { no: 26, name: "health_checked_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 27, name: "load_test", kind: "message", T: LoadTestProgress },
+    { no: 28, name: "expected_end_time", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Workflow {
+    return new Workflow().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Workflow {
     return new Workflow().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Workflow {
-    return new Workflow().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Workflow {
+    return new Workflow().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Workflow | PlainMessage<Workflow> | undefined, b: Workflow | PlainMessage<Workflow> | undefined): boolean {
+    return proto3.util.equals(Workflow, a, b);
+  }
+}
+
+/**
+ * @generated from message skip.ironbird.NodeHealth
+ */
+export class NodeHealth extends Message<NodeHealth> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: uint64 height = 2;
+   */
+  height = protoInt64.zero;
+
+  /**
+   * empty if the node is healthy
+   *
+   * @generated from field: string error = 3;
+   */
+  error = "";
+
+  constructor(data?: PartialMessage<NodeHealth>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "skip.ironbird.NodeHealth";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "height", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 3, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): NodeHealth {
+    return new NodeHealth().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): NodeHealth {
+    return new NodeHealth().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): NodeHealth {
+    return new NodeHealth().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: NodeHealth | PlainMessage<NodeHealth> | undefined, b: NodeHealth | PlainMessage<NodeHealth> | undefined): boolean {
+    return proto3.util.equals(NodeHealth, a, b);
+  }
+}
+
+/**
+ * @generated from message skip.ironbird.LoadTestProgress
+ */
+export class LoadTestProgress extends Message<LoadTestProgress> {
+  /**
+   * @generated from field: string start_time = 1;
+   */
+  startTime = "";
+
+  /**
+   * empty while the load test is running
+   *
+   * @generated from field: string end_time = 2;
+   */
+  endTime = "";
+
+  /**
+   * @generated from field: string error = 3;
+   */
+  error = "";
+
+  /**
+   * @generated from field: int64 total_transactions = 4;
+   */
+  totalTransactions = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 included_transactions = 5;
+   */
+  includedTransactions = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 successful_transactions = 6;
+   */
+  successfulTransactions = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 failed_transactions = 7;
+   */
+  failedTransactions = protoInt64.zero;
+
+  /**
+   * @generated from field: double tps = 8;
+   */
+  tps = 0;
+
+  constructor(data?: PartialMessage<LoadTestProgress>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "skip.ironbird.LoadTestProgress";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "start_time", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "end_time", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "total_transactions", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "included_transactions", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "successful_transactions", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "failed_transactions", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "tps", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LoadTestProgress {
+    return new LoadTestProgress().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LoadTestProgress {
+    return new LoadTestProgress().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LoadTestProgress {
+    return new LoadTestProgress().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LoadTestProgress | PlainMessage<LoadTestProgress> | undefined, b: LoadTestProgress | PlainMessage<LoadTestProgress> | undefined): boolean {
+    return proto3.util.equals(LoadTestProgress, a, b);
+  }
+}
+
+/**
+ * @generated from message skip.ironbird.WorkflowSummary
+ */
+export class WorkflowSummary extends Message<WorkflowSummary> {
+  /**
+   * @generated from field: string workflow_id = 1;
+   */
+  workflowId = "";
+
+  /**
+   * @generated from field: string status = 2;
+   */
+  status = "";
+
+  /**
+   * @generated from field: string start_time = 3;
+   */
+  startTime = "";
+
+  /**
+   * @generated from field: string repo = 4;
+   */
+  repo = "";
+
+  /**
+   * @generated from field: string sha = 5;
+   */
+  sha = "";
+
+  /**
+   * @generated from field: string provider = 6;
+   */
+  provider = "";
+
+  /**
+   * @generated from field: string template_id = 7;
+   */
+  templateId = "";
+
+  /**
+   * @generated from field: string run_name = 8;
+   */
+  runName = "";
+
+  constructor(data?: PartialMessage<WorkflowSummary>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "skip.ironbird.WorkflowSummary";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workflow_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "start_time", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "repo", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "sha", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "provider", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "template_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "run_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WorkflowSummary {
+    return new WorkflowSummary().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WorkflowSummary {
+    return new WorkflowSummary().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WorkflowSummary {
+    return new WorkflowSummary().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WorkflowSummary | PlainMessage<WorkflowSummary> | undefined, b: WorkflowSummary | PlainMessage<WorkflowSummary> | undefined): boolean {
+    return proto3.util.equals(WorkflowSummary, a, b);
+  }
+}
+
+/**
+ * @generated from message skip.ironbird.UpdateWorkflowDataRequest
+ */
+export class UpdateWorkflowDataRequest extends Message<UpdateWorkflowDataRequest> {
+  /**
+   * @generated from field: string workflow_id = 1;
+   */
+  workflowId = "";
+
+  /**
+   * @generated from field: repeated skip.ironbird.Node load_balancers = 2;
+   */
+  loadBalancers: Node[] = [];
+
+  /**
+   * @generated from field: map<string, string> monitoring = 3;
+   */
+  monitoring: { [key: string]: string } = {};
+
+  /**
+   * @generated from field: repeated skip.ironbird.Node nodes = 4;
+   */
+  nodes: Node[] = [];
+
+  /**
+   * @generated from field: repeated skip.ironbird.Node validators = 5;
+   */
+  validators: Node[] = [];
+
+  /**
+   * @generated from field: skip.ironbird.WalletInfo wallets = 6;
+   */
+  wallets?: WalletInfo;
+
+  /**
+   * @generated from field: string provider = 7;
+   */
+  provider = "";
+
+  constructor(data?: PartialMessage<UpdateWorkflowDataRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "skip.ironbird.UpdateWorkflowDataRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workflow_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "load_balancers", kind: "message", T: Node, repeated: true },
+    { no: 3, name: "monitoring", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 4, name: "nodes", kind: "message", T: Node, repeated: true },
+    { no: 5, name: "validators", kind: "message", T: Node, repeated: true },
+    { no: 6, name: "wallets", kind: "message", T: WalletInfo },
+    { no: 7, name: "provider", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateWorkflowDataRequest {
+    return new UpdateWorkflowDataRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateWorkflowDataRequest {
+    return new UpdateWorkflowDataRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateWorkflowDataRequest {
+    return new UpdateWorkflowDataRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateWorkflowDataRequest | PlainMessage<UpdateWorkflowDataRequest> | undefined, b: UpdateWorkflowDataRequest | PlainMessage<UpdateWorkflowDataRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateWorkflowDataRequest, a, b);
+  }
+}
+
+/**
+ * AppendBuildLogsRequest is sent by workers while they build an image of a workflow
+ *
+ * @generated from message skip.ironbird.AppendBuildLogsRequest
+ */
+export class AppendBuildLogsRequest extends Message<AppendBuildLogsRequest> {
+  /**
+   * @generated from field: string workflow_id = 1;
+   */
+  workflowId = "";
+
+  /**
+   * image tag of the build
+   *
+   * @generated from field: string build = 2;
+   */
+  build = "";
+
+  /**
+   * @generated from field: string content = 3;
+   */
+  content = "";
+
+  constructor(data?: PartialMessage<AppendBuildLogsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "skip.ironbird.AppendBuildLogsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workflow_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "build", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "content", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppendBuildLogsRequest {
+    return new AppendBuildLogsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppendBuildLogsRequest {
+    return new AppendBuildLogsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppendBuildLogsRequest {
+    return new AppendBuildLogsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Workflow | PlainMessage<Workflow> | undefined, b: Workflow | PlainMessage<Workflow> | undefined): boolean {
-    return proto3.util.equals(Workflow, a, b);
+  static equals(a: AppendBuildLogsRequest | PlainMessage<AppendBuildLogsRequest> | undefined, b: AppendBuildLogsRequest | PlainMessage<AppendBuildLogsRequest> | undefined): boolean {
+    return proto3.util.equals(AppendBuildLogsRequest, a, b);
   }
 }
 
 /**
- * @generated from message skip.ironbird.WorkflowSummary
+ * @generated from message skip.ironbird.AppendBuildLogsResponse
  */
-export class WorkflowSummary extends Message<WorkflowSummary> {
+export class AppendBuildLogsResponse extends Message<AppendBuildLogsResponse> {
+  constructor(data?: PartialMessage<AppendBuildLogsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "skip.ironbird.AppendBuildLogsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppendBuildLogsResponse {
+    return new AppendBuildLogsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppendBuildLogsResponse {
+    return new AppendBuildLogsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppendBuildLogsResponse {
+    return new AppendBuildLogsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AppendBuildLogsResponse | PlainMessage<AppendBuildLogsResponse> | undefined, b: AppendBuildLogsResponse | PlainMessage<AppendBuildLogsResponse> | undefined): boolean {
+    return proto3.util.equals(AppendBuildLogsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message skip.ironbird.StreamBuildLogsRequest
+ */
+export class StreamBuildLogsRequest extends Message<StreamBuildLogsRequest> {
   /**
    * @generated from field: string workflow_id = 1;
    */
   workflowId = "";
 
   /**
-   * @generated from field: string status = 2;
+   * keep the stream open and send new chunks until the workflow finishes
+   *
+   * @generated from field: bool follow = 2;
    */
-  status = "";
+  follow = false;
 
   /**
-   * @generated from field: string start_time = 3;
+   * only send chunks with a greater id, used to resume a stream
+   *
+   * @generated from field: int64 after_id = 3;
    */
-  startTime = "";
+  afterId = protoInt64.zero;
 
+  constructor(data?: PartialMessage<StreamBuildLogsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "skip.ironbird.StreamBuildLogsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workflow_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "follow", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "after_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StreamBuildLogsRequest {
+    return new StreamBuildLogsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StreamBuildLogsRequest {
+    return new StreamBuildLogsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StreamBuildLogsRequest {
+    return new StreamBuildLogsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StreamBuildLogsRequest | PlainMessage<StreamBuildLogsRequest> | undefined, b: StreamBuildLogsRequest | PlainMessage<StreamBuildLogsRequest> | undefined): boolean {
+    return proto3.util.equals(StreamBuildLogsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message skip.ironbird.BuildLogChunk
+ */
+export class BuildLogChunk extends Message<BuildLogChunk> {
   /**
-   * @generated from field: string repo = 4;
+   * @generated from field: int64 id = 1;
    */
-  repo = "";
+  id = protoInt64.zero;
 
   /**
-   * @generated from field: string sha = 5;
+   * @generated from field: string workflow_id = 2;
    */
-  sha = "";
+  workflowId = "";
 
   /**
-   * @generated from field: string provider = 6;
+   * @generated from field: string build = 3;
    */
-  provider = "";
+  build = "";
 
   /**
-   * @generated from field: string template_id = 7;
+   * @generated from field: string content = 4;
    */
-  templateId = "";
+  content = "";
 
   /**
-   * @generated from field: string run_name = 8;
+   * @generated from field: string created_at = 5;
    */
-  runName = "";
+  createdAt = "";
 
-  constructor(data?: PartialMessage<WorkflowSummary>) {
+  constructor(data?: PartialMessage<BuildLogChunk>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "skip.ironbird.WorkflowSummary";
+  static readonly typeName = "skip.ironbird.BuildLogChunk";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "workflow_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "status", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "start_time", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "repo", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 5, name: "sha", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 6, name: "provider", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 7, name: "template_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 8, name: "run_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "workflow_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "build", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "content", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WorkflowSummary {
-    return new WorkflowSummary().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BuildLogChunk {
+    return new BuildLogChunk().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WorkflowSummary {
-    return new WorkflowSummary().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BuildLogChunk {
+    return new BuildLogChunk().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WorkflowSummary {
-    return new WorkflowSummary().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BuildLogChunk {
+    return new BuildLogChunk().fromJsonString(jsonString, options);
   }
 
-  static equals(a: WorkflowSummary | PlainMessage<WorkflowSummary> | undefined, b: WorkflowSummary | PlainMessage<WorkflowSummary> | undefined): boolean {
-    return proto3.util.equals(WorkflowSummary, a, b);
+  static equals(a: BuildLogChunk | PlainMessage<BuildLogChunk> | undefined, b: BuildLogChunk | PlainMessage<BuildLogChunk> | undefined): boolean {
+    return proto3.util.equals(BuildLogChunk, a, b);
   }
 }
 
 /**
- * @generated from message skip.ironbird.UpdateWorkflowDataRequest
+ * SaveProviderStateRequest is sent by workers after every activity that changed the provider of a workflow
+ *
+ * @generated from message skip.ironbird.SaveProviderStateRequest
  */
-export class UpdateWorkflowDataRequest extends Message<UpdateWorkflowDataRequest> {
+export class SaveProviderStateRequest extends Message<SaveProviderStateRequest> {
   /**
    * @generated from field: string workflow_id = 1;
    */
   workflowId = "";
 
   /**
-   * @generated from field: repeated skip.ironbird.Node load_balancers = 2;
+   * @generated from field: string runner_type = 2;
    */
-  loadBalancers: Node[] = [];
+  runnerType = "";
 
   /**
-   * @generated from field: map<string, string> monitoring = 3;
+   * compressed provider state, empty once the provider was torn down
+   *
+   * @generated from field: bytes provider_state = 3;
    */
-  monitoring: { [key: string]: string } = {};
+  providerState = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<SaveProviderStateRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "skip.ironbird.SaveProviderStateRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workflow_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "runner_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "provider_state", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SaveProviderStateRequest {
+    return new SaveProviderStateRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SaveProviderStateRequest {
+    return new SaveProviderStateRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SaveProviderStateRequest {
+    return new SaveProviderStateRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SaveProviderStateRequest | PlainMessage<SaveProviderStateRequest> | undefined, b: SaveProviderStateRequest | PlainMessage<SaveProviderStateRequest> | undefined): boolean {
+    return proto3.util.equals(SaveProviderStateRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message skip.ironbird.SaveProviderStateResponse
+ */
+export class SaveProviderStateResponse extends Message<SaveProviderStateResponse> {
+  constructor(data?: PartialMessage<SaveProviderStateResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "skip.ironbird.SaveProviderStateResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SaveProviderStateResponse {
+    return new SaveProviderStateResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SaveProviderStateResponse {
+    return new SaveProviderStateResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SaveProviderStateResponse {
+    return new SaveProviderStateResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SaveProviderStateResponse | PlainMessage<SaveProviderStateResponse> | undefined, b: SaveProviderStateResponse | PlainMessage<SaveProviderStateResponse> | undefined): boolean {
+    return proto3.util.equals(SaveProviderStateResponse, a, b);
+  }
+}
 
+/**
+ * UploadWorkflowArtifactRequest is sent by workers with files collected from a workflow's testnet
+ *
+ * @generated from message skip.ironbird.UploadWorkflowArtifactRequest
+ */
+export class UploadWorkflowArtifactRequest extends Message<UploadWorkflowArtifactRequest> {
   /**
-   * @generated from field: repeated skip.ironbird.Node nodes = 4;
+   * @generated from field: string workflow_id = 1;
    */
-  nodes: Node[] = [];
+  workflowId = "";
 
   /**
-   * @generated from field: repeated skip.ironbird.Node validators = 5;
+   * path like name of the artifact, e.g. coverage.out or race/validator-0/report.1
+   *
+   * @generated from field: string name = 2;
    */
-  validators: Node[] = [];
+  name = "";
 
   /**
-   * @generated from field: skip.ironbird.WalletInfo wallets = 6;
+   * @generated from field: bytes content = 3;
    */
-  wallets?: WalletInfo;
+  content = new Uint8Array(0);
+
+  constructor(data?: PartialMessage<UploadWorkflowArtifactRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "skip.ironbird.UploadWorkflowArtifactRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "workflow_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "content", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UploadWorkflowArtifactRequest {
+    return new UploadWorkflowArtifactRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UploadWorkflowArtifactRequest {
+    return new UploadWorkflowArtifactRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UploadWorkflowArtifactRequest {
+    return new UploadWorkflowArtifactRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UploadWorkflowArtifactRequest | PlainMessage<UploadWorkflowArtifactRequest> | undefined, b: UploadWorkflowArtifactRequest | PlainMessage<UploadWorkflowArtifactRequest> | undefined): boolean {
+    return proto3.util.equals(UploadWorkflowArtifactRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message skip.ironbird.UploadWorkflowArtifactResponse
+ */
+export class UploadWorkflowArtifactResponse extends Message<UploadWorkflowArtifactResponse> {
+  constructor(data?: PartialMessage<UploadWorkflowArtifactResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "skip.ironbird.UploadWorkflowArtifactResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UploadWorkflowArtifactResponse {
+    return new UploadWorkflowArtifactResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UploadWorkflowArtifactResponse {
+    return new UploadWorkflowArtifactResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UploadWorkflowArtifactResponse {
+    return new UploadWorkflowArtifactResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UploadWorkflowArtifactResponse | PlainMessage<UploadWorkflowArtifactResponse> | undefined, b: UploadWorkflowArtifactResponse | PlainMessage<UploadWorkflowArtifactResponse> | undefined): boolean {
+    return proto3.util.equals(UploadWorkflowArtifactResponse, a, b);
+  }
+}
 
+/**
+ * @generated from message skip.ironbird.GetWorkflowArtifactsRequest
+ */
+export class GetWorkflowArtifactsRequest extends Message<GetWorkflowArtifactsRequest> {
   /**
-   * @generated from field: string provider = 7;
+   * @generated from field: string workflow_id = 1;
    */
-  provider = "";
+  workflowId = "";
 
-  constructor(data?: PartialMessage<UpdateWorkflowDataRequest>) {
+  constructor(data?: PartialMessage<GetWorkflowArtifactsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "skip.ironbird.UpdateWorkflowDataRequest";
+  static readonly typeName = "skip.ironbird.GetWorkflowArtifactsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "workflow_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "load_balancers", kind: "message", T: Node, repeated: true },
-    { no: 3, name: "monitoring", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
-    { no: 4, name: "nodes", kind: "message", T: Node, repeated: true },
-    { no: 5, name: "validators", kind: "message", T: Node, repeated: true },
-    { no: 6, name: "wallets", kind: "message", T: WalletInfo },
-    { no: 7, name: "provider", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateWorkflowDataRequest {
-    return new UpdateWorkflowDataRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetWorkflowArtifactsRequest {
+    return new GetWorkflowArtifactsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateWorkflowDataRequest {
-    return new UpdateWorkflowDataRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetWorkflowArtifactsRequest {
+    return new GetWorkflowArtifactsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateWorkflowDataRequest {
-    return new UpdateWorkflowDataRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetWorkflowArtifactsRequest {
+    return new GetWorkflowArtifactsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UpdateWorkflowDataRequest | PlainMessage<UpdateWorkflowDataRequest> | undefined, b: UpdateWorkflowDataRequest | PlainMessage<UpdateWorkflowDataRequest> | undefined): boolean {
-    return proto3.util.equals(UpdateWorkflowDataRequest, a, b);
+  static equals(a: GetWorkflowArtifactsRequest | PlainMessage<GetWorkflowArtifactsRequest> | undefined, b: GetWorkflowArtifactsRequest | PlainMessage<GetWorkflowArtifactsRequest> | undefined): boolean {
+    return proto3.util.equals(GetWorkflowArtifactsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message skip.ironbird.WorkflowArtifact
+ */
+export class WorkflowArtifact extends Message<WorkflowArtifact> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * @generated from field: bytes content = 2;
+   */
+  content = new Uint8Array(0);
+
+  /**
+   * @generated from field: string created_at = 3;
+   */
+  createdAt = "";
+
+  constructor(data?: PartialMessage<WorkflowArtifact>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "skip.ironbird.WorkflowArtifact";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "content", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 3, name: "created_at", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WorkflowArtifact {
+    return new WorkflowArtifact().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WorkflowArtifact {
+    return new WorkflowArtifact().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WorkflowArtifact {
+    return new WorkflowArtifact().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WorkflowArtifact | PlainMessage<WorkflowArtifact> | undefined, b: WorkflowArtifact | PlainMessage<WorkflowArtifact> | undefined): boolean {
+    return proto3.util.equals(WorkflowArtifact, a, b);
+  }
+}
+
+/**
+ * @generated from message skip.ironbird.WorkflowArtifactsResponse
+ */
+export class WorkflowArtifactsResponse extends Message<WorkflowArtifactsResponse> {
+  /**
+   * @generated from field: repeated skip.ironbird.WorkflowArtifact artifacts = 1;
+   */
+  artifacts: WorkflowArtifact[] = [];
+
+  constructor(data?: PartialMessage<WorkflowArtifactsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "skip.ironbird.WorkflowArtifactsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "artifacts", kind: "message", T: WorkflowArtifact, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WorkflowArtifactsResponse {
+    return new WorkflowArtifactsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WorkflowArtifactsResponse {
+    return new WorkflowArtifactsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WorkflowArtifactsResponse {
+    return new WorkflowArtifactsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WorkflowArtifactsResponse | PlainMessage<WorkflowArtifactsResponse> | undefined, b: WorkflowArtifactsResponse | PlainMessage<WorkflowArtifactsResponse> | undefined): boolean {
+    return proto3.util.equals(WorkflowArtifactsResponse, a, b);
   }
 }
 
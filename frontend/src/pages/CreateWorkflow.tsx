@@ -299,16 +299,6 @@ const CreateWorkflow = () => {
     }
   }, [formData.ChainConfig.NumOfNodes, location.search]);
 
-  // Auto-disable LaunchLoadBalancer when runner type changes away from DigitalOcean
-  useEffect(() => {
-    if (formData.LaunchLoadBalancer && formData.RunnerType !== 'DigitalOcean') {
-      setFormData(prev => ({
-        ...prev,
-        LaunchLoadBalancer: false
-      }));
-    }
-  }, [formData.RunnerType, formData.LaunchLoadBalancer]);
-
   // Update hasLoadTest state when in JSON mode and JSON input changes
   useEffect(() => {
     if (jsonMode) {
@@ -390,10 +380,6 @@ const CreateWorkflow = () => {
       }
     }
 
-    if (data.LaunchLoadBalancer && data.RunnerType !== 'DigitalOcean') {
-      return 'Launch Load Balancer can only be enabled when using DigitalOcean as the runner type';
-    }
-
     return null;
   };
 
@@ -1735,10 +1721,9 @@ const CreateWorkflow = () => {
             <FormLabel mb="0">Launch Load Balancer</FormLabel>
             <Switch
               isChecked={formData.LaunchLoadBalancer}
-              isDisabled={formData.RunnerType === 'Docker'}
               onChange={(e) => setFormData({ ...formData, LaunchLoadBalancer: e.target.checked })}
             />
-            <Tooltip label="Launch a load balancer for the testnet (only available for DigitalOcean runner)">
+            <Tooltip label="Launch a load balancer for the testnet (on Docker every endpoint is published on its own local port)">
               <InfoIcon ml={2} cursor="pointer" />
             </Tooltip>
           </FormControl>
@@ -1,11 +1,107 @@
 package messages
 
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+)
+
+// MaxSourceOverlaySize is the maximum size of an uploaded patch or source tarball. Overlays are passed around in
+// workflow payloads, which are limited to a few MB
+const MaxSourceOverlaySize = 1 << 20
+
 type BuildDockerImageRequest struct {
 	Repo         string
 	SHA          string
 	ImageConfig  ImageConfig
 	CosmosSdkSha string // Optional: SHA/version to replace cosmos-sdk dependency
 	CometBFTSha  string // Optional: SHA/version to replace cometbft dependency
+	// Optional: go.mod replacements of the chain, keyed by module path. Replacements are module@version or
+	// fork@sha, e.g. github.com/cosmos/iavl => github.com/cosmos/iavl@v1.2.6 or https://github.com/org/iavl@<sha>
+	GoModReplaces map[string]string
+	// Optional: remote and local changes the chain is built from instead of the SHA of the repo's GitHub repository
+	Source ChainSource
+	// Optional: instrumentation the chain binary is compiled with
+	Variant BuildVariant
+}
+
+// BuildVariant is an instrumented build of a chain binary
+type BuildVariant string
+
+const (
+	// CoverBuild compiles the chain binary with -cover, nodes write coverage data that is collected at teardown
+	CoverBuild BuildVariant = "cover"
+	// RaceBuild compiles the chain binary with the race detector, nodes write race reports that are collected at
+	// teardown
+	RaceBuild BuildVariant = "race"
+)
+
+func (v BuildVariant) Validate(repo string) error {
+	switch v {
+	case "":
+		return nil
+	case CoverBuild, RaceBuild:
+	default:
+		return fmt.Errorf("unknown build variant %s", v)
+	}
+
+	if repo == "cometbft" {
+		return fmt.Errorf("%s builds are not supported for cometbft", v)
+	}
+
+	return nil
+}
+
+// ChainSource is where the source of a chain image comes from. By default, chains are cloned from their GitHub
+// repository, a GitURL clones them from a different remote (e.g. a fork) and an uploaded Patch or Tarball is
+// applied on top of the checked out SHA
+type ChainSource struct {
+	// Optional: https URL of the git repository the chain is cloned from
+	GitURL string
+	// Optional: key of the builder's auth_env_configs holding the token GitURL is cloned with
+	GitAuth string
+	// Optional: git diff applied on top of the SHA, e.g. the output of git diff of a local checkout
+	Patch []byte
+	// Optional: gzipped tarball of source files extracted over the checkout of the SHA
+	Tarball []byte
+}
+
+// HasOverlay returns whether local changes are applied on top of the checked out SHA
+func (s ChainSource) HasOverlay() bool {
+	return len(s.Patch) != 0 || len(s.Tarball) != 0
+}
+
+// WithoutOverlay returns the source without the uploaded changes, which only apply on top of the workflow's SHA
+func (s ChainSource) WithoutOverlay() ChainSource {
+	return ChainSource{GitURL: s.GitURL, GitAuth: s.GitAuth}
+}
+
+func (s ChainSource) Validate(repo string) error {
+	if repo == "cometbft" && (s.GitURL != "" || s.HasOverlay()) {
+		return fmt.Errorf("custom sources are not supported for cometbft builds, use go.mod replaces instead")
+	}
+
+	if s.GitURL != "" {
+		u, err := url.Parse(s.GitURL)
+		// the URL ends up in a shell command of the Dockerfile, so it must not contain anything but a plain URL
+		if err != nil || u.Scheme != "https" || u.Host == "" || u.User != nil || !gitURLPattern.MatchString(s.GitURL) {
+			return fmt.Errorf("git url %q must be a plain https url", s.GitURL)
+		}
+	}
+
+	if s.GitAuth != "" && s.GitURL == "" {
+		return fmt.Errorf("git auth requires a git url")
+	}
+
+	if len(s.Patch) != 0 && len(s.Tarball) != 0 {
+		return fmt.Errorf("only one of a patch or a source tarball can be applied")
+	}
+
+	if len(s.Patch) > MaxSourceOverlaySize || len(s.Tarball) > MaxSourceOverlaySize {
+		return fmt.Errorf("patches and source tarballs are limited to %d bytes", MaxSourceOverlaySize)
+	}
+
+	return nil
 }
 
 type ImageConfig struct {
@@ -18,3 +114,25 @@ type BuildDockerImageResponse struct {
 	FQDNTag string
 	Logs    []byte
 }
+
+var (
+	// module paths and versions end up in a shell command, so only characters that are valid in them are allowed
+	modulePathPattern  = regexp.MustCompile(`^[A-Za-z0-9._~/-]+$`)
+	replacementPattern = regexp.MustCompile(`^(https://)?[A-Za-z0-9._~/-]+@[A-Za-z0-9._~+/-]+$`)
+	gitURLPattern      = regexp.MustCompile(`^https://[A-Za-z0-9._~:/-]+$`)
+)
+
+// ValidateGoModReplaces checks that every replace is a module path replaced by module@version or fork@sha
+func ValidateGoModReplaces(replaces map[string]string) error {
+	for module, replacement := range replaces {
+		if !modulePathPattern.MatchString(module) {
+			return fmt.Errorf("invalid module path %q", module)
+		}
+
+		if !replacementPattern.MatchString(replacement) {
+			return fmt.Errorf("invalid replacement %q of module %s, expected module@version or fork@sha", replacement, module)
+		}
+	}
+
+	return nil
+}
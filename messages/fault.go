@@ -0,0 +1,85 @@
+package messages
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/skip-mev/ironbird/types"
+)
+
+type FaultType string
+
+const (
+	// DoubleSignFault makes a validator equivocate and asserts that it is jailed, tombstoned and slashed
+	DoubleSignFault FaultType = "double-sign"
+
+	// DefaultFaultTimeout is how long a fault waits for the chain to react to it if the fault doesn't set a timeout
+	DefaultFaultTimeout = 10 * time.Minute
+)
+
+// FaultSpec is a fault that is injected into the primary chain of a testnet once it has been running for After
+type FaultSpec struct {
+	Type FaultType
+	// After is the delay after the testnet launched, e.g. 5m
+	After string
+	// Validator is the index of the validator the fault is injected into
+	Validator int
+	// Optional: how long to wait for the chain to react to the fault, defaults to DefaultFaultTimeout
+	Timeout string
+}
+
+func (f FaultSpec) Validate(chainConfig types.ChainsConfig, runnerType RunnerType) error {
+	if f.Type != DoubleSignFault {
+		return fmt.Errorf("unknown fault type: %s", f.Type)
+	}
+
+	if _, err := time.ParseDuration(f.After); err != nil {
+		return fmt.Errorf("invalid delay of %s fault: %w", f.Type, err)
+	}
+
+	if f.Timeout != "" {
+		if _, err := time.ParseDuration(f.Timeout); err != nil {
+			return fmt.Errorf("invalid timeout of %s fault: %w", f.Type, err)
+		}
+	}
+
+	numValidators, _ := chainSize(chainConfig, runnerType)
+	if f.Validator < 0 || f.Validator >= numValidators {
+		return fmt.Errorf("%s fault targets validator %d but the chain has %d validators", f.Type, f.Validator, numValidators)
+	}
+
+	if f.Type == DoubleSignFault && chainConfig.RemoteSigner != nil {
+		return fmt.Errorf("%s fault is not supported for validators with a remote signer", f.Type)
+	}
+
+	return nil
+}
+
+// GetTimeout returns the timeout of the fault
+func (f FaultSpec) GetTimeout() time.Duration {
+	timeout, err := time.ParseDuration(f.Timeout)
+	if err != nil || timeout == 0 {
+		return DefaultFaultTimeout
+	}
+	return timeout
+}
+
+type InjectFaultRequest struct {
+	RunnerType             RunnerType
+	ProviderState          []byte
+	ChainState             []byte
+	Fault                  FaultSpec
+	ProviderSpecificConfig map[string]string
+}
+
+type InjectFaultResponse struct {
+	ProviderState []byte
+
+	// OperatorAddress, Jailed, Tombstoned and the validator's tokens before and after the fault are set for
+	// DoubleSignFault
+	OperatorAddress string
+	Jailed          bool
+	Tombstoned      bool
+	TokensBefore    string
+	TokensAfter     string
+}
@@ -1,9 +1,103 @@
 package messages
 
 import (
+	"fmt"
+	"slices"
+
 	"github.com/skip-mev/ironbird/petri/core/apps"
+	pb "github.com/skip-mev/ironbird/server/proto"
 )
 
+// LoadBalancerDomainTypes are the domains a load balancer serves for a chain, e.g. <chain>-rpc
+var LoadBalancerDomainTypes = []string{"rpc", "lcd", "grpc", "evmrpc", "evmws"}
+
+// LoadBalancerSpec configures the load balancer of a testnet
+type LoadBalancerSpec struct {
+	// Options apply to every domain without DomainOptions
+	Options apps.LoadBalancerOptions
+	// DomainOptions replace Options for a domain type, see LoadBalancerDomainTypes
+	DomainOptions map[string]apps.LoadBalancerOptions
+	// ExcludeValidators only routes traffic to full nodes, validators still serve traffic if the chain has no full
+	// nodes
+	ExcludeValidators bool
+}
+
+// DomainTypeOptions returns the options of a domain type
+func (s LoadBalancerSpec) DomainTypeOptions(domainType string) apps.LoadBalancerOptions {
+	if options, ok := s.DomainOptions[domainType]; ok {
+		return options
+	}
+	return s.Options
+}
+
+// Domains returns the load balancer domains of a chain, e.g. <chain>-rpc, with the nodes as upstreams
+func (s LoadBalancerSpec) Domains(chainName string, nodes, validators []*pb.Node, isEvmChain bool) []apps.LoadBalancerDomain {
+	var domains []apps.LoadBalancerDomain
+
+	domainTypes := map[string]string{
+		"grpc": "9090",
+		"rpc":  "26657",
+		"lcd":  "1317",
+	}
+
+	if isEvmChain {
+		domainTypes["evmrpc"] = "8545"
+		domainTypes["evmws"] = "8546"
+	}
+
+	upstreams := slices.Concat(nodes, validators)
+	if s.ExcludeValidators && len(nodes) > 0 {
+		upstreams = nodes
+	}
+
+	for _, domainType := range LoadBalancerDomainTypes {
+		port, ok := domainTypes[domainType]
+		if !ok {
+			continue
+		}
+
+		domain := apps.LoadBalancerDomain{
+			Domain:  fmt.Sprintf("%s-%s", chainName, domainType),
+			Options: s.DomainTypeOptions(domainType),
+		}
+
+		var ips []string
+		for _, node := range upstreams {
+			ips = append(ips, fmt.Sprintf("%s:%s", node.Address, port))
+		}
+
+		if domainType == "grpc" {
+			domain.Protocol = "grpc"
+		} else {
+			domain.Protocol = "http"
+		}
+
+		domain.IPs = ips
+
+		domains = append(domains, domain)
+	}
+
+	return domains
+}
+
+func (s LoadBalancerSpec) Validate() error {
+	if err := s.Options.Validate(); err != nil {
+		return fmt.Errorf("invalid load balancer options: %w", err)
+	}
+
+	for domainType, options := range s.DomainOptions {
+		if !slices.Contains(LoadBalancerDomainTypes, domainType) {
+			return fmt.Errorf("unknown load balancer domain type %s", domainType)
+		}
+
+		if err := options.Validate(); err != nil {
+			return fmt.Errorf("invalid load balancer options of %s: %w", domainType, err)
+		}
+	}
+
+	return nil
+}
+
 type LaunchLoadBalancerRequest struct {
 	ProviderState []byte
 	RunnerType    RunnerType
@@ -17,3 +111,18 @@ type LaunchLoadBalancerResponse struct {
 	LoadBalancerState []byte
 	RootDomain        string
 }
+
+type UpdateLoadBalancerRequest struct {
+	ProviderState     []byte
+	RunnerType        RunnerType
+	LoadBalancerState []byte
+	ChainState        []byte
+	ChainName         string
+	IsEvmChain        bool
+	LoadBalancer      LoadBalancerSpec
+}
+
+type UpdateLoadBalancerResponse struct {
+	// Reloaded is set if the upstreams or options changed and the load balancer was reloaded
+	Reloaded bool
+}
@@ -0,0 +1,43 @@
+package messages
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/ironbird/petri/core/apps"
+	pb "github.com/skip-mev/ironbird/server/proto"
+)
+
+func TestLoadBalancerSpecDomains(t *testing.T) {
+	nodes := []*pb.Node{{Name: "node-0", Address: "10.0.0.1"}}
+	validators := []*pb.Node{{Name: "validator-0", Address: "10.0.0.2"}}
+
+	spec := LoadBalancerSpec{
+		Options:       apps.LoadBalancerOptions{Policy: "round_robin"},
+		DomainOptions: map[string]apps.LoadBalancerOptions{"grpc": {HealthCheck: true}},
+	}
+
+	domains := spec.Domains("chain", nodes, validators, false)
+	require.Len(t, domains, 3)
+	require.Equal(t, apps.LoadBalancerDomain{
+		Domain:   "chain-rpc",
+		IPs:      []string{"10.0.0.1:26657", "10.0.0.2:26657"},
+		Protocol: "http",
+		Options:  apps.LoadBalancerOptions{Policy: "round_robin"},
+	}, domains[0])
+	require.Equal(t, apps.LoadBalancerDomain{
+		Domain:   "chain-grpc",
+		IPs:      []string{"10.0.0.1:9090", "10.0.0.2:9090"},
+		Protocol: "grpc",
+		Options:  apps.LoadBalancerOptions{HealthCheck: true},
+	}, domains[2])
+
+	spec.ExcludeValidators = true
+	for _, domain := range spec.Domains("chain", nodes, validators, true) {
+		require.Len(t, domain.IPs, 1, domain.Domain)
+	}
+
+	// validators serve traffic if there are no full nodes
+	require.Len(t, spec.Domains("chain", nil, validators, false)[0].IPs, 1)
+}
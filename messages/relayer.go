@@ -0,0 +1,64 @@
+package messages
+
+import (
+	"github.com/skip-mev/ironbird/petri/core/apps"
+	"github.com/skip-mev/ironbird/types"
+)
+
+// AdditionalChain is a chain that is launched next to the primary chain of a testnet workflow
+type AdditionalChain struct {
+	Repo string
+	// Optional: defaults to the SHA of the workflow
+	SHA         string
+	IsEvmChain  bool
+	ChainConfig types.ChainsConfig
+}
+
+// RelayerSpec configures the IBC relayer that connects the primary chain to every additional chain
+type RelayerSpec struct {
+	// Optional: Hermes image, defaults to apps.DefaultRelayerImage
+	Image           string
+	IBCTransferLoad *IBCTransferLoadSpec
+}
+
+// IBCTransferLoadSpec configures a load of IBC transfers over every channel of the relayer
+type IBCTransferLoadSpec struct {
+	Duration  string
+	Amount    uint64
+	MsgsPerTx int
+}
+
+// RelayerChainState is a launched chain the relayer connects to
+type RelayerChainState struct {
+	ChainState []byte
+	IsEvmChain bool
+}
+
+type LaunchRelayerRequest struct {
+	RunnerType    RunnerType
+	ProviderState []byte
+	// Chains are the chains to relay between, transfer channels are created from the first chain to every other chain
+	Chains                 []RelayerChainState
+	Image                  string
+	ProviderSpecificConfig map[string]string
+}
+
+type LaunchRelayerResponse struct {
+	ProviderState []byte
+	RelayerState  []byte
+	Channels      []apps.RelayerChannel
+	Denoms        map[string]string // Denoms maps chain IDs to their native denom
+}
+
+type RunIBCTransferLoadRequest struct {
+	RunnerType    RunnerType
+	ProviderState []byte
+	RelayerState  []byte
+	Channels      []apps.RelayerChannel
+	Denoms        map[string]string
+	Spec          IBCTransferLoadSpec
+}
+
+type RunIBCTransferLoadResponse struct {
+	TransfersSent int
+}
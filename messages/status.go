@@ -0,0 +1,71 @@
+package messages
+
+import (
+	"slices"
+	"time"
+
+	catalysttypes "github.com/skip-mev/catalyst/chains/types"
+	pb "github.com/skip-mev/ironbird/server/proto"
+)
+
+// TestnetStatusQuery is the query testnet workflows answer with their TestnetStatus
+const TestnetStatusQuery = "testnet_status"
+
+// TestnetPhase is what a testnet workflow is currently doing
+type TestnetPhase string
+
+const (
+	PhaseBuilding                  TestnetPhase = "building"
+	PhaseLaunching                 TestnetPhase = "launching"
+	PhaseMigratingGenesis          TestnetPhase = "migrating_genesis"
+	PhaseLaunchingAdditionalChains TestnetPhase = "launching_additional_chains"
+	PhaseLaunchingRelayer          TestnetPhase = "launching_relayer"
+	PhaseLaunchingLoadBalancer     TestnetPhase = "launching_load_balancer"
+	PhaseRunning                   TestnetPhase = "running"
+	PhaseUpgrading                 TestnetPhase = "upgrading"
+	PhaseInjectingFault            TestnetPhase = "injecting_fault"
+	PhaseCollectingInstrumentation TestnetPhase = "collecting_instrumentation"
+	PhaseTearingDown               TestnetPhase = "tearing_down"
+)
+
+// LoadTestProgress is the progress of the load test of a testnet
+type LoadTestProgress struct {
+	StartedAt time.Time
+	// CompletedAt is zero while the load test is running
+	CompletedAt time.Time
+	// Error is set if the load test failed
+	Error string
+	// Overall are the statistics of a completed load test
+	Overall *catalysttypes.OverallStats
+}
+
+// TestnetStatus is the live state of a testnet workflow
+type TestnetStatus struct {
+	Phase      TestnetPhase
+	ChainID    string
+	Nodes      []*pb.Node
+	Validators []*pb.Node
+	// Health is the result of the last health check of a long-running testnet, it holds the latest observed heights
+	Health          *CheckHealthResponse
+	HealthCheckedAt time.Time
+	LoadTest        *LoadTestProgress
+	// ExpectedEnd is when the testnet is torn down, it's zero for long-running testnets which run until the workflow
+	// is cancelled
+	ExpectedEnd time.Time
+}
+
+// ReplaceNodes replaces the nodes of recorded that have the name of an updated node, updated nodes that weren't
+// recorded are appended
+func ReplaceNodes(recorded, updated []*pb.Node) []*pb.Node {
+	nodes := slices.Clone(recorded)
+	for _, node := range updated {
+		i := slices.IndexFunc(nodes, func(n *pb.Node) bool { return n.Name == node.Name })
+		if i < 0 {
+			nodes = append(nodes, node)
+			continue
+		}
+		nodes[i] = node
+	}
+
+	return nodes
+}
@@ -0,0 +1,28 @@
+package messages
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/skip-mev/ironbird/server/proto"
+)
+
+func TestReplaceNodes(t *testing.T) {
+	recorded := []*pb.Node{
+		{Name: "chain-a-node-0", Image: "chain-a:v1"},
+		{Name: "chain-b-node-0", Image: "chain-b:v1"},
+	}
+
+	nodes := ReplaceNodes(recorded, []*pb.Node{
+		{Name: "chain-a-node-0", Image: "chain-a:v2"},
+		{Name: "chain-a-node-1", Image: "chain-a:v2"},
+	})
+
+	require.Equal(t, []*pb.Node{
+		{Name: "chain-a-node-0", Image: "chain-a:v2"},
+		{Name: "chain-b-node-0", Image: "chain-b:v1"},
+		{Name: "chain-a-node-1", Image: "chain-a:v2"},
+	}, nodes)
+	require.Equal(t, "chain-a:v1", recorded[0].Image)
+}
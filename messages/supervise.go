@@ -0,0 +1,57 @@
+package messages
+
+import (
+	"time"
+)
+
+// CheckHealthRequest checks that every node of a chain responds
+type CheckHealthRequest struct {
+	RunnerType    RunnerType
+	ProviderState []byte
+	ChainState    []byte
+}
+
+// NodeHealth is the result of the health check of a node, Error is empty if the node is healthy
+type NodeHealth struct {
+	Name   string
+	Height uint64
+	Error  string
+}
+
+type CheckHealthResponse struct {
+	// Height is the highest height reported by a node of the chain
+	Height uint64
+	Nodes  []NodeHealth
+}
+
+// Unhealthy returns the nodes that failed their health check
+func (r CheckHealthResponse) Unhealthy() []NodeHealth {
+	var unhealthy []NodeHealth
+	for _, node := range r.Nodes {
+		if node.Error != "" {
+			unhealthy = append(unhealthy, node)
+		}
+	}
+	return unhealthy
+}
+
+// SupervisedTestnet is the state of a launched long-running testnet, it's carried over to the next run of the
+// testnet's workflow when it continues as new
+type SupervisedTestnet struct {
+	ChainState        []byte
+	ProviderState     []byte
+	LoadBalancerState []byte
+
+	// LaunchedAt is the time the testnet launched, faults are injected relative to it
+	LaunchedAt time.Time
+	// PendingFaults are the faults of the request that were not injected yet
+	PendingFaults []FaultSpec
+	// Status holds the node list and the last health check, which is used to detect halted chains
+	Status TestnetStatus
+}
+
+// SuperviseWorkflowRequest resumes the supervision of a long-running testnet in a new run of its workflow
+type SuperviseWorkflowRequest struct {
+	Request TestnetWorkflowRequest
+	Testnet SupervisedTestnet
+}
@@ -2,6 +2,8 @@ package messages
 
 import (
 	"fmt"
+	"slices"
+	"time"
 
 	ctlttypes "github.com/skip-mev/catalyst/chains/types"
 	petritypes "github.com/skip-mev/ironbird/petri/core/types"
@@ -26,6 +28,8 @@ type RunnerType string
 type CreateProviderRequest struct {
 	RunnerType RunnerType
 	Name       string
+	// ExpiresAt is stamped on the resources of the provider, the cleanup job does not reap them before
+	ExpiresAt time.Time
 }
 
 type CreateProviderResponse struct {
@@ -39,6 +43,33 @@ type TeardownProviderRequest struct {
 
 type TeardownProviderResponse struct{}
 
+// SaveProviderStateRequest saves the compressed provider state of a workflow's testnet to the server, an empty state
+// records that the provider was torn down
+type SaveProviderStateRequest struct {
+	WorkflowID    string
+	RunnerType    RunnerType
+	ProviderState []byte
+}
+
+type SaveProviderStateResponse struct{}
+
+// TeardownWorkflowRequest tears down the testnet of another workflow from its last saved provider state
+type TeardownWorkflowRequest struct {
+	WorkflowID    string
+	RunnerType    RunnerType
+	ProviderState []byte
+}
+
+type SetExpiryRequest struct {
+	RunnerType    RunnerType
+	ProviderState []byte
+	ExpiresAt     time.Time
+}
+
+type SetExpiryResponse struct {
+	ProviderState []byte
+}
+
 type LaunchTestnetRequest struct {
 	Name                 string
 	IsEvmChain           bool
@@ -52,6 +83,12 @@ type LaunchTestnetRequest struct {
 	RegionConfigs   []petritypes.RegionConfig
 	NumOfValidators uint64
 	NumOfNodes      uint64
+	ImageGroups     []petritypes.ImageGroup
+	ConfigOverrides []petritypes.NodeConfigOverride
+
+	StakeDistribution *petritypes.StakeDistribution
+	Topology          *petritypes.Topology
+	RemoteSigner      *petritypes.RemoteSigner
 
 	CustomAppConfig       map[string]interface{}
 	CustomConsensusConfig map[string]interface{}
@@ -64,6 +101,16 @@ type LaunchTestnetRequest struct {
 	BaseMnemonic           string
 	NumWallets             int
 	ProviderSpecificConfig map[string]string
+
+	CustomGenesis *CustomGenesisSpec
+
+	// Instrumented is set if Image was built with a BuildVariant
+	Instrumented bool
+
+	// ExistingNodes and ExistingValidators belong to chains that were previously launched by the same workflow.
+	// They are recorded together with the nodes of this chain
+	ExistingNodes      []*pb.Node
+	ExistingValidators []*pb.Node
 }
 
 type LaunchTestnetResponse struct {
@@ -74,6 +121,122 @@ type LaunchTestnetResponse struct {
 	Validators    []*pb.Node
 }
 
+type MigrateGenesisRequest struct {
+	RunnerType    RunnerType
+	ProviderState []byte
+	ChainState    []byte
+	IsEvmChain    bool
+
+	Image                string // optional image the chain is restarted on, e.g. a newer build of the chain binary
+	ExportHeight         uint64
+	MigrationCommand     []string
+	GenesisModifications []petrichain.GenesisKV
+
+	// ExistingNodes and ExistingValidators are the nodes recorded for the workflow. The recorded nodes of the chain
+	// are replaced with the migrated ones
+	ExistingNodes      []*pb.Node
+	ExistingValidators []*pb.Node
+}
+
+type MigrateGenesisResponse struct {
+	ProviderState []byte
+	ChainState    []byte
+	// Nodes and Validators are the nodes of the chain after the migration, which may run a different image
+	Nodes      []*pb.Node
+	Validators []*pb.Node
+}
+
+type UpgradeMode string
+
+const (
+	// RollingRestart restarts the nodes one at a time on the new image
+	RollingRestart UpgradeMode = "rolling-restart"
+	// HaltHeightUpgrade halts every node at a halt-height and restarts them on the new image
+	HaltHeightUpgrade UpgradeMode = "halt-height"
+
+	RollingRestartSignal    = "rolling_restart"
+	HaltHeightUpgradeSignal = "halt_height_upgrade"
+)
+
+// UpgradeSignal is the payload of the RollingRestartSignal and HaltHeightUpgradeSignal signals
+type UpgradeSignal struct {
+	// Optional: SHA of Repo to build the image the nodes are restarted on. The nodes keep their image if empty
+	SHA        string `json:"sha"`
+	HaltHeight uint64 `json:"halt_height,omitempty"`
+}
+
+// ExtendTestnetSignal pushes the end of a testnet and the expiry of its resources forward
+const ExtendTestnetSignal = "extend_testnet"
+
+// ExtendSignal is the payload of the ExtendTestnetSignal signal
+type ExtendSignal struct {
+	Duration string `json:"duration"`
+}
+
+type UpgradeChainRequest struct {
+	RunnerType    RunnerType
+	ProviderState []byte
+	ChainState    []byte
+	IsEvmChain    bool
+
+	Mode       UpgradeMode
+	Image      string // optional image the nodes are restarted on
+	HaltHeight uint64 // required for HaltHeightUpgrade
+
+	// ExistingNodes and ExistingValidators are the nodes recorded for the workflow. The recorded nodes of the chain
+	// are replaced with the upgraded ones
+	ExistingNodes      []*pb.Node
+	ExistingValidators []*pb.Node
+}
+
+type UpgradeChainResponse struct {
+	ProviderState []byte
+	ChainState    []byte
+	// Nodes and Validators are the nodes of the chain after the upgrade, which may run a different image
+	Nodes      []*pb.Node
+	Validators []*pb.Node
+}
+
+// CollectInstrumentationRequest collects the coverage data or race reports of a chain built with a BuildVariant
+type CollectInstrumentationRequest struct {
+	RunnerType    RunnerType
+	ProviderState []byte
+	ChainState    []byte
+}
+
+// CollectInstrumentationResponse summarizes the collected output, the output itself is uploaded to the server as
+// workflow artifacts
+type CollectInstrumentationResponse struct {
+	// Coverage is the percentage of statements covered by the merged coverage profile of every node
+	Coverage float64
+	// RaceReports are the artifact names of the race reports, e.g. race/validator-0/report.123
+	RaceReports []string
+}
+
+// CustomGenesisSpec references a genesis, e.g. an exported and anonymized mainnet state, that the testnet is
+// started from instead of a freshly generated one. Genesis files can be too large for workflow payloads, so only
+// http(s) artifact URLs are passed around and the artifacts are fetched by the worker
+type CustomGenesisSpec struct {
+	GenesisURL string
+	// Optional: expected sha256 digest of the genesis artifact
+	GenesisSHA256 string
+	// Optional: URL of a JSON array of priv_validator_key.json files that are assigned to the validators in order
+	ValidatorKeysURL string
+	// Optional: expected sha256 digest of the validator keys artifact
+	ValidatorKeysSHA256 string
+}
+
+// GenesisMigrationSpec configures an export-and-restart of the testnet: the chain is run to ExportHeight,
+// halted and exported, the exported genesis is transformed and every node is restarted from it
+type GenesisMigrationSpec struct {
+	ExportHeight uint64
+	// Optional: SHA of Repo to build the image the chain is restarted on
+	SHA string
+	// Optional: binary subcommand run against the exported genesis, e.g. ["genesis", "migrate", "v0.50"]
+	MigrationCommand     []string
+	GenesisModifications []petrichain.GenesisKV
+}
+
 type TestnetWorkflowRequest struct {
 	Repo        string
 	SHA         string
@@ -85,17 +248,34 @@ type TestnetWorkflowRequest struct {
 	CosmosSdkSha string
 	// Optional: SHA/version to replace cometbft dependency (for EVM chains)
 	CometBFTSha string
+	// Optional: additional go.mod replacements of the chain, see BuildDockerImageRequest.GoModReplaces
+	GoModReplaces map[string]string
+	// Optional: fork, patch or source tarball the chain is built from, see ChainSource
+	Source ChainSource
+	// Optional: builds the chain binary with -cover or -race, coverage and race reports are collected at teardown
+	BuildVariant BuildVariant
 
 	EthereumLoadTestSpec *ctlttypes.LoadTestSpec
 	CosmosLoadTestSpec   *ctlttypes.LoadTestSpec
 
-	LongRunningTestnet     bool
-	LaunchLoadBalancer     bool
+	LongRunningTestnet bool
+	LaunchLoadBalancer bool
+	// Optional: health checks, upstream selection and limits of the load balancer
+	LoadBalancer           *LoadBalancerSpec
 	TestnetDuration        string
 	NumWallets             int
 	BaseMnemonic           string
 	CatalystVersion        string
 	ProviderSpecificConfig map[string]string
+
+	GenesisMigration *GenesisMigrationSpec
+	CustomGenesis    *CustomGenesisSpec
+
+	AdditionalChains []AdditionalChain
+	Relayer          *RelayerSpec
+
+	// Faults are injected into the primary chain while the testnet is running
+	Faults []FaultSpec
 }
 
 func (r TestnetWorkflowRequest) Validate() error {
@@ -119,12 +299,8 @@ func (r TestnetWorkflowRequest) Validate() error {
 		return fmt.Errorf("can not set duration on long-running testnet")
 	}
 
-	if !r.ChainConfig.SetSeedNode && !r.ChainConfig.SetPersistentPeers {
-		return fmt.Errorf("at least one of SetSeedNode or SetPersistentPeers must be set to true")
-	}
-
-	if r.RunnerType == Docker && r.LaunchLoadBalancer {
-		return fmt.Errorf("load balancer is not supported for docker runners")
+	if !r.ChainConfig.SetSeedNode && !r.ChainConfig.SetPersistentPeers && r.ChainConfig.Topology == nil {
+		return fmt.Errorf("at least one of SetSeedNode, SetPersistentPeers or Topology must be set")
 	}
 
 	if r.EthereumLoadTestSpec != nil && r.CosmosLoadTestSpec != nil {
@@ -141,6 +317,206 @@ func (r TestnetWorkflowRequest) Validate() error {
 		}
 	}
 
+	if r.CustomGenesis != nil && r.CustomGenesis.GenesisURL == "" {
+		return fmt.Errorf("custom genesis requires a genesis url")
+	}
+
+	if err := validateImageGroups(r.ChainConfig); err != nil {
+		return err
+	}
+
+	if err := validateConfigOverrides(r.ChainConfig, r.RunnerType); err != nil {
+		return err
+	}
+
+	if err := validateStakeDistribution(r.ChainConfig, r.RunnerType); err != nil {
+		return err
+	}
+
+	if err := validateTopology(r.ChainConfig, r.RunnerType); err != nil {
+		return err
+	}
+
+	if err := validateRemoteSigner(r.ChainConfig); err != nil {
+		return err
+	}
+
+	if r.GenesisMigration != nil && r.GenesisMigration.ExportHeight == 0 {
+		return fmt.Errorf("genesis migration requires an export height")
+	}
+
+	// chain names are used as chain IDs and node names and evm chains share the same chain ID, so they must be unique
+	chainNames := map[string]bool{r.ChainConfig.Name: true}
+	evmChains := 0
+	if r.IsEvmChain {
+		evmChains++
+	}
+
+	for _, chain := range r.AdditionalChains {
+		if chain.ChainConfig.Name == "" {
+			return fmt.Errorf("additional chain name is required")
+		}
+
+		if chainNames[chain.ChainConfig.Name] {
+			return fmt.Errorf("chain name %s is used by more than one chain", chain.ChainConfig.Name)
+		}
+		chainNames[chain.ChainConfig.Name] = true
+
+		if !chain.ChainConfig.SetSeedNode && !chain.ChainConfig.SetPersistentPeers && chain.ChainConfig.Topology == nil {
+			return fmt.Errorf("at least one of SetSeedNode, SetPersistentPeers or Topology must be set for chain %s", chain.ChainConfig.Name)
+		}
+
+		if err := validateImageGroups(chain.ChainConfig); err != nil {
+			return err
+		}
+
+		if err := validateConfigOverrides(chain.ChainConfig, r.RunnerType); err != nil {
+			return err
+		}
+
+		if err := validateStakeDistribution(chain.ChainConfig, r.RunnerType); err != nil {
+			return err
+		}
+
+		if err := validateTopology(chain.ChainConfig, r.RunnerType); err != nil {
+			return err
+		}
+
+		if err := validateRemoteSigner(chain.ChainConfig); err != nil {
+			return err
+		}
+
+		if chain.IsEvmChain {
+			evmChains++
+		}
+	}
+
+	if evmChains > 1 {
+		return fmt.Errorf("only one evm chain can be launched per testnet")
+	}
+
+	if r.LoadBalancer != nil {
+		if !r.LaunchLoadBalancer {
+			return fmt.Errorf("load balancer options require LaunchLoadBalancer")
+		}
+
+		if err := r.LoadBalancer.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if r.Relayer != nil && len(r.AdditionalChains) == 0 {
+		return fmt.Errorf("relayer requires at least one additional chain")
+	}
+
+	if r.Relayer != nil && r.Relayer.IBCTransferLoad != nil && r.Relayer.IBCTransferLoad.Duration == "" {
+		return fmt.Errorf("ibc transfer load requires a duration")
+	}
+
+	if err := ValidateGoModReplaces(r.GoModReplaces); err != nil {
+		return err
+	}
+
+	if err := r.Source.Validate(r.Repo); err != nil {
+		return err
+	}
+
+	if err := r.BuildVariant.Validate(r.Repo); err != nil {
+		return err
+	}
+
+	for _, fault := range r.Faults {
+		if err := fault.Validate(r.ChainConfig, r.RunnerType); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateImageGroups(chainConfig types.ChainsConfig) error {
+	for _, group := range chainConfig.ImageGroups {
+		if group.SHA == "" {
+			return fmt.Errorf("image group of chain %s requires a SHA", chainConfig.Name)
+		}
+
+		if group.Region == "" {
+			continue
+		}
+
+		if !slices.ContainsFunc(chainConfig.RegionConfigs, func(rc petritypes.RegionConfig) bool {
+			return rc.Name == group.Region
+		}) {
+			return fmt.Errorf("image group of chain %s references unknown region %s", chainConfig.Name, group.Region)
+		}
+	}
+
+	return nil
+}
+
+// chainSize returns the number of validators and nodes of a chain, which are distributed across regions on
+// DigitalOcean
+func chainSize(chainConfig types.ChainsConfig, runnerType RunnerType) (int, int) {
+	if runnerType != DigitalOcean {
+		return int(chainConfig.NumOfValidators), int(chainConfig.NumOfNodes)
+	}
+
+	numValidators, numNodes := 0, 0
+	for _, region := range chainConfig.RegionConfigs {
+		numValidators += region.NumValidators
+		numNodes += region.NumNodes
+	}
+
+	return numValidators, numNodes
+}
+
+func validateStakeDistribution(chainConfig types.ChainsConfig, runnerType RunnerType) error {
+	if chainConfig.StakeDistribution == nil {
+		return nil
+	}
+
+	numValidators, _ := chainSize(chainConfig, runnerType)
+	if err := chainConfig.StakeDistribution.ValidateBasic(numValidators); err != nil {
+		return fmt.Errorf("stake distribution of chain %s is invalid: %w", chainConfig.Name, err)
+	}
+
+	return nil
+}
+
+func validateTopology(chainConfig types.ChainsConfig, runnerType RunnerType) error {
+	if chainConfig.Topology == nil {
+		return nil
+	}
+
+	numValidators, numNodes := chainSize(chainConfig, runnerType)
+	if err := chainConfig.Topology.ValidateBasic(numValidators, numNodes); err != nil {
+		return fmt.Errorf("topology of chain %s is invalid: %w", chainConfig.Name, err)
+	}
+
+	return nil
+}
+
+func validateRemoteSigner(chainConfig types.ChainsConfig) error {
+	if chainConfig.RemoteSigner == nil {
+		return nil
+	}
+
+	if err := chainConfig.RemoteSigner.ValidateBasic(); err != nil {
+		return fmt.Errorf("remote signer of chain %s is invalid: %w", chainConfig.Name, err)
+	}
+
+	return nil
+}
+
+func validateConfigOverrides(chainConfig types.ChainsConfig, runnerType RunnerType) error {
+	numValidators, numNodes := chainSize(chainConfig, runnerType)
+
+	for _, override := range chainConfig.ConfigOverrides {
+		if err := override.ValidateBasic(numValidators, numNodes); err != nil {
+			return fmt.Errorf("config override of chain %s is invalid: %w", chainConfig.Name, err)
+		}
+	}
+
 	return nil
 }
 
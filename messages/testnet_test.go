@@ -3,6 +3,8 @@ package messages
 import (
 	"testing"
 
+	"github.com/skip-mev/ironbird/petri/core/apps"
+	petritypes "github.com/skip-mev/ironbird/petri/core/types"
 	"github.com/skip-mev/ironbird/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -107,7 +109,7 @@ func TestTestnetWorkflowRequest_Validate(t *testing.T) {
 				RunnerType: Docker,
 			},
 			wantErr: true,
-			errMsg:  "at least one of SetSeedNode or SetPersistentPeers must be set to true",
+			errMsg:  "at least one of SetSeedNode, SetPersistentPeers or Topology must be set",
 		},
 		{
 			name: "valid request with only SetPersistentPeers true",
@@ -139,6 +141,341 @@ func TestTestnetWorkflowRequest_Validate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "custom genesis without genesis url",
+			request: TestnetWorkflowRequest{
+				Repo: "ironbird",
+				SHA:  "abcdef123456",
+				ChainConfig: types.ChainsConfig{
+					Name:               "test-chain",
+					Image:              "simapp-v50",
+					SetSeedNode:        true,
+					SetPersistentPeers: false,
+				},
+				RunnerType:    Docker,
+				CustomGenesis: &CustomGenesisSpec{ValidatorKeysURL: "https://example.com/keys.json"},
+			},
+			wantErr: true,
+			errMsg:  "custom genesis requires a genesis url",
+		},
+		{
+			name: "genesis migration without export height",
+			request: TestnetWorkflowRequest{
+				Repo: "ironbird",
+				SHA:  "abcdef123456",
+				ChainConfig: types.ChainsConfig{
+					Name:               "test-chain",
+					Image:              "simapp-v50",
+					SetSeedNode:        true,
+					SetPersistentPeers: false,
+				},
+				RunnerType:       Docker,
+				GenesisMigration: &GenesisMigrationSpec{},
+			},
+			wantErr: true,
+			errMsg:  "genesis migration requires an export height",
+		},
+		{
+			name: "valid request with genesis migration",
+			request: TestnetWorkflowRequest{
+				Repo: "ironbird",
+				SHA:  "abcdef123456",
+				ChainConfig: types.ChainsConfig{
+					Name:               "test-chain",
+					Image:              "simapp-v50",
+					SetSeedNode:        true,
+					SetPersistentPeers: false,
+				},
+				RunnerType: Docker,
+				GenesisMigration: &GenesisMigrationSpec{
+					ExportHeight:     100,
+					MigrationCommand: []string{"genesis", "migrate", "v0.50"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "additional chain with duplicate name",
+			request: TestnetWorkflowRequest{
+				Repo: "ironbird",
+				SHA:  "abcdef123456",
+				ChainConfig: types.ChainsConfig{
+					Name:               "test-chain",
+					Image:              "simapp-v50",
+					SetSeedNode:        true,
+					SetPersistentPeers: false,
+				},
+				RunnerType: Docker,
+				AdditionalChains: []AdditionalChain{
+					{Repo: "ironbird", ChainConfig: types.ChainsConfig{Name: "test-chain", Image: "simapp-v50", SetSeedNode: true}},
+				},
+			},
+			wantErr: true,
+			errMsg:  "chain name test-chain is used by more than one chain",
+		},
+		{
+			name: "image group with unknown region",
+			request: TestnetWorkflowRequest{
+				Repo: "ironbird",
+				SHA:  "abcdef123456",
+				ChainConfig: types.ChainsConfig{
+					Name:               "test-chain",
+					Image:              "simapp-v50",
+					SetSeedNode:        true,
+					SetPersistentPeers: false,
+					RegionConfigs:      []petritypes.RegionConfig{{Name: "nyc1", NumValidators: 2}},
+					ImageGroups:        []types.ImageGroup{{SHA: "123456abcdef", Region: "ams3"}},
+				},
+				RunnerType: DigitalOcean,
+			},
+			wantErr: true,
+			errMsg:  "image group of chain test-chain references unknown region ams3",
+		},
+		{
+			name: "config override with invalid role",
+			request: TestnetWorkflowRequest{
+				Repo: "ironbird",
+				SHA:  "abcdef123456",
+				ChainConfig: types.ChainsConfig{
+					Name:               "test-chain",
+					Image:              "simapp-v50",
+					SetSeedNode:        true,
+					SetPersistentPeers: false,
+					NumOfValidators:    1,
+					ConfigOverrides: []petritypes.NodeConfigOverride{{
+						Role:           "archive",
+						ConfigOverride: petritypes.ConfigOverride{CustomAppConfig: map[string]interface{}{"pruning": "nothing"}},
+					}},
+				},
+				RunnerType: Docker,
+			},
+			wantErr: true,
+			errMsg:  "config override of chain test-chain is invalid: role must be either validator or node",
+		},
+		{
+			name: "explicit stake distribution with wrong number of amounts",
+			request: TestnetWorkflowRequest{
+				Repo: "ironbird",
+				SHA:  "abcdef123456",
+				ChainConfig: types.ChainsConfig{
+					Name:               "test-chain",
+					Image:              "simapp-v50",
+					SetSeedNode:        true,
+					SetPersistentPeers: false,
+					RegionConfigs:      []petritypes.RegionConfig{{Name: "nyc1", NumValidators: 2}, {Name: "ams3", NumValidators: 1}},
+					StakeDistribution:  &petritypes.StakeDistribution{Type: petritypes.ExplicitStake, Amounts: []uint64{100, 10}},
+				},
+				RunnerType: DigitalOcean,
+			},
+			wantErr: true,
+			errMsg:  "stake distribution of chain test-chain is invalid: explicit stake distribution has 2 amounts but the chain has 3 validators",
+		},
+		{
+			name: "valid request with sentry topology",
+			request: TestnetWorkflowRequest{
+				Repo: "ironbird",
+				SHA:  "abcdef123456",
+				ChainConfig: types.ChainsConfig{
+					Name:            "test-chain",
+					Image:           "simapp-v50",
+					NumOfValidators: 2,
+					NumOfNodes:      2,
+					Topology:        &petritypes.Topology{Type: petritypes.SentryTopology},
+				},
+				RunnerType: Docker,
+			},
+			wantErr: false,
+		},
+		{
+			name: "remote signer with minority threshold",
+			request: TestnetWorkflowRequest{
+				Repo: "ironbird",
+				SHA:  "abcdef123456",
+				ChainConfig: types.ChainsConfig{
+					Name:            "test-chain",
+					Image:           "simapp-v50",
+					NumOfValidators: 2,
+					SetSeedNode:     true,
+					RemoteSigner:    &petritypes.RemoteSigner{Shares: 4, Threshold: 2},
+				},
+				RunnerType: Docker,
+			},
+			wantErr: true,
+			errMsg:  "remote signer of chain test-chain is invalid: threshold must be between 3 and 4 for 4 shares",
+		},
+		{
+			name: "double-sign fault targeting an unknown validator",
+			request: TestnetWorkflowRequest{
+				Repo: "ironbird",
+				SHA:  "abcdef123456",
+				ChainConfig: types.ChainsConfig{
+					Name:            "test-chain",
+					Image:           "simapp-v50",
+					NumOfValidators: 2,
+					SetSeedNode:     true,
+				},
+				RunnerType: Docker,
+				Faults:     []FaultSpec{{Type: DoubleSignFault, After: "5m", Validator: 2}},
+			},
+			wantErr: true,
+			errMsg:  "double-sign fault targets validator 2 but the chain has 2 validators",
+		},
+		{
+			name: "valid request with double-sign fault",
+			request: TestnetWorkflowRequest{
+				Repo: "ironbird",
+				SHA:  "abcdef123456",
+				ChainConfig: types.ChainsConfig{
+					Name:            "test-chain",
+					Image:           "simapp-v50",
+					NumOfValidators: 2,
+					SetSeedNode:     true,
+				},
+				RunnerType: Docker,
+				Faults:     []FaultSpec{{Type: DoubleSignFault, After: "5m", Validator: 1, Timeout: "15m"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "go.mod replace with shell metacharacters",
+			request: TestnetWorkflowRequest{
+				Repo: "ironbird",
+				SHA:  "abcdef123456",
+				ChainConfig: types.ChainsConfig{
+					Name:        "test-chain",
+					Image:       "simapp-v50",
+					SetSeedNode: true,
+				},
+				RunnerType:    Docker,
+				GoModReplaces: map[string]string{"github.com/cosmos/iavl": "github.com/cosmos/iavl@v1.2.6; rm -rf /"},
+			},
+			wantErr: true,
+			errMsg:  `invalid replacement "github.com/cosmos/iavl@v1.2.6; rm -rf /" of module github.com/cosmos/iavl, expected module@version or fork@sha`,
+		},
+		{
+			name: "git auth without git url",
+			request: TestnetWorkflowRequest{
+				Repo: "ironbird",
+				SHA:  "abcdef123456",
+				ChainConfig: types.ChainsConfig{
+					Name:        "test-chain",
+					Image:       "simapp-v50",
+					SetSeedNode: true,
+				},
+				RunnerType: Docker,
+				Source:     ChainSource{GitAuth: "GITHUB_TOKEN"},
+			},
+			wantErr: true,
+			errMsg:  "git auth requires a git url",
+		},
+		{
+			name: "valid request with fork and patch",
+			request: TestnetWorkflowRequest{
+				Repo: "ironbird",
+				SHA:  "abcdef123456",
+				ChainConfig: types.ChainsConfig{
+					Name:        "test-chain",
+					Image:       "simapp-v50",
+					SetSeedNode: true,
+				},
+				RunnerType: Docker,
+				Source: ChainSource{
+					GitURL:  "https://github.com/org/ironbird.git",
+					GitAuth: "GITHUB_TOKEN",
+					Patch:   []byte("diff --git a/go.mod b/go.mod\n"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid docker request with load balancer",
+			request: TestnetWorkflowRequest{
+				Repo: "ironbird",
+				SHA:  "abcdef123456",
+				ChainConfig: types.ChainsConfig{
+					Name:        "test-chain",
+					Image:       "simapp-v50",
+					SetSeedNode: true,
+				},
+				RunnerType:         Docker,
+				LaunchLoadBalancer: true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "load balancer options with unknown domain type",
+			request: TestnetWorkflowRequest{
+				Repo: "ironbird",
+				SHA:  "abcdef123456",
+				ChainConfig: types.ChainsConfig{
+					Name:        "test-chain",
+					Image:       "simapp-v50",
+					SetSeedNode: true,
+				},
+				RunnerType:         Docker,
+				LaunchLoadBalancer: true,
+				LoadBalancer: &LoadBalancerSpec{
+					Options:       apps.LoadBalancerOptions{HealthCheck: true, Policy: "least_conn"},
+					DomainOptions: map[string]apps.LoadBalancerOptions{"websocket": {}},
+				},
+			},
+			wantErr: true,
+			errMsg:  "unknown load balancer domain type websocket",
+		},
+		{
+			name: "unknown build variant",
+			request: TestnetWorkflowRequest{
+				Repo: "ironbird",
+				SHA:  "abcdef123456",
+				ChainConfig: types.ChainsConfig{
+					Name:        "test-chain",
+					Image:       "simapp-v50",
+					SetSeedNode: true,
+				},
+				RunnerType:   Docker,
+				BuildVariant: BuildVariant("msan"),
+			},
+			wantErr: true,
+			errMsg:  "unknown build variant msan",
+		},
+		{
+			name: "relayer without additional chains",
+			request: TestnetWorkflowRequest{
+				Repo: "ironbird",
+				SHA:  "abcdef123456",
+				ChainConfig: types.ChainsConfig{
+					Name:               "test-chain",
+					Image:              "simapp-v50",
+					SetSeedNode:        true,
+					SetPersistentPeers: false,
+				},
+				RunnerType: Docker,
+				Relayer:    &RelayerSpec{},
+			},
+			wantErr: true,
+			errMsg:  "relayer requires at least one additional chain",
+		},
+		{
+			name: "valid request with relayer",
+			request: TestnetWorkflowRequest{
+				Repo: "ironbird",
+				SHA:  "abcdef123456",
+				ChainConfig: types.ChainsConfig{
+					Name:               "test-chain",
+					Image:              "simapp-v50",
+					SetSeedNode:        true,
+					SetPersistentPeers: false,
+				},
+				RunnerType: Docker,
+				AdditionalChains: []AdditionalChain{
+					{Repo: "ironbird", ChainConfig: types.ChainsConfig{Name: "counterparty", Image: "simapp-v50", SetSeedNode: true}},
+				},
+				Relayer: &RelayerSpec{
+					IBCTransferLoad: &IBCTransferLoadSpec{Duration: "10m", Amount: 1, MsgsPerTx: 10},
+				},
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
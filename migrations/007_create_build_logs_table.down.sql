@@ -0,0 +1,4 @@
+-- Drop build logs table
+DROP TRIGGER IF EXISTS delete_workflow_build_logs;
+DROP INDEX IF EXISTS idx_build_logs_workflow_id;
+DROP TABLE IF EXISTS build_logs;
@@ -0,0 +1,19 @@
+-- Create build logs table for the BuildKit output of a workflow's image builds
+CREATE TABLE IF NOT EXISTS build_logs (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    workflow_id TEXT NOT NULL,
+    build TEXT NOT NULL, -- image tag the chunk belongs to, a workflow can build several images
+    content TEXT NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_build_logs_workflow_id ON build_logs (workflow_id, id);
+
+-- logs are appended while the workflow row may not exist yet, so they're cleaned up by a trigger instead of a
+-- foreign key
+CREATE TRIGGER IF NOT EXISTS delete_workflow_build_logs
+    AFTER DELETE ON workflows
+    FOR EACH ROW
+BEGIN
+    DELETE FROM build_logs WHERE workflow_id = OLD.workflow_id;
+END;
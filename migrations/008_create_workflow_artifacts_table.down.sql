@@ -0,0 +1,3 @@
+-- Drop workflow artifacts table
+DROP TRIGGER IF EXISTS delete_workflow_artifacts;
+DROP TABLE IF EXISTS workflow_artifacts;
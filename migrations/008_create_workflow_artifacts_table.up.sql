@@ -0,0 +1,16 @@
+-- Create workflow artifacts table for files collected from a workflow's testnet, e.g. coverage profiles
+CREATE TABLE IF NOT EXISTS workflow_artifacts (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    workflow_id TEXT NOT NULL,
+    name TEXT NOT NULL,
+    content BLOB NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (workflow_id, name)
+);
+
+CREATE TRIGGER IF NOT EXISTS delete_workflow_artifacts
+    AFTER DELETE ON workflows
+    FOR EACH ROW
+BEGIN
+    DELETE FROM workflow_artifacts WHERE workflow_id = OLD.workflow_id;
+END;
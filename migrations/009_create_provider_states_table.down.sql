@@ -0,0 +1,3 @@
+-- Drop provider states table
+DROP TRIGGER IF EXISTS delete_provider_states;
+DROP TABLE IF EXISTS provider_states;
@@ -0,0 +1,15 @@
+-- Create provider states table holding the latest compressed provider state of a workflow's testnet, so that the
+-- testnet can be torn down when the workflow itself no longer can
+CREATE TABLE IF NOT EXISTS provider_states (
+    workflow_id TEXT PRIMARY KEY,
+    runner_type TEXT NOT NULL,
+    state BLOB,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER IF NOT EXISTS delete_provider_states
+    AFTER DELETE ON workflows
+    FOR EACH ROW
+BEGIN
+    DELETE FROM provider_states WHERE workflow_id = OLD.workflow_id;
+END;
@@ -1,19 +1,64 @@
 package apps
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/skip-mev/ironbird/petri/core/provider"
 	"github.com/skip-mev/ironbird/petri/core/provider/digitalocean"
 )
 
+// DefaultLoadBalancerImage is the Caddy image used when a load balancer definition doesn't specify one
+const DefaultLoadBalancerImage = "caddy:2-alpine"
+
+// LoadBalancerPolicies are the Caddy policies upstreams of a domain can be selected with
+var LoadBalancerPolicies = []string{"random", "round_robin", "weighted_round_robin", "least_conn", "first", "ip_hash",
+	"client_ip_hash", "uri_hash"}
+
+var requestBodySizeRegex = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?\s*([kKmMgG]i?)?[bB]?$`)
+
+// LoadBalancerOptions configure how a domain routes traffic to its upstreams
+type LoadBalancerOptions struct {
+	// HealthCheck takes upstreams out of rotation while they fail an active health check. HTTP domains check that
+	// the CometBFT /status of the node doesn't report catching_up, gRPC domains probe the node's gRPC server
+	HealthCheck bool `json:"health_check,omitempty"`
+	// Policy is the Caddy lb_policy upstreams are selected with, Caddy selects randomly by default
+	Policy string `json:"policy,omitempty"`
+	// RateLimit is the number of requests per second a client IP can send, it requires a load balancer image with
+	// the caddy-ratelimit module
+	RateLimit int `json:"rate_limit,omitempty"`
+	// MaxRequestBodySize limits the size of request bodies, e.g. 1MB
+	MaxRequestBodySize string `json:"max_request_body_size,omitempty"`
+}
+
+func (o LoadBalancerOptions) Validate() error {
+	if o.Policy != "" && !slices.Contains(LoadBalancerPolicies, o.Policy) {
+		return fmt.Errorf("policy must be one of %s", strings.Join(LoadBalancerPolicies, ", "))
+	}
+
+	if o.RateLimit < 0 {
+		return fmt.Errorf("rate limit must not be negative")
+	}
+
+	if o.MaxRequestBodySize != "" && !requestBodySizeRegex.MatchString(o.MaxRequestBodySize) {
+		return fmt.Errorf("invalid max request body size %q", o.MaxRequestBodySize)
+	}
+
+	return nil
+}
+
 type LoadBalancerDomain struct {
-	Domain   string   `json:"domain"`
-	IPs      []string `json:"ips,omitempty"`
-	Protocol string   `json:"protocol"`
+	Domain   string              `json:"domain"`
+	IPs      []string            `json:"ips,omitempty"`
+	Protocol string              `json:"protocol"`
+	Options  LoadBalancerOptions `json:"options"`
 }
 
 func (lbd LoadBalancerDomain) Validate() error {
@@ -28,10 +73,17 @@ func (lbd LoadBalancerDomain) Validate() error {
 	if lbd.Protocol != "http" && lbd.Protocol != "grpc" {
 		return fmt.Errorf("protocol must be either 'http' or 'grpc'")
 	}
+
+	if err := lbd.Options.Validate(); err != nil {
+		return fmt.Errorf("invalid options of domain %s: %w", lbd.Domain, err)
+	}
+
 	return nil
 }
 
 type LoadBalancerDefinition struct {
+	// Image is the Caddy image of the load balancer, DefaultLoadBalancerImage if empty
+	Image              string
 	DigitalOceanConfig map[string]string
 	Domains            []LoadBalancerDomain
 	SSLCertificate     []byte
@@ -54,7 +106,12 @@ func (lbd LoadBalancerDefinition) Validate() error {
 	var err error
 
 	for _, domain := range lbd.Domains {
-		err = errors.Join(domain.Validate())
+		err = errors.Join(err, domain.Validate())
+
+		if domain.Options.RateLimit > 0 && lbd.Image == "" {
+			err = errors.Join(err, fmt.Errorf("rate limit of domain %s requires a load balancer image with the "+
+				"caddy-ratelimit module", domain.Domain))
+		}
 	}
 
 	return err
@@ -65,12 +122,14 @@ const CaddyTlsTemplate = "tls /caddy/cert.pem /caddy/key.pem"
 
 // CaddyHttpDomainTemplate is used for HTTP services
 // it uses the default HTTP transport and sends traffic
-// to the second argument in the template
+// to the third argument in the template. The second and
+// fourth argument are site and reverse_proxy directives
 const CaddyHttpDomainTemplate = `%s {
 	log
-	
+%s
 	handle {
-		reverse_proxy %s 
+		reverse_proxy %s {
+%s		}
 	}
 
 	%s
@@ -83,43 +142,200 @@ const CaddyHttpDomainTemplate = `%s {
 // is cleartext
 const CaddyGrpcDomainTemplate = `%s {
 	log
-
+%s
 	handle {
 		reverse_proxy %s {
 			transport http {
 				# Use HTTP/2 cleartext for gRPC
 				versions h2c
 			}
-		}
+%s		}
 	}
 
 	%s
 }
 `
 
-// LaunchLoadBalancer only supports the DigitalOcean provider
-func LaunchLoadBalancer(ctx context.Context, p *digitalocean.Provider, rootDomain string, definition LoadBalancerDefinition) (provider.TaskI, error) {
+// CaddyStatusHealthCheck takes nodes out of rotation while their CometBFT RPC reports that they're catching up
+const CaddyStatusHealthCheck = `			health_uri /status
+			health_port 26657
+			health_interval 5s
+			health_timeout 3s
+			health_body ` + "`" + `"catching_up":\s*false` + "`" + `
+`
+
+// CaddyGrpcHealthCheck takes nodes out of rotation while their gRPC server doesn't answer. gRPC servers answer
+// requests that aren't POSTs with 405 Method Not Allowed, which proves that the server is up without calling a
+// service the node may not register
+const CaddyGrpcHealthCheck = `			health_uri /grpc.health.v1.Health/Check
+			health_headers {
+				Content-Type application/grpc
+			}
+			health_status 405
+			health_interval 5s
+			health_timeout 3s
+`
+
+// caddyGlobalOptions returns the global options block of a Caddyfile. Load balancers that don't terminate TLS
+// serve HTTP/2 cleartext, so that gRPC clients can connect without TLS
+func caddyGlobalOptions(h2c, rateLimit bool) string {
+	var options string
+	if h2c {
+		options += "\tservers {\n\t\tprotocols h1 h2 h2c\n\t}\n"
+	}
+	if rateLimit {
+		options += "\torder rate_limit before basic_auth\n"
+	}
+
+	if options == "" {
+		return ""
+	}
+
+	return "{\n" + options + "}\n"
+}
+
+// caddySiteDirectives returns the directives of a domain's site block that apply before requests are proxied
+func caddySiteDirectives(domain LoadBalancerDomain) string {
+	var directives string
+
+	if domain.Options.MaxRequestBodySize != "" {
+		directives += fmt.Sprintf("\n\trequest_body {\n\t\tmax_size %s\n\t}\n", domain.Options.MaxRequestBodySize)
+	}
+
+	if domain.Options.RateLimit > 0 {
+		directives += fmt.Sprintf("\n\trate_limit {\n\t\tzone %s {\n\t\t\tkey {remote_host}\n\t\t\tevents %d\n"+
+			"\t\t\twindow 1s\n\t\t}\n\t}\n", domain.Domain, domain.Options.RateLimit)
+	}
+
+	return directives
+}
+
+// caddyProxyDirectives returns the reverse_proxy subdirectives of a domain
+func caddyProxyDirectives(domain LoadBalancerDomain) string {
+	var directives string
+
+	if domain.Options.Policy != "" {
+		directives += fmt.Sprintf("\t\t\tlb_policy %s\n", domain.Options.Policy)
+	}
+
+	if domain.Options.HealthCheck {
+		if domain.Protocol == "grpc" {
+			directives += CaddyGrpcHealthCheck
+		} else {
+			directives += CaddyStatusHealthCheck
+		}
+	}
+
+	return directives
+}
+
+// Caddyfile returns the Caddy config of a load balancer. siteAddress returns the address a domain is served on, tls
+// terminates TLS with the certificate written next to the Caddyfile and h2c serves HTTP/2 cleartext
+func (lbd LoadBalancerDefinition) Caddyfile(siteAddress func(LoadBalancerDomain) string, tls, h2c bool) string {
+	tlsTemplate := ""
+	if tls {
+		tlsTemplate = CaddyTlsTemplate
+	}
+
+	rateLimit := slices.ContainsFunc(lbd.Domains, func(d LoadBalancerDomain) bool { return d.Options.RateLimit > 0 })
+	caddyConfig := caddyGlobalOptions(h2c, rateLimit)
+
+	for _, domain := range lbd.Domains {
+		var template string
+		if domain.Protocol == "http" {
+			template = CaddyHttpDomainTemplate
+		} else if domain.Protocol == "grpc" {
+			template = CaddyGrpcDomainTemplate
+		}
+
+		ipDirective := strings.Join(domain.IPs, " ")
+		caddyConfig += fmt.Sprintf(template, siteAddress(domain), caddySiteDirectives(domain), ipDirective,
+			caddyProxyDirectives(domain), tlsTemplate)
+	}
+
+	return caddyConfig
+}
+
+// LoadBalancerBasePort is the port the first domain of a load balancer without DNS is served on
+const LoadBalancerBasePort = 8000
+
+// DomainPorts returns the port every domain is served on by a load balancer without DNS. Ports are assigned in the
+// order of the domain names, starting at LoadBalancerBasePort
+func (lbd LoadBalancerDefinition) DomainPorts() map[string]string {
+	names := make([]string, 0, len(lbd.Domains))
+	for _, domain := range lbd.Domains {
+		names = append(names, domain.Domain)
+	}
+	slices.Sort(names)
+
+	ports := make(map[string]string, len(names))
+	for i, name := range names {
+		ports[name] = strconv.Itoa(LoadBalancerBasePort + i)
+	}
+
+	return ports
+}
+
+// terminatesTLS returns whether the load balancer terminates TLS, which is only done on DigitalOcean
+func (lbd LoadBalancerDefinition) terminatesTLS(isDigitalOcean bool) bool {
+	return isDigitalOcean && lbd.SSLCertificate != nil && lbd.SSLKey != nil
+}
+
+// providerCaddyfile returns the Caddyfile of a load balancer on DigitalOcean, which serves subdomains of rootDomain,
+// or on a provider without DNS, which serves every domain on its own port
+func (lbd LoadBalancerDefinition) providerCaddyfile(rootDomain string, isDigitalOcean bool) string {
+	domainPorts := lbd.DomainPorts()
+
+	return lbd.Caddyfile(func(domain LoadBalancerDomain) string {
+		if isDigitalOcean {
+			return fmt.Sprintf("%s.%s", domain.Domain, rootDomain)
+		}
+		return ":" + domainPorts[domain.Domain]
+	}, lbd.terminatesTLS(isDigitalOcean), !isDigitalOcean)
+}
+
+// LaunchLoadBalancer launches a Caddy load balancer in front of the domains of the definition. On DigitalOcean every
+// domain is a subdomain of rootDomain that DNS records are created for. Other providers skip DNS and TLS, every
+// domain is served on its own port instead (see DomainPorts), which the provider publishes to the host
+func LaunchLoadBalancer(ctx context.Context, p provider.ProviderI, rootDomain string, definition LoadBalancerDefinition) (provider.TaskI, error) {
+	if err := definition.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid load balancer definition: %w", err)
+	}
+
+	doProvider, isDigitalOcean := p.(*digitalocean.Provider)
+
+	image := definition.Image
+	if image == "" {
+		image = DefaultLoadBalancerImage
+	}
+
+	ports := []string{}
+	var providerSpecificConfig map[string]string
+	if isDigitalOcean {
+		providerSpecificConfig = definition.DigitalOceanConfig
+	} else {
+		ports = slices.Sorted(maps.Values(definition.DomainPorts()))
+	}
+
 	task, err := p.CreateTask(ctx, provider.TaskDefinition{
 		Name: "loadbalancer",
 		Image: provider.ImageDefinition{
-			Image: "caddy:2-alpine",
+			Image: image,
 			UID:   "0",
 			GID:   "0",
 		},
-		Ports:      []string{},
+		Ports:      ports,
 		DataDir:    "/caddy",
 		Entrypoint: []string{"caddy", "run", "--config", "/caddy/Caddyfile"},
 
-		ProviderSpecificConfig: definition.DigitalOceanConfig,
+		ProviderSpecificConfig: providerSpecificConfig,
 	})
 
 	if err != nil {
 		return nil, err
 	}
 
-	tlsTemplate := ""
-
-	if definition.SSLCertificate != nil && definition.SSLKey != nil {
+	if definition.terminatesTLS(isDigitalOcean) {
 		if err := task.WriteFile(ctx, "cert.pem", definition.SSLCertificate); err != nil {
 			return nil, err
 		}
@@ -127,24 +343,9 @@ func LaunchLoadBalancer(ctx context.Context, p *digitalocean.Provider, rootDomai
 		if err := task.WriteFile(ctx, "key.pem", definition.SSLKey); err != nil {
 			return nil, err
 		}
-
-		tlsTemplate = CaddyTlsTemplate
 	}
 
-	caddyConfig := ""
-
-	for _, domain := range definition.Domains {
-		fullDomain := fmt.Sprintf("%s.%s", domain.Domain, rootDomain)
-		var template string
-		if domain.Protocol == "http" {
-			template = CaddyHttpDomainTemplate
-		} else if domain.Protocol == "grpc" {
-			template = CaddyGrpcDomainTemplate
-		}
-
-		ipDirective := strings.Join(domain.IPs, " ")
-		caddyConfig += fmt.Sprintf(template, fullDomain, ipDirective, tlsTemplate)
-	}
+	caddyConfig := definition.providerCaddyfile(rootDomain, isDigitalOcean)
 
 	if err := task.WriteFile(ctx, "Caddyfile", []byte(caddyConfig)); err != nil {
 		return nil, err
@@ -154,6 +355,10 @@ func LaunchLoadBalancer(ctx context.Context, p *digitalocean.Provider, rootDomai
 		return nil, err
 	}
 
+	if !isDigitalOcean {
+		return task, nil
+	}
+
 	// hack: until we figure out how to best handle returning addresses in providers
 	doTask, ok := task.(*digitalocean.Task)
 	if !ok {
@@ -178,9 +383,47 @@ func LaunchLoadBalancer(ctx context.Context, p *digitalocean.Provider, rootDomai
 		domains[domain.Domain] = ip
 	}
 
-	if err := p.CreateDomains(ctx, domains); err != nil {
+	if err := doProvider.CreateDomains(ctx, domains); err != nil {
 		return nil, err
 	}
 
 	return task, nil
 }
+
+// UpdateLoadBalancer regenerates the Caddyfile of a running load balancer from the domains of the definition and
+// hot-reloads Caddy if the config changed. It returns whether the load balancer was reloaded. Only the upstreams and
+// options of the domains can change, the domains themselves must be the ones the load balancer was launched with
+func UpdateLoadBalancer(ctx context.Context, task provider.TaskI, rootDomain string, definition LoadBalancerDefinition) (bool, error) {
+	if err := definition.Validate(); err != nil {
+		return false, fmt.Errorf("invalid load balancer definition: %w", err)
+	}
+
+	_, isDigitalOcean := task.(*digitalocean.Task)
+
+	// without DNS the domains are served on the ports that were published when the load balancer was launched
+	if !isDigitalOcean && !slices.Equal(slices.Sorted(maps.Values(definition.DomainPorts())), task.GetDefinition().Ports) {
+		return false, fmt.Errorf("load balancer domains changed since the load balancer was launched")
+	}
+
+	caddyConfig := []byte(definition.providerCaddyfile(rootDomain, isDigitalOcean))
+
+	current, err := task.ReadFile(ctx, "Caddyfile")
+	if err == nil && bytes.Equal(current, caddyConfig) {
+		return false, nil
+	}
+
+	if err := task.WriteFile(ctx, "Caddyfile", caddyConfig); err != nil {
+		return false, err
+	}
+
+	stdout, stderr, exitCode, err := task.RunCommand(ctx, []string{"caddy", "reload", "--config", "/caddy/Caddyfile"})
+	if err != nil {
+		return false, fmt.Errorf("failed to reload load balancer: %w", err)
+	}
+
+	if exitCode != 0 {
+		return false, fmt.Errorf("failed to reload load balancer (exit code %d): %s, stdout: %s", exitCode, stderr, stdout)
+	}
+
+	return true, nil
+}
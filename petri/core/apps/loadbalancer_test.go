@@ -0,0 +1,115 @@
+package apps
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadBalancerCaddyfile(t *testing.T) {
+	definition := LoadBalancerDefinition{
+		Image: "ironbird-caddy",
+		Domains: []LoadBalancerDomain{
+			{
+				Domain:   "chain-rpc",
+				IPs:      []string{"10.0.0.1:26657", "10.0.0.2:26657"},
+				Protocol: "http",
+				Options: LoadBalancerOptions{
+					HealthCheck:        true,
+					Policy:             "least_conn",
+					RateLimit:          50,
+					MaxRequestBodySize: "1MB",
+				},
+			},
+			{
+				Domain:   "chain-grpc",
+				IPs:      []string{"10.0.0.1:9090"},
+				Protocol: "grpc",
+				Options:  LoadBalancerOptions{HealthCheck: true},
+			},
+		},
+	}
+	require.NoError(t, definition.Validate())
+
+	ports := definition.DomainPorts()
+	require.Equal(t, map[string]string{"chain-grpc": "8000", "chain-rpc": "8001"}, ports)
+
+	caddyfile := definition.Caddyfile(func(domain LoadBalancerDomain) string {
+		return ":" + ports[domain.Domain]
+	}, false, true)
+
+	require.Equal(t, `{
+	servers {
+		protocols h1 h2 h2c
+	}
+	order rate_limit before basic_auth
+}
+:8001 {
+	log
+
+	request_body {
+		max_size 1MB
+	}
+
+	rate_limit {
+		zone chain-rpc {
+			key {remote_host}
+			events 50
+			window 1s
+		}
+	}
+
+	handle {
+		reverse_proxy 10.0.0.1:26657 10.0.0.2:26657 {
+			lb_policy least_conn
+			health_uri /status
+			health_port 26657
+			health_interval 5s
+			health_timeout 3s
+			health_body `+"`"+`"catching_up":\s*false`+"`"+`
+		}
+	}
+
+	
+}
+:8000 {
+	log
+
+	handle {
+		reverse_proxy 10.0.0.1:9090 {
+			transport http {
+				# Use HTTP/2 cleartext for gRPC
+				versions h2c
+			}
+			health_uri /grpc.health.v1.Health/Check
+			health_headers {
+				Content-Type application/grpc
+			}
+			health_status 405
+			health_interval 5s
+			health_timeout 3s
+		}
+	}
+
+	
+}
+`, caddyfile)
+}
+
+func TestLoadBalancerDefinitionValidate(t *testing.T) {
+	domain := LoadBalancerDomain{Domain: "chain-rpc", IPs: []string{"10.0.0.1:26657"}, Protocol: "http"}
+
+	invalidPolicy := domain
+	invalidPolicy.Options.Policy = "fastest"
+	require.ErrorContains(t, LoadBalancerDefinition{Domains: []LoadBalancerDomain{invalidPolicy}}.Validate(), "policy must be one of")
+
+	invalidSize := domain
+	invalidSize.Options.MaxRequestBodySize = "a lot"
+	require.ErrorContains(t, LoadBalancerDefinition{Domains: []LoadBalancerDomain{invalidSize}}.Validate(), "invalid max request body size")
+
+	// the default image doesn't include the rate limit module
+	rateLimited := domain
+	rateLimited.Options.RateLimit = 10
+	require.ErrorContains(t, LoadBalancerDefinition{Domains: []LoadBalancerDomain{rateLimited}}.Validate(), "caddy-ratelimit")
+	require.NoError(t, LoadBalancerDefinition{Image: "ironbird-caddy", Domains: []LoadBalancerDomain{rateLimited}}.Validate())
+}
@@ -0,0 +1,376 @@
+package apps
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/skip-mev/ironbird/petri/core/provider"
+)
+
+// DefaultRelayerImage is the Hermes image used when a relayer definition doesn't specify one
+const DefaultRelayerImage = "ghcr.io/informalsystems/hermes:1.13.1"
+
+const (
+	relayerHome       = "/home/hermes/.hermes"
+	relayerConfigPath = relayerHome + "/config.toml"
+
+	// RelayerKeyName is the key Hermes relays packets with
+	RelayerKeyName = "relayer"
+	// TransferKeyName is the key IBC transfers are sent with, so that they don't race the relayer's sequence
+	TransferKeyName = "transfer"
+)
+
+type RelayerChain struct {
+	ChainID      string `json:"chain_id"`
+	RPCAddress   string `json:"rpc_address"`  // RPCAddress is the host:port of the chain's CometBFT RPC
+	GRPCAddress  string `json:"grpc_address"` // GRPCAddress is the host:port of the chain's gRPC server
+	Bech32Prefix string `json:"bech32_prefix"`
+	GasPrice     string `json:"gas_price"` // GasPrice is the decimal gas price, e.g. 0.025
+	GasDenom     string `json:"gas_denom"`
+	IsEVMChain   bool   `json:"is_evm_chain"`
+
+	RelayerMnemonic  string `json:"relayer_mnemonic"`
+	TransferMnemonic string `json:"transfer_mnemonic,omitempty"`
+}
+
+func (rc RelayerChain) Validate() error {
+	if rc.ChainID == "" {
+		return fmt.Errorf("chain id must be specified")
+	}
+
+	if rc.RPCAddress == "" || rc.GRPCAddress == "" {
+		return fmt.Errorf("rpc and grpc addresses must be specified for chain %s", rc.ChainID)
+	}
+
+	if rc.GasDenom == "" {
+		return fmt.Errorf("gas denom must be specified for chain %s", rc.ChainID)
+	}
+
+	if rc.RelayerMnemonic == "" {
+		return fmt.Errorf("relayer mnemonic must be specified for chain %s", rc.ChainID)
+	}
+
+	return nil
+}
+
+// RelayerPath is a pair of chains that are connected by a transfer channel
+type RelayerPath struct {
+	ChainA string `json:"chain_a"`
+	ChainB string `json:"chain_b"`
+}
+
+// RelayerChannel is a transfer channel created by the relayer
+type RelayerChannel struct {
+	ChainA   string `json:"chain_a"`
+	ChainB   string `json:"chain_b"`
+	ChannelA string `json:"channel_a"`
+	ChannelB string `json:"channel_b"`
+}
+
+type RelayerDefinition struct {
+	Image                  string
+	Chains                 []RelayerChain
+	Paths                  []RelayerPath
+	ProviderSpecificConfig map[string]string
+}
+
+func (rd RelayerDefinition) Validate() error {
+	if len(rd.Chains) < 2 {
+		return fmt.Errorf("at least two chains must be specified")
+	}
+
+	if len(rd.Paths) == 0 {
+		return fmt.Errorf("at least one path must be specified")
+	}
+
+	chains := make(map[string]bool, len(rd.Chains))
+	var err error
+
+	for _, chain := range rd.Chains {
+		err = errors.Join(err, chain.Validate())
+		chains[chain.ChainID] = true
+	}
+
+	for _, path := range rd.Paths {
+		if !chains[path.ChainA] || !chains[path.ChainB] {
+			err = errors.Join(err, fmt.Errorf("path %s <-> %s references an unknown chain", path.ChainA, path.ChainB))
+		}
+	}
+
+	return err
+}
+
+// HermesChainTemplate is the Hermes configuration of a single chain
+const HermesChainTemplate = `
+[[chains]]
+id = '%[1]s'
+type = 'CosmosSdk'
+rpc_addr = 'http://%[2]s'
+grpc_addr = 'http://%[3]s'
+event_source = { mode = 'push', url = 'ws://%[2]s/websocket', batch_delay = '500ms' }
+rpc_timeout = '10s'
+account_prefix = '%[4]s'
+key_name = '%[5]s'
+store_prefix = 'ibc'
+default_gas = 100000
+max_gas = 4000000
+gas_price = { price = %[6]s, denom = '%[7]s' }
+gas_multiplier = 1.5
+max_msg_num = 30
+max_tx_size = 2097152
+clock_drift = '5s'
+max_block_time = '30s'
+trusting_period = '14days'
+trust_threshold = { numerator = '1', denominator = '3' }
+address_type = %[8]s
+`
+
+// HermesGlobalTemplate relays packets and keeps clients up to date, channels are created explicitly
+const HermesGlobalTemplate = `[global]
+log_level = 'info'
+
+[mode.clients]
+enabled = true
+refresh = true
+misbehaviour = false
+
+[mode.connections]
+enabled = false
+
+[mode.channels]
+enabled = false
+
+[mode.packets]
+enabled = true
+clear_interval = 100
+clear_on_start = true
+tx_confirmation = false
+
+[rest]
+enabled = false
+
+[telemetry]
+enabled = true
+host = '0.0.0.0'
+port = 3001
+`
+
+// GenerateHermesConfig renders the Hermes config.toml for the given chains
+func GenerateHermesConfig(chains []RelayerChain) string {
+	var b strings.Builder
+	b.WriteString(HermesGlobalTemplate)
+
+	for _, chain := range chains {
+		addressType := "{ derivation = 'cosmos' }"
+		if chain.IsEVMChain {
+			addressType = "{ derivation = 'ethermint', proto_type = { pk_type = '/cosmos.evm.crypto.v1.ethsecp256k1.PubKey' } }"
+		}
+
+		gasPrice := chain.GasPrice
+		if gasPrice == "" {
+			gasPrice = "0"
+		}
+
+		b.WriteString(fmt.Sprintf(HermesChainTemplate, chain.ChainID, chain.RPCAddress, chain.GRPCAddress,
+			chain.Bech32Prefix, RelayerKeyName, gasPrice, chain.GasDenom, addressType))
+	}
+
+	return b.String()
+}
+
+// LaunchRelayer launches a Hermes relayer that creates a transfer channel (and the underlying clients and
+// connection) for every path of the definition and then relays packets between the chains
+func LaunchRelayer(ctx context.Context, p provider.ProviderI, definition RelayerDefinition) (provider.TaskI, []RelayerChannel, error) {
+	if err := definition.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("invalid relayer definition: %w", err)
+	}
+
+	image := definition.Image
+	if image == "" {
+		image = DefaultRelayerImage
+	}
+
+	task, err := p.CreateTask(ctx, provider.TaskDefinition{
+		Name: "relayer",
+		Image: provider.ImageDefinition{
+			Image: image,
+			UID:   "1000",
+			GID:   "1000",
+		},
+		Ports:      []string{"3001"},
+		DataDir:    relayerHome,
+		Entrypoint: []string{"hermes", "--config", relayerConfigPath, "start"},
+
+		ProviderSpecificConfig: definition.ProviderSpecificConfig,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := task.WriteFile(ctx, "config.toml", []byte(GenerateHermesConfig(definition.Chains))); err != nil {
+		return task, nil, err
+	}
+
+	for _, chain := range definition.Chains {
+		if err := addRelayerKey(ctx, task, chain, RelayerKeyName, chain.RelayerMnemonic); err != nil {
+			return task, nil, err
+		}
+
+		if chain.TransferMnemonic != "" {
+			if err := addRelayerKey(ctx, task, chain, TransferKeyName, chain.TransferMnemonic); err != nil {
+				return task, nil, err
+			}
+		}
+	}
+
+	channels := make([]RelayerChannel, 0, len(definition.Paths))
+	for _, path := range definition.Paths {
+		channel, err := createTransferChannel(ctx, task, path)
+		if err != nil {
+			return task, nil, err
+		}
+
+		channels = append(channels, channel)
+	}
+
+	if err := task.Start(ctx); err != nil {
+		return task, nil, err
+	}
+
+	return task, channels, nil
+}
+
+// RunIBCTransfers sends msgsPerTx transfers of amount in both directions over every channel with the transfer key
+// and returns the number of transfers sent
+func RunIBCTransfers(ctx context.Context, task provider.TaskI, channels []RelayerChannel, denoms map[string]string,
+	amount uint64, msgsPerTx int,
+) (int, error) {
+	sent := 0
+
+	for _, channel := range channels {
+		directions := [][3]string{
+			{channel.ChainA, channel.ChainB, channel.ChannelA},
+			{channel.ChainB, channel.ChainA, channel.ChannelB},
+		}
+
+		for _, d := range directions {
+			cmd := hermesCommand("tx", "ft-transfer",
+				"--src-chain", d[0], "--dst-chain", d[1],
+				"--src-port", "transfer", "--src-channel", d[2],
+				"--amount", fmt.Sprint(amount), "--denom", denoms[d[0]],
+				"--number-msgs", fmt.Sprint(msgsPerTx),
+				"--timeout-seconds", "120",
+				"--key-name", TransferKeyName,
+			)
+
+			if _, err := runHermes(ctx, task, cmd); err != nil {
+				return sent, fmt.Errorf("failed to transfer from %s to %s: %w", d[0], d[1], err)
+			}
+
+			sent += msgsPerTx
+		}
+	}
+
+	return sent, nil
+}
+
+func hermesCommand(args ...string) []string {
+	return append([]string{"hermes", "--config", relayerConfigPath, "--json"}, args...)
+}
+
+// runHermes runs a Hermes command and returns the result of its final JSON status line
+func runHermes(ctx context.Context, task provider.TaskI, cmd []string) (json.RawMessage, error) {
+	stdout, stderr, exitCode, err := task.RunCommand(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	if exitCode != 0 {
+		return nil, fmt.Errorf("hermes command failed (exit code %d): %s, stdout: %s", exitCode, stderr, stdout)
+	}
+
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		var status struct {
+			Result json.RawMessage `json:"result"`
+			Status string          `json:"status"`
+		}
+
+		if err := json.Unmarshal([]byte(lines[i]), &status); err != nil || status.Status == "" {
+			continue
+		}
+
+		if status.Status != "success" {
+			return nil, fmt.Errorf("hermes command failed: %s", status.Result)
+		}
+
+		return status.Result, nil
+	}
+
+	return nil, fmt.Errorf("hermes command returned no status, stdout: %s", stdout)
+}
+
+func addRelayerKey(ctx context.Context, task provider.TaskI, chain RelayerChain, keyName, mnemonic string) error {
+	mnemonicFile := fmt.Sprintf("%s-%s.mnemonic", chain.ChainID, keyName)
+	if err := task.WriteFile(ctx, mnemonicFile, []byte(mnemonic)); err != nil {
+		return err
+	}
+
+	hdPath := "m/44'/118'/0'/0/0"
+	if chain.IsEVMChain {
+		hdPath = "m/44'/60'/0'/0/0"
+	}
+
+	cmd := hermesCommand("keys", "add", "--chain", chain.ChainID, "--key-name", keyName,
+		"--mnemonic-file", fmt.Sprintf("%s/%s", relayerHome, mnemonicFile), "--hd-path", hdPath, "--overwrite")
+
+	if _, err := runHermes(ctx, task, cmd); err != nil {
+		return fmt.Errorf("failed to add %s key for %s: %w", keyName, chain.ChainID, err)
+	}
+
+	return nil
+}
+
+func createTransferChannel(ctx context.Context, task provider.TaskI, path RelayerPath) (RelayerChannel, error) {
+	cmd := hermesCommand("create", "channel",
+		"--a-chain", path.ChainA, "--b-chain", path.ChainB,
+		"--a-port", "transfer", "--b-port", "transfer",
+		"--new-client-connection", "--yes",
+	)
+
+	result, err := runHermes(ctx, task, cmd)
+	if err != nil {
+		return RelayerChannel{}, fmt.Errorf("failed to create channel between %s and %s: %w", path.ChainA, path.ChainB, err)
+	}
+
+	return parseChannel(path, result)
+}
+
+func parseChannel(path RelayerPath, result json.RawMessage) (RelayerChannel, error) {
+	var channel struct {
+		ASide struct {
+			ChannelID string `json:"channel_id"`
+		} `json:"a_side"`
+		BSide struct {
+			ChannelID string `json:"channel_id"`
+		} `json:"b_side"`
+	}
+
+	if err := json.Unmarshal(result, &channel); err != nil {
+		return RelayerChannel{}, fmt.Errorf("failed to parse created channel: %w", err)
+	}
+
+	if channel.ASide.ChannelID == "" || channel.BSide.ChannelID == "" {
+		return RelayerChannel{}, fmt.Errorf("created channel is missing channel ids: %s", result)
+	}
+
+	return RelayerChannel{
+		ChainA:   path.ChainA,
+		ChainB:   path.ChainB,
+		ChannelA: channel.ASide.ChannelID,
+		ChannelB: channel.BSide.ChannelID,
+	}, nil
+}
@@ -26,16 +26,20 @@ type DoClient interface {
 	// Domain operations
 	CreateDomain(ctx context.Context, rootDomain string, req *godo.DomainRecordEditRequest) (*godo.DomainRecord, error)
 	GetDomain(ctx context.Context, rootDomain string, recordId int) (*godo.DomainRecord, error)
+	ListDomainRecords(ctx context.Context, rootDomain string, opts *godo.ListOptions) ([]godo.DomainRecord, error)
 	DeleteDomain(ctx context.Context, rootDomain string, recordId int) error
 
 	// SSH Key operations
 	CreateKey(ctx context.Context, req *godo.KeyCreateRequest) (*godo.Key, error)
 	DeleteKeyByFingerprint(ctx context.Context, fingerprint string) error
 	GetKeyByFingerprint(ctx context.Context, fingerprint string) (*godo.Key, error)
+	ListKeys(ctx context.Context, opts *godo.ListOptions) ([]godo.Key, error)
 
 	// Tag operations
 	CreateTag(ctx context.Context, req *godo.TagCreateRequest) (*godo.Tag, error)
 	ListTags(ctx context.Context, opts *godo.ListOptions) ([]godo.Tag, error)
+	TagResources(ctx context.Context, tag string, resources []godo.Resource) error
+	UntagResources(ctx context.Context, tag string, resources []godo.Resource) error
 	DeleteTag(ctx context.Context, tag string) error
 }
 
@@ -157,6 +161,14 @@ func (c *godoClient) GetDomain(ctx context.Context, rootDomain string, recordId
 	return domainResp, nil
 }
 
+func (c *godoClient) ListDomainRecords(ctx context.Context, rootDomain string, opts *godo.ListOptions) ([]godo.DomainRecord, error) {
+	records, res, err := c.Domains.Records(ctx, rootDomain, opts)
+	if err := checkResponse(res, err); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
 func (c *godoClient) DeleteDomain(ctx context.Context, rootDomain string, recordId int) error {
 	res, err := c.Domains.DeleteRecord(ctx, rootDomain, recordId)
 	return checkResponse(res, err)
@@ -184,6 +196,14 @@ func (c *godoClient) GetKeyByFingerprint(ctx context.Context, fingerprint string
 	return key, nil
 }
 
+func (c *godoClient) ListKeys(ctx context.Context, opts *godo.ListOptions) ([]godo.Key, error) {
+	keys, res, err := c.Keys.List(ctx, opts)
+	if err := checkResponse(res, err); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
 // Tag operations
 func (c *godoClient) CreateTag(ctx context.Context, req *godo.TagCreateRequest) (*godo.Tag, error) {
 	tag, res, err := c.Tags.Create(ctx, req)
@@ -201,6 +221,16 @@ func (c *godoClient) ListTags(ctx context.Context, opts *godo.ListOptions) ([]go
 	return tags, nil
 }
 
+func (c *godoClient) TagResources(ctx context.Context, tag string, resources []godo.Resource) error {
+	res, err := c.Tags.TagResources(ctx, tag, &godo.TagResourcesRequest{Resources: resources})
+	return checkResponse(res, err)
+}
+
+func (c *godoClient) UntagResources(ctx context.Context, tag string, resources []godo.Resource) error {
+	res, err := c.Tags.UntagResources(ctx, tag, &godo.UntagResourcesRequest{Resources: resources})
+	return checkResponse(res, err)
+}
+
 func (c *godoClient) DeleteTag(ctx context.Context, tag string) error {
 	res, err := c.Tags.Delete(ctx, tag)
 	return checkResponse(res, err)
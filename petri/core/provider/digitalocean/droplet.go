@@ -54,6 +54,9 @@ func (p *Provider) CreateDroplet(ctx context.Context, definition provider.TaskDe
 	state := p.GetState()
 
 	tags := []string{state.PetriTag}
+	if !state.ExpiresAt.IsZero() {
+		tags = append(tags, ExpiryTag(state.ExpiresAt))
+	}
 
 	req := &godo.DropletCreateRequest{
 		Name:    fmt.Sprintf("%s-%s", state.PetriTag, definition.Name),
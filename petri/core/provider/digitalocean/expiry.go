@@ -0,0 +1,75 @@
+package digitalocean
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+
+	"github.com/skip-mev/ironbird/petri/core/provider"
+)
+
+// ExpiryTagPrefix prefixes the tag holding the time after which the droplets of a provider may be reaped
+const ExpiryTagPrefix = "expires-at:"
+
+// ExpiryTag returns the tag stamping droplets with their expiry
+func ExpiryTag(expiresAt time.Time) string {
+	return ExpiryTagPrefix + provider.FormatExpiry(expiresAt)
+}
+
+// ParseExpiryTag returns the expiry held by a tag created by ExpiryTag
+func ParseExpiryTag(tag string) (time.Time, bool) {
+	expiry, ok := strings.CutPrefix(tag, ExpiryTagPrefix)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	expiresAt, err := provider.ParseExpiry(expiry)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return expiresAt, true
+}
+
+// SetExpiry moves the droplets of the provider to the expiry tag of expiresAt. The previous expiry tag is left in
+// place since other providers may share it, the cleanup job deletes it once it is unused and expired
+func (p *Provider) SetExpiry(ctx context.Context, expiresAt time.Time) error {
+	state := p.GetState()
+
+	tag := ExpiryTag(expiresAt)
+	if !state.ExpiresAt.IsZero() && ExpiryTag(state.ExpiresAt) == tag {
+		return nil
+	}
+
+	if _, err := p.createTag(ctx, tag); err != nil {
+		return fmt.Errorf("failed to create expiry tag: %w", err)
+	}
+
+	var droplets []godo.Resource
+	for _, taskState := range state.TaskStates {
+		droplets = append(droplets, godo.Resource{ID: taskState.ID, Type: godo.DropletResourceType})
+	}
+
+	if len(droplets) > 0 {
+		if err := p.doClient.TagResources(ctx, tag, droplets); err != nil {
+			return fmt.Errorf("failed to tag droplets with %s: %w", tag, err)
+		}
+	}
+
+	p.stateMu.Lock()
+	p.state.ExpiresAt = expiresAt
+	p.stateMu.Unlock()
+
+	if state.ExpiresAt.IsZero() || len(droplets) == 0 {
+		return nil
+	}
+
+	if err := p.doClient.UntagResources(ctx, ExpiryTag(state.ExpiresAt), droplets); err != nil {
+		return fmt.Errorf("failed to untag droplets: %w", err)
+	}
+
+	return nil
+}
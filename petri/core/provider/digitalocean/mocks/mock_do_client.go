@@ -830,6 +830,64 @@ func (_c *MockDoClient_GetKeyByFingerprint_Call) RunAndReturn(run func(ctx conte
 	return _c
 }
 
+// ListDomainRecords provides a mock function for the type MockDoClient
+func (_mock *MockDoClient) ListDomainRecords(ctx context.Context, rootDomain string, opts *godo.ListOptions) ([]godo.DomainRecord, error) {
+	ret := _mock.Called(ctx, rootDomain, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDomainRecords")
+	}
+
+	var r0 []godo.DomainRecord
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *godo.ListOptions) ([]godo.DomainRecord, error)); ok {
+		return returnFunc(ctx, rootDomain, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *godo.ListOptions) []godo.DomainRecord); ok {
+		r0 = returnFunc(ctx, rootDomain, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]godo.DomainRecord)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *godo.ListOptions) error); ok {
+		r1 = returnFunc(ctx, rootDomain, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDoClient_ListDomainRecords_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDomainRecords'
+type MockDoClient_ListDomainRecords_Call struct {
+	*mock.Call
+}
+
+// ListDomainRecords is a helper method to define mock.On call
+//   - ctx
+//   - rootDomain
+//   - opts
+func (_e *MockDoClient_Expecter) ListDomainRecords(ctx interface{}, rootDomain interface{}, opts interface{}) *MockDoClient_ListDomainRecords_Call {
+	return &MockDoClient_ListDomainRecords_Call{Call: _e.mock.On("ListDomainRecords", ctx, rootDomain, opts)}
+}
+
+func (_c *MockDoClient_ListDomainRecords_Call) Run(run func(ctx context.Context, rootDomain string, opts *godo.ListOptions)) *MockDoClient_ListDomainRecords_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*godo.ListOptions))
+	})
+	return _c
+}
+
+func (_c *MockDoClient_ListDomainRecords_Call) Return(domainRecords []godo.DomainRecord, err error) *MockDoClient_ListDomainRecords_Call {
+	_c.Call.Return(domainRecords, err)
+	return _c
+}
+
+func (_c *MockDoClient_ListDomainRecords_Call) RunAndReturn(run func(ctx context.Context, rootDomain string, opts *godo.ListOptions) ([]godo.DomainRecord, error)) *MockDoClient_ListDomainRecords_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListDroplets provides a mock function for the type MockDoClient
 func (_mock *MockDoClient) ListDroplets(ctx context.Context, opts *godo.ListOptions) ([]godo.Droplet, error) {
 	ret := _mock.Called(ctx, opts)
@@ -944,6 +1002,63 @@ func (_c *MockDoClient_ListFirewalls_Call) RunAndReturn(run func(ctx context.Con
 	return _c
 }
 
+// ListKeys provides a mock function for the type MockDoClient
+func (_mock *MockDoClient) ListKeys(ctx context.Context, opts *godo.ListOptions) ([]godo.Key, error) {
+	ret := _mock.Called(ctx, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListKeys")
+	}
+
+	var r0 []godo.Key
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *godo.ListOptions) ([]godo.Key, error)); ok {
+		return returnFunc(ctx, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *godo.ListOptions) []godo.Key); ok {
+		r0 = returnFunc(ctx, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]godo.Key)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *godo.ListOptions) error); ok {
+		r1 = returnFunc(ctx, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDoClient_ListKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListKeys'
+type MockDoClient_ListKeys_Call struct {
+	*mock.Call
+}
+
+// ListKeys is a helper method to define mock.On call
+//   - ctx
+//   - opts
+func (_e *MockDoClient_Expecter) ListKeys(ctx interface{}, opts interface{}) *MockDoClient_ListKeys_Call {
+	return &MockDoClient_ListKeys_Call{Call: _e.mock.On("ListKeys", ctx, opts)}
+}
+
+func (_c *MockDoClient_ListKeys_Call) Run(run func(ctx context.Context, opts *godo.ListOptions)) *MockDoClient_ListKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*godo.ListOptions))
+	})
+	return _c
+}
+
+func (_c *MockDoClient_ListKeys_Call) Return(keys []godo.Key, err error) *MockDoClient_ListKeys_Call {
+	_c.Call.Return(keys, err)
+	return _c
+}
+
+func (_c *MockDoClient_ListKeys_Call) RunAndReturn(run func(ctx context.Context, opts *godo.ListOptions) ([]godo.Key, error)) *MockDoClient_ListKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListTags provides a mock function for the type MockDoClient
 func (_mock *MockDoClient) ListTags(ctx context.Context, opts *godo.ListOptions) ([]godo.Tag, error) {
 	ret := _mock.Called(ctx, opts)
@@ -1000,3 +1115,97 @@ func (_c *MockDoClient_ListTags_Call) RunAndReturn(run func(ctx context.Context,
 	_c.Call.Return(run)
 	return _c
 }
+
+// TagResources provides a mock function for the type MockDoClient
+func (_mock *MockDoClient) TagResources(ctx context.Context, tag string, resources []godo.Resource) error {
+	ret := _mock.Called(ctx, tag, resources)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TagResources")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []godo.Resource) error); ok {
+		r0 = returnFunc(ctx, tag, resources)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDoClient_TagResources_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TagResources'
+type MockDoClient_TagResources_Call struct {
+	*mock.Call
+}
+
+// TagResources is a helper method to define mock.On call
+//   - ctx
+//   - tag
+//   - resources
+func (_e *MockDoClient_Expecter) TagResources(ctx interface{}, tag interface{}, resources interface{}) *MockDoClient_TagResources_Call {
+	return &MockDoClient_TagResources_Call{Call: _e.mock.On("TagResources", ctx, tag, resources)}
+}
+
+func (_c *MockDoClient_TagResources_Call) Run(run func(ctx context.Context, tag string, resources []godo.Resource)) *MockDoClient_TagResources_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]godo.Resource))
+	})
+	return _c
+}
+
+func (_c *MockDoClient_TagResources_Call) Return(err error) *MockDoClient_TagResources_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDoClient_TagResources_Call) RunAndReturn(run func(ctx context.Context, tag string, resources []godo.Resource) error) *MockDoClient_TagResources_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UntagResources provides a mock function for the type MockDoClient
+func (_mock *MockDoClient) UntagResources(ctx context.Context, tag string, resources []godo.Resource) error {
+	ret := _mock.Called(ctx, tag, resources)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UntagResources")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []godo.Resource) error); ok {
+		r0 = returnFunc(ctx, tag, resources)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDoClient_UntagResources_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UntagResources'
+type MockDoClient_UntagResources_Call struct {
+	*mock.Call
+}
+
+// UntagResources is a helper method to define mock.On call
+//   - ctx
+//   - tag
+//   - resources
+func (_e *MockDoClient_Expecter) UntagResources(ctx interface{}, tag interface{}, resources interface{}) *MockDoClient_UntagResources_Call {
+	return &MockDoClient_UntagResources_Call{Call: _e.mock.On("UntagResources", ctx, tag, resources)}
+}
+
+func (_c *MockDoClient_UntagResources_Call) Run(run func(ctx context.Context, tag string, resources []godo.Resource)) *MockDoClient_UntagResources_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]godo.Resource))
+	})
+	return _c
+}
+
+func (_c *MockDoClient_UntagResources_Call) Return(err error) *MockDoClient_UntagResources_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDoClient_UntagResources_Call) RunAndReturn(run func(ctx context.Context, tag string, resources []godo.Resource) error) *MockDoClient_UntagResources_Call {
+	_c.Call.Return(run)
+	return _c
+}
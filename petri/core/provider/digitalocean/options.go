@@ -1,6 +1,8 @@
 package digitalocean
 
 import (
+	"time"
+
 	"github.com/skip-mev/ironbird/petri/core/provider/clients"
 	"go.uber.org/zap"
 )
@@ -28,3 +30,10 @@ func WithDomain(domain string) func(*Provider) {
 		p.domain = domain
 	}
 }
+
+// WithExpiry stamps the droplets of the provider with the time after which they may be reaped
+func WithExpiry(expiresAt time.Time) func(*Provider) {
+	return func(p *Provider) {
+		p.state.ExpiresAt = expiresAt
+	}
+}
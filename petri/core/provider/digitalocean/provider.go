@@ -40,6 +40,8 @@ type ProviderState struct {
 	PetriTag   string                `json:"petri_tag"`
 	FirewallID string                `json:"firewall_id"`
 	DomainIDs  []int                 `json:"domain_ids"`
+	// ExpiresAt is stamped on droplets with an expiry tag, see ExpiryTag
+	ExpiresAt time.Time `json:"expires_at"`
 }
 
 type Provider struct {
@@ -98,6 +100,12 @@ func NewProviderWithClient(ctx context.Context, providerName string, doClient Do
 		return nil, err
 	}
 
+	if expiresAt := digitalOceanProvider.state.ExpiresAt; !expiresAt.IsZero() {
+		if _, err := digitalOceanProvider.createTag(ctx, ExpiryTag(expiresAt)); err != nil {
+			return nil, fmt.Errorf("failed to create expiry tag: %w", err)
+		}
+	}
+
 	firewall, err := digitalOceanProvider.createFirewall(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create firewall: %w", err)
@@ -192,10 +200,7 @@ func (p *Provider) CreateTask(ctx context.Context, definition provider.TaskDefin
 	}
 
 	_, _, err = task.dockerClient.ImageInspectWithRaw(ctx, definition.Image.Image)
-	var registryAuth string
-	if provider.IsECRImage(definition.Image.Image) {
-		registryAuth = doConfig["docker_auth"]
-	}
+	registryAuth := provider.RegistryAuth(definition.Image.Image, doConfig)
 	if err != nil {
 		p.logger.Info("image not found, pulling", zap.String("image", definition.Image.Image))
 		for retries := 5; retries > 0; retries-- {
@@ -22,6 +22,7 @@ import (
 	"github.com/docker/docker/api/types/image"
 	"github.com/docker/docker/api/types/mount"
 	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/api/types/registry"
 	specs "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -166,6 +167,59 @@ func TestCreateTask_ValidTask(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCreateTask_PullsOCIImageWithRegistryAuth(t *testing.T) {
+	ctx := context.Background()
+	p, _, mockDocker := setupTestProvider(t, ctx)
+
+	const ociImage = "ghcr.io/skip-mev/ironbird:gaia-v1"
+	dockerAuth, err := registry.EncodeAuthConfig(registry.AuthConfig{
+		Username:      "ironbird",
+		Password:      "token",
+		ServerAddress: "ghcr.io",
+	})
+	require.NoError(t, err)
+
+	// the task pulls ociImage instead of the image the shared setup expects
+	expectedCalls := mockDocker.ExpectedCalls[:0]
+	for _, call := range mockDocker.ExpectedCalls {
+		switch call.Method {
+		case "ImageInspectWithRaw", "ImagePull", "ContainerCreate":
+		default:
+			expectedCalls = append(expectedCalls, call)
+		}
+	}
+	mockDocker.ExpectedCalls = expectedCalls
+
+	mockDocker.On("ImageInspectWithRaw", ctx, ociImage).Return(types.ImageInspect{}, []byte{}, fmt.Errorf("image not found"))
+	mockDocker.On("ImagePull", ctx, mock.AnythingOfType("*zap.Logger"), ociImage, image.PullOptions{
+		RegistryAuth: dockerAuth,
+	}).Return(nil).Once()
+	mockDocker.On("ContainerCreate", ctx, mock.MatchedBy(func(config *container.Config) bool {
+		return config.Image == ociImage
+	}), mock.Anything, (*network.NetworkingConfig)(nil), (*specs.Platform)(nil), "petri-test-provider-test-task").
+		Return(container.CreateResponse{ID: "petri-test-provider-test-task"}, nil)
+
+	taskDef := provider.TaskDefinition{
+		Name:       "test-task",
+		Image:      provider.ImageDefinition{Image: ociImage, UID: "1000", GID: "1000"},
+		Entrypoint: []string{"/bin/bash"},
+		DataDir:    "/data",
+		ProviderSpecificConfig: DigitalOceanTaskConfig{
+			"size":                   "s-1vcpu-1gb",
+			"region":                 "nyc1",
+			"image_id":               "123456",
+			provider.RegistryAuthKey: dockerAuth,
+		},
+	}
+
+	task, err := p.CreateTask(ctx, taskDef)
+	require.NoError(t, err)
+	mockDocker.AssertCalled(t, "ImagePull", ctx, mock.AnythingOfType("*zap.Logger"), ociImage,
+		image.PullOptions{RegistryAuth: dockerAuth})
+
+	require.NoError(t, task.Destroy(ctx))
+}
+
 func setupValidationTestProvider(t *testing.T, ctx context.Context) *Provider {
 	logger := zap.NewExample()
 	mockDO := mocks.NewMockDoClient(t)
@@ -622,3 +676,46 @@ func TestProviderSerialization(t *testing.T) {
 		assert.Equal(t, task1.Definition, task2.Definition)
 	}
 }
+
+func TestSetExpiry(t *testing.T) {
+	ctx := context.Background()
+	logger := zap.NewExample()
+	mockDO := mocks.NewMockDoClient(t)
+
+	mockTailscale := TailscaleSettings{
+		Server:      clientmocks.NewMockTailscaleServer(t),
+		LocalClient: clientmocks.NewMockTailscaleLocalClient(t),
+		AuthKey:     "test-auth-key",
+		Tags:        []string{"test-tag"},
+	}
+
+	createdAt := time.Unix(1700000000, 0)
+	extendedAt := createdAt.Add(time.Hour)
+
+	mockDO.On("CreateTag", ctx, &godo.TagCreateRequest{Name: "petri-test-provider"}).Return(&godo.Tag{}, nil).Once()
+	mockDO.On("CreateTag", ctx, &godo.TagCreateRequest{Name: "expires-at:1700000000"}).Return(&godo.Tag{}, nil).Once()
+	mockDO.On("CreateFirewall", ctx, mock.Anything).Return(&godo.Firewall{ID: "test-firewall"}, nil)
+
+	p, err := NewProviderWithClient(ctx, "test-provider", mockDO, mockTailscale, WithLogger(logger), WithExpiry(createdAt))
+	require.NoError(t, err)
+
+	p.state.TaskStates["1"] = &TaskState{ID: "1"}
+	droplets := []godo.Resource{{ID: "1", Type: godo.DropletResourceType}}
+
+	mockDO.On("CreateTag", ctx, &godo.TagCreateRequest{Name: "expires-at:1700003600"}).Return(&godo.Tag{}, nil).Once()
+	mockDO.On("TagResources", ctx, "expires-at:1700003600", droplets).Return(nil).Once()
+	mockDO.On("UntagResources", ctx, "expires-at:1700000000", droplets).Return(nil).Once()
+
+	require.NoError(t, p.SetExpiry(ctx, extendedAt))
+	require.True(t, p.GetState().ExpiresAt.Equal(extendedAt))
+
+	// the expiry is unchanged, so nothing is retagged
+	require.NoError(t, p.SetExpiry(ctx, extendedAt))
+
+	expiresAt, ok := ParseExpiryTag(ExpiryTag(extendedAt))
+	require.True(t, ok)
+	require.True(t, expiresAt.Equal(extendedAt))
+
+	_, ok = ParseExpiryTag("petri-test-provider")
+	require.False(t, ok)
+}
@@ -99,13 +99,8 @@ func (t *Task) getTailscaleIp(ctx context.Context) (string, error) {
 	return "", errors.New("no IPv4 Tailscale address found")
 }
 
-func GenerateTailscaleAuthKey(ctx context.Context, oauthSecret string, tags []string) (string, error) {
-	prefixedTags := make([]string, len(tags))
-
-	for i, tag := range tags {
-		prefixedTags[i] = fmt.Sprintf("tag:%s", tag)
-	}
-
+// NewTailscaleClient creates a Tailscale API client authenticated with an OAuth client secret
+func NewTailscaleClient(ctx context.Context, oauthSecret string) (*tailscale.Client, error) {
 	baseURL := "https://api.tailscale.com"
 
 	credentials := clientcredentials.Config{
@@ -115,13 +110,26 @@ func GenerateTailscaleAuthKey(ctx context.Context, oauthSecret string, tags []st
 
 	baseU, err := url.Parse(baseURL)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	tsClient := &tailscale.Client{
+	return &tailscale.Client{
 		APIKey:  "-",
 		HTTP:    credentials.Client(ctx),
 		BaseURL: baseU,
+	}, nil
+}
+
+func GenerateTailscaleAuthKey(ctx context.Context, oauthSecret string, tags []string) (string, error) {
+	prefixedTags := make([]string, len(tags))
+
+	for i, tag := range tags {
+		prefixedTags[i] = fmt.Sprintf("tag:%s", tag)
+	}
+
+	tsClient, err := NewTailscaleClient(ctx, oauthSecret)
+	if err != nil {
+		return "", err
 	}
 
 	var capabilities tailscale.KeyCapabilities
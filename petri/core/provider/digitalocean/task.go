@@ -12,6 +12,7 @@ import (
 	"golang.org/x/crypto/ssh"
 
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/image"
 	"github.com/docker/docker/api/types/mount"
 	dockerclient "github.com/docker/docker/client"
 	"github.com/docker/docker/pkg/stdcopy"
@@ -109,8 +110,76 @@ func (t *Task) Stop(ctx context.Context) error {
 	return t.dockerClient.ContainerStop(ctx, containers[0].ID, container.StopOptions{})
 }
 
+// Modify recreates the task's container on the droplet from the given definition. The droplet, its data
+// directory and the container name are kept, so the workload comes back with the same state and network
+// identity. The task is left stopped and has to be started again
 func (t *Task) Modify(ctx context.Context, definition provider.TaskDefinition) error {
-	panic("implement me")
+	if err := definition.ValidateBasic(); err != nil {
+		return fmt.Errorf("failed to validate task definition: %w", err)
+	}
+
+	state := t.GetState()
+	t.logger.Info("modifying task", zap.String("task", state.Name), zap.String("image", definition.Image.Image))
+
+	// the name, ports and data directory are fixed when the droplet is created
+	definition.Name = state.Definition.Name
+	definition.Ports = state.Definition.Ports
+	definition.DataDir = state.Definition.DataDir
+
+	containers, err := t.dockerClient.ContainerList(ctx, container.ListOptions{
+		Limit: 1,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to retrieve containers: %w", err)
+	}
+
+	if len(containers) != 1 {
+		return fmt.Errorf("could not find container for %s", state.Name)
+	}
+
+	existing, err := t.dockerClient.ContainerInspect(ctx, containers[0].ID)
+	if err != nil {
+		return fmt.Errorf("failed to inspect container: %w", err)
+	}
+
+	if _, _, err := t.dockerClient.ImageInspectWithRaw(ctx, definition.Image.Image); err != nil {
+		registryAuth := provider.RegistryAuth(definition.Image.Image, definition.ProviderSpecificConfig)
+
+		t.logger.Info("image not found, pulling", zap.String("image", definition.Image.Image))
+		if err := t.dockerClient.ImagePull(ctx, t.logger, definition.Image.Image, image.PullOptions{
+			RegistryAuth: registryAuth,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if existing.State != nil && existing.State.Running {
+		if err := t.dockerClient.ContainerStop(ctx, containers[0].ID, container.StopOptions{}); err != nil {
+			return fmt.Errorf("failed to stop container: %w", err)
+		}
+	}
+
+	if err := t.dockerClient.ContainerRemove(ctx, containers[0].ID, container.RemoveOptions{Force: true}); err != nil {
+		return fmt.Errorf("failed to remove container: %w", err)
+	}
+
+	containerConfig := existing.Config
+	containerConfig.Image = definition.Image.Image
+	containerConfig.Entrypoint = definition.Entrypoint
+	containerConfig.Cmd = definition.Command
+	containerConfig.Env = convertEnvMapToList(definition.Environment)
+
+	if _, err := t.dockerClient.ContainerCreate(ctx, containerConfig, existing.HostConfig, nil, nil, state.Name); err != nil {
+		return fmt.Errorf("failed to recreate container: %w", err)
+	}
+
+	t.stateMu.Lock()
+	defer t.stateMu.Unlock()
+
+	t.state.Definition = definition
+	t.state.Status = provider.TASK_STOPPED
+
+	return nil
 }
 
 func (t *Task) Destroy(ctx context.Context) error {
@@ -14,6 +14,7 @@ import (
 	"github.com/digitalocean/godo"
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/image"
 	"github.com/docker/docker/api/types/mount"
 	"github.com/docker/docker/api/types/network"
 	clientmocks "github.com/skip-mev/ironbird/petri/core/provider/clients/mocks"
@@ -416,6 +417,97 @@ func TestTaskGetIP(t *testing.T) {
 	mockDO.AssertExpectations(t)
 }
 
+func TestTaskModify(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := zap.NewDevelopment()
+
+	mockDocker := clientmocks.NewMockDockerClient(t)
+
+	existingConfig := &container.Config{
+		Image:      "nginx:1.25",
+		Entrypoint: []string{"nginx"},
+		Hostname:   "test-task",
+		Labels: map[string]string{
+			providerLabelName: "test-provider",
+		},
+	}
+	existingHostConfig := &container.HostConfig{
+		Mounts: []mount.Mount{
+			{
+				Type:   mount.TypeBind,
+				Source: "/docker_volumes",
+				Target: "/data",
+			},
+		},
+		NetworkMode: "host",
+	}
+
+	mockDocker.On("ContainerList", ctx, container.ListOptions{
+		Limit: 1,
+	}).Return([]types.Container{testContainer}, nil)
+	mockDocker.On("ContainerInspect", ctx, testContainerID).Return(types.ContainerJSON{
+		ContainerJSONBase: &types.ContainerJSONBase{
+			ID: testContainerID,
+			State: &types.ContainerState{
+				Status:  "running",
+				Running: true,
+			},
+			HostConfig: existingHostConfig,
+		},
+		Config: existingConfig,
+	}, nil)
+	mockDocker.On("ImageInspectWithRaw", ctx, "nginx:1.27").Return(types.ImageInspect{}, []byte{}, fmt.Errorf("image not found"))
+	mockDocker.On("ImagePull", ctx, mock.AnythingOfType("*zap.Logger"), "nginx:1.27", image.PullOptions{}).Return(nil)
+	mockDocker.On("ContainerStop", ctx, testContainerID, container.StopOptions{}).Return(nil)
+	mockDocker.On("ContainerRemove", ctx, testContainerID, container.RemoveOptions{Force: true}).Return(nil)
+	mockDocker.On("ContainerCreate", ctx, mock.MatchedBy(func(config *container.Config) bool {
+		return config.Image == "nginx:1.27" &&
+			config.Labels[providerLabelName] == "test-provider" &&
+			len(config.Env) == 1 && config.Env[0] == "FOO=bar"
+	}), existingHostConfig, (*network.NetworkingConfig)(nil), (*specs.Platform)(nil), "test-task").Return(container.CreateResponse{ID: "new-container-id"}, nil)
+
+	task := &Task{
+		state: &TaskState{
+			ID:           strconv.Itoa(testDroplet.ID),
+			Name:         "test-task",
+			ProviderName: "test-provider",
+			Definition: provider.TaskDefinition{
+				Name: "test-task",
+				Image: provider.ImageDefinition{
+					Image: "nginx:1.25",
+					UID:   "1000",
+					GID:   "1000",
+				},
+				Ports:   []string{"80"},
+				DataDir: "/data",
+			},
+			Status: provider.TASK_RUNNING,
+		},
+		logger:       logger,
+		dockerClient: mockDocker,
+	}
+
+	err := task.Modify(ctx, provider.TaskDefinition{
+		Name: "renamed-task",
+		Image: provider.ImageDefinition{
+			Image: "nginx:1.27",
+			UID:   "1000",
+			GID:   "1000",
+		},
+		Environment: map[string]string{"FOO": "bar"},
+	})
+	require.NoError(t, err)
+
+	state := task.GetState()
+	require.Equal(t, provider.TASK_STOPPED, state.Status)
+	require.Equal(t, "nginx:1.27", state.Definition.Image.Image)
+	require.Equal(t, "test-task", state.Definition.Name)
+	require.Equal(t, []string{"80"}, state.Definition.Ports)
+	require.Equal(t, "/data", state.Definition.DataDir)
+
+	mockDocker.AssertExpectations(t)
+}
+
 func TestTaskDestroy(t *testing.T) {
 	ctx := context.Background()
 	logger, _ := zap.NewDevelopment()
@@ -0,0 +1,74 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/volume"
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/ironbird/petri/core/provider"
+)
+
+// expiryRecordName returns the name of the volume recording the expiry of a provider, a record is created for every
+// expiry since volumes cannot be changed
+func expiryRecordName(state ProviderState) string {
+	return fmt.Sprintf("%s-expiry-%s", state.Name, provider.FormatExpiry(state.ExpiresAt))
+}
+
+// SetExpiry changes the expiry of the provider's resources. Resources created from now on are labeled with it, while
+// existing resources keep their labels and are covered by the expiry record replacing the previous one
+func (p *Provider) SetExpiry(ctx context.Context, expiresAt time.Time) error {
+	state := p.GetState()
+	state.ExpiresAt = expiresAt
+
+	keep := ""
+	if !expiresAt.IsZero() {
+		labels := resourceLabels(state)
+		labels[ExpiryRecordLabelName] = "true"
+
+		// the new record is created before the previous ones are removed, so that the provider is never left without one
+		record, err := p.dockerClient.VolumeCreate(ctx, volume.CreateOptions{
+			Name:   expiryRecordName(state),
+			Labels: labels,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create expiry record: %w", err)
+		}
+		keep = record.Name
+	}
+
+	p.stateMu.Lock()
+	p.state.ExpiresAt = expiresAt
+	p.stateMu.Unlock()
+
+	return p.removeExpiryRecords(ctx, keep)
+}
+
+// removeExpiryRecords removes the expiry records of the provider except for keep
+func (p *Provider) removeExpiryRecords(ctx context.Context, keep string) error {
+	records, err := p.dockerClient.VolumeList(ctx, volume.ListOptions{
+		Filters: filters.NewArgs(
+			filters.Arg("label", ExpiryRecordLabelName),
+			filters.Arg("label", fmt.Sprintf("%s=%s", ProviderLabelName, p.GetName())),
+		),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list expiry records: %w", err)
+	}
+
+	for _, record := range records.Volumes {
+		if record.Name == keep {
+			continue
+		}
+
+		p.logger.Debug("removing expiry record", zap.String("name", record.Name))
+		if err := p.dockerClient.VolumeRemove(ctx, record.Name, true); err != nil {
+			return fmt.Errorf("failed to remove expiry record %s: %w", record.Name, err)
+		}
+	}
+
+	return nil
+}
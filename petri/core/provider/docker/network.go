@@ -7,15 +7,22 @@ import (
 	"net"
 
 	"github.com/docker/docker/api/types/network"
+	"github.com/skip-mev/ironbird/petri/core/provider"
 	"go.uber.org/zap"
 
 	"github.com/docker/go-connections/nat"
 )
 
 const (
-	providerLabelName = "petri-provider"
-	portsLabelName    = "petri-ports"
-	nodeNameLabelName = "petri-node-name"
+	// ProviderLabelName labels every container, network and volume with the name of the provider owning it
+	ProviderLabelName = "petri-provider"
+	// ExpiryLabelName labels resources with the unix time after which they may be reaped, see provider.FormatExpiry
+	ExpiryLabelName = "petri-expires-at"
+	// ExpiryRecordLabelName marks the volume recording the current expiry of a provider. Labels cannot be changed, so
+	// the expiry label of the record replaces the ones every other resource of the provider was created with
+	ExpiryRecordLabelName = "petri-expiry-record"
+	portsLabelName        = "petri-ports"
+	nodeNameLabelName     = "petri-node-name"
 )
 
 func (p *Provider) initNetwork(ctx context.Context) (network.Inspect, error) {
@@ -37,9 +44,7 @@ func (p *Provider) initNetwork(ctx context.Context) (network.Inspect, error) {
 		Internal:   false,
 		Attachable: false,
 		Ingress:    false,
-		Labels: map[string]string{
-			providerLabelName: state.Name,
-		},
+		Labels:     resourceLabels(state),
 		IPAM: &network.IPAM{
 			Driver: "default",
 			Config: []network.IPAMConfig{
@@ -174,3 +179,16 @@ func (p *Provider) GeneratePortBindings(portSet nat.PortSet) (nat.PortMap, error
 
 	return m, nil
 }
+
+// resourceLabels returns the labels of every resource created by the provider
+func resourceLabels(state ProviderState) map[string]string {
+	labels := map[string]string{
+		ProviderLabelName: state.Name,
+	}
+
+	if !state.ExpiresAt.IsZero() {
+		labels[ExpiryLabelName] = provider.FormatExpiry(state.ExpiresAt)
+	}
+
+	return labels
+}
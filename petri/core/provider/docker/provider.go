@@ -7,6 +7,7 @@ import (
 	"net"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/docker/docker/api/types/image"
 	"github.com/skip-mev/ironbird/petri/core/provider"
@@ -32,6 +33,10 @@ type ProviderState struct {
 	NetworkGateway string `json:"network_gateway"`
 
 	BuilderImageName string `json:"builder_image_name"`
+
+	// ExpiresAt is stamped on resources as they are created and held by the provider's expiry record once it changes,
+	// see ExpiryLabelName and ExpiryRecordLabelName
+	ExpiresAt time.Time `json:"expires_at"`
 }
 
 type Provider struct {
@@ -46,7 +51,14 @@ type Provider struct {
 
 var _ provider.ProviderI = (*Provider)(nil)
 
-func CreateProvider(ctx context.Context, logger *zap.Logger, providerName string) (*Provider, error) {
+// WithExpiry stamps every resource of the provider with the time after which it may be reaped
+func WithExpiry(expiresAt time.Time) func(*Provider) {
+	return func(p *Provider) {
+		p.state.ExpiresAt = expiresAt
+	}
+}
+
+func CreateProvider(ctx context.Context, logger *zap.Logger, providerName string, opts ...func(*Provider)) (*Provider, error) {
 	dockerClient, err := clients.NewDockerClient("", nil)
 	if err != nil {
 		return nil, err
@@ -74,6 +86,10 @@ func CreateProvider(ctx context.Context, logger *zap.Logger, providerName string
 		logger:       logger,
 	}
 
+	for _, opt := range opts {
+		opt(dockerProvider)
+	}
+
 	network, err := dockerProvider.initNetwork(ctx)
 	if err != nil {
 		return nil, err
@@ -180,10 +196,7 @@ func (p *Provider) CreateTask(ctx context.Context, definition provider.TaskDefin
 	}
 
 	_, _, err := p.dockerClient.ImageInspectWithRaw(ctx, definition.Image.Image)
-	var registryAuth string
-	if provider.IsECRImage(definition.Image.Image) && definition.ProviderSpecificConfig != nil {
-		registryAuth = definition.ProviderSpecificConfig["docker_auth"]
-	}
+	registryAuth := provider.RegistryAuth(definition.Image.Image, definition.ProviderSpecificConfig)
 	if err != nil {
 		p.logger.Info("image not found, pulling", zap.String("image", definition.Image.Image))
 		if err = p.dockerClient.ImagePull(ctx, p.logger, definition.Image.Image, image.PullOptions{
@@ -245,17 +258,17 @@ func (p *Provider) CreateTask(ctx context.Context, definition provider.TaskDefin
 		return nil, err
 	}
 
+	labels := resourceLabels(state)
+	labels[portsLabelName] = strings.Join(definition.Ports, ",")
+	labels[nodeNameLabelName] = definition.Name
+
 	createdContainer, err := p.dockerClient.ContainerCreate(ctx, &container.Config{
 		Image:      definition.Image.Image,
 		Entrypoint: definition.Entrypoint,
 		Cmd:        definition.Command,
 		Tty:        false,
 		Hostname:   taskState.Name,
-		Labels: map[string]string{
-			providerLabelName: state.Name,
-			portsLabelName:    strings.Join(definition.Ports, ","),
-			nodeNameLabelName: definition.Name,
-		},
+		Labels:     labels,
 		Env:          convertEnvMapToList(definition.Environment),
 		ExposedPorts: portSet,
 	}, &container.HostConfig{
@@ -292,6 +305,7 @@ func (p *Provider) CreateTask(ctx context.Context, definition provider.TaskDefin
 		logger:       p.logger.With(zap.String("task", definition.Name)),
 		dockerClient: p.dockerClient,
 		removeTask:   p.removeTask,
+		replaceTask:  p.replaceTask,
 	}, nil
 }
 
@@ -333,6 +347,7 @@ func (p *Provider) DeserializeTask(ctx context.Context, bz []byte) (provider.Tas
 		logger:       p.logger.With(zap.String("task", taskState.Name)),
 		dockerClient: p.dockerClient,
 		removeTask:   p.removeTask,
+		replaceTask:  p.replaceTask,
 	}
 
 	if err := task.ensureTask(ctx); err != nil {
@@ -351,6 +366,16 @@ func (p *Provider) removeTask(_ context.Context, taskID string) error {
 	return nil
 }
 
+func (p *Provider) replaceTask(_ context.Context, oldID string, state *TaskState) error {
+	p.stateMu.Lock()
+	defer p.stateMu.Unlock()
+
+	delete(p.state.TaskStates, oldID)
+	p.state.TaskStates[state.Id] = state
+
+	return nil
+}
+
 func (p *Provider) Teardown(ctx context.Context) error {
 	p.logger.Info("tearing down Docker provider")
 
@@ -372,7 +397,7 @@ func (p *Provider) Teardown(ctx context.Context) error {
 		return err
 	}
 
-	return nil
+	return p.removeExpiryRecords(ctx, "")
 }
 
 func (p *Provider) GetState() ProviderState {
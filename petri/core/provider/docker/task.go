@@ -9,7 +9,9 @@ import (
 	"time"
 
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/image"
 	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/network"
 	"github.com/docker/docker/pkg/stdcopy"
 	"github.com/docker/go-connections/nat"
 	"github.com/skip-mev/ironbird/petri/core/provider"
@@ -34,12 +36,16 @@ type VolumeState struct {
 	Size string `json:"size"`
 }
 
+// replaceTaskFunc is a callback used to re-register a task with its provider after its container was recreated
+type replaceTaskFunc func(ctx context.Context, oldID string, state *TaskState) error
+
 type Task struct {
 	state        *TaskState
 	stateMu      sync.Mutex
 	logger       *zap.Logger
 	dockerClient clients.DockerClient
 	removeTask   provider.RemoveTaskFunc
+	replaceTask  replaceTaskFunc
 }
 
 var _ provider.TaskI = (*Task)(nil)
@@ -181,8 +187,78 @@ func (t *Task) GetStatus(ctx context.Context) (provider.TaskStatus, error) {
 	return provider.TASK_STATUS_UNDEFINED, nil
 }
 
+// Modify recreates the task's container from the given definition. The data volume, IP address and port
+// bindings of the task are kept, so the workload comes back with the same state and network identity.
+// The task is left stopped and has to be started again
 func (t *Task) Modify(ctx context.Context, td provider.TaskDefinition) error {
-	panic("unimplemented")
+	if err := td.ValidateBasic(); err != nil {
+		return fmt.Errorf("failed to validate task definition: %w", err)
+	}
+
+	state := t.GetState()
+	t.logger.Info("modifying task", zap.String("id", state.Id), zap.String("image", td.Image.Image))
+
+	// the name, ports and data directory are fixed when the task is created
+	td.Name = state.Definition.Name
+	td.Ports = state.Definition.Ports
+	td.DataDir = state.Definition.DataDir
+
+	existing, err := t.dockerClient.ContainerInspect(ctx, state.Id)
+	if err != nil {
+		return fmt.Errorf("failed to inspect container: %w", err)
+	}
+
+	if _, _, err := t.dockerClient.ImageInspectWithRaw(ctx, td.Image.Image); err != nil {
+		registryAuth := provider.RegistryAuth(td.Image.Image, td.ProviderSpecificConfig)
+
+		t.logger.Info("image not found, pulling", zap.String("image", td.Image.Image))
+		if err := t.dockerClient.ImagePull(ctx, t.logger, td.Image.Image, image.PullOptions{
+			RegistryAuth: registryAuth,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if existing.State != nil && existing.State.Running {
+		if err := t.dockerClient.ContainerStop(ctx, state.Id, container.StopOptions{}); err != nil {
+			return fmt.Errorf("failed to stop container: %w", err)
+		}
+	}
+
+	if err := t.dockerClient.ContainerRemove(ctx, state.Id, container.RemoveOptions{Force: true}); err != nil {
+		return fmt.Errorf("failed to remove container: %w", err)
+	}
+
+	containerConfig := existing.Config
+	containerConfig.Image = td.Image.Image
+	containerConfig.Entrypoint = td.Entrypoint
+	containerConfig.Cmd = td.Command
+	containerConfig.Env = convertEnvMapToList(td.Environment)
+
+	createdContainer, err := t.dockerClient.ContainerCreate(ctx, containerConfig, existing.HostConfig, &network.NetworkingConfig{
+		EndpointsConfig: map[string]*network.EndpointSettings{
+			state.NetworkName: {
+				IPAMConfig: &network.EndpointIPAMConfig{
+					IPv4Address: state.IpAddress,
+				},
+			},
+		},
+	}, nil, state.Name)
+	if err != nil {
+		return fmt.Errorf("failed to recreate container: %w", err)
+	}
+
+	t.stateMu.Lock()
+	t.state.Id = createdContainer.ID
+	t.state.Definition = td
+	t.state.Status = provider.TASK_STOPPED
+	t.stateMu.Unlock()
+
+	if t.replaceTask != nil {
+		return t.replaceTask(ctx, state.Id, t.state)
+	}
+
+	return nil
 }
 
 func (t *Task) RunCommand(ctx context.Context, cmd []string) (string, string, int, error) {
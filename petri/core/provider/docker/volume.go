@@ -36,10 +36,8 @@ func (p *Provider) CreateVolume(ctx context.Context, definition provider.VolumeD
 	}
 
 	createdVolume, err := p.dockerClient.VolumeCreate(ctx, volume.CreateOptions{
-		Name: definition.Name,
-		Labels: map[string]string{
-			providerLabelName: p.GetState().Name,
-		},
+		Name:   definition.Name,
+		Labels: resourceLabels(p.GetState()),
 	})
 	if err != nil {
 		return "", err
@@ -510,9 +508,7 @@ func (p *Provider) SetVolumeOwner(ctx context.Context, volumeName, uid, gid stri
 				uid,
 				gid,
 			},
-			Labels: map[string]string{
-				providerLabelName: p.GetState().Name,
-			},
+			Labels: resourceLabels(p.GetState()),
 			// Use root user to avoid permission issues when reading files from the volume.
 			User: "0",
 		},
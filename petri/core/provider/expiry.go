@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// FormatExpiry formats the expiry resources are stamped with as unix seconds, which is valid in both DigitalOcean
+// tags and docker labels
+func FormatExpiry(expiresAt time.Time) string {
+	return strconv.FormatInt(expiresAt.Unix(), 10)
+}
+
+// ParseExpiry parses an expiry formatted by FormatExpiry
+func ParseExpiry(expiry string) (time.Time, error) {
+	unix, err := strconv.ParseInt(expiry, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid expiry %q: %w", expiry, err)
+	}
+	return time.Unix(unix, 0), nil
+}
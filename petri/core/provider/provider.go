@@ -4,6 +4,7 @@ import (
 	"context"
 	"net"
 	"strings"
+	"time"
 )
 
 // TaskStatus defines the status of a task's underlying workload
@@ -49,6 +50,8 @@ type ProviderI interface {
 	DeserializeTask(context.Context, []byte) (TaskI, error)
 
 	Teardown(context.Context) error
+	// SetExpiry stamps the resources of the provider with the time after which they may be reaped
+	SetExpiry(context.Context, time.Time) error
 
 	SerializeProvider(context.Context) ([]byte, error)
 	GetType() string
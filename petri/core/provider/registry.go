@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"strings"
+
+	"github.com/docker/docker/api/types/registry"
+)
+
+// RegistryAuthKey is the provider specific config key holding the encoded credentials images are pulled with
+const RegistryAuthKey = "docker_auth"
+
+// RegistryAuth returns the encoded registry credentials of a task's provider specific config if image is hosted on
+// the registry they were issued for. Images of other registries are pulled anonymously, since their registry would
+// reject the credentials. Credentials that don't name their registry are only used for ECR images
+func RegistryAuth(image string, providerSpecificConfig map[string]string) string {
+	auth := providerSpecificConfig[RegistryAuthKey]
+	if auth == "" {
+		return ""
+	}
+
+	authConfig, err := registry.DecodeAuthConfig(auth)
+	if err != nil || authConfig.ServerAddress == "" {
+		if IsECRImage(image) {
+			return auth
+		}
+		return ""
+	}
+
+	if imageRegistryHost(image) != registryHost(authConfig.ServerAddress) {
+		return ""
+	}
+
+	return auth
+}
+
+// imageRegistryHost returns the host of the registry an image reference points at, images without one are hosted on
+// Docker Hub
+func imageRegistryHost(image string) string {
+	host, _, found := strings.Cut(image, "/")
+	if !found || (!strings.ContainsAny(host, ".:") && host != "localhost") {
+		return "docker.io"
+	}
+	return host
+}
+
+// registryHost returns the host of a registry server address, which may be a URL
+func registryHost(serverAddress string) string {
+	if _, rest, found := strings.Cut(serverAddress, "://"); found {
+		serverAddress = rest
+	}
+	host, _, _ := strings.Cut(serverAddress, "/")
+	return host
+}
@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/docker/docker/api/types/registry"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistryAuth(t *testing.T) {
+	ghcrAuth, err := registry.EncodeAuthConfig(registry.AuthConfig{Username: "user", Password: "token", ServerAddress: "ghcr.io"})
+	require.NoError(t, err)
+	unnamedAuth, err := registry.EncodeAuthConfig(registry.AuthConfig{Username: "AWS", Password: "token"})
+	require.NoError(t, err)
+
+	config := map[string]string{RegistryAuthKey: ghcrAuth}
+	require.Equal(t, ghcrAuth, RegistryAuth("ghcr.io/skip-mev/ironbird:gaia-v1", config))
+	require.Empty(t, RegistryAuth("harbor.example.com/ironbird/gaia:v1", config))
+	require.Empty(t, RegistryAuth("ubuntu:latest", config))
+	require.Empty(t, RegistryAuth("ghcr.io/skip-mev/ironbird:gaia-v1", nil))
+
+	urlAuth, err := registry.EncodeAuthConfig(registry.AuthConfig{Username: "user", ServerAddress: "https://harbor.example.com:8443/"})
+	require.NoError(t, err)
+	require.Equal(t, urlAuth, RegistryAuth("harbor.example.com:8443/ironbird/gaia:v1", map[string]string{RegistryAuthKey: urlAuth}))
+
+	config = map[string]string{RegistryAuthKey: unnamedAuth}
+	require.Equal(t, unnamedAuth, RegistryAuth("123.dkr.ecr.us-east-1.amazonaws.com/ironbird:v1", config))
+	require.Empty(t, RegistryAuth("ghcr.io/skip-mev/ironbird:gaia-v1", config))
+}
@@ -37,6 +37,10 @@ type RegionConfig struct {
 	Name          string `json:"name"`
 	NumValidators int    `json:"num_validators"`
 	NumNodes      int    `json:"num_nodes"`
+	// Image optionally overrides the chain image for every validator and node of the region
+	Image string `json:"image,omitempty"`
+	// ConfigOverride is applied on top of the chain's custom configs on every validator and node of the region
+	ConfigOverride `yaml:",inline"`
 }
 
 func (r RegionConfig) ValidateBasic() error {
@@ -57,6 +61,114 @@ func (r RegionConfig) ValidateBasic() error {
 	return nil
 }
 
+// NodeRole is the role of a node in the network
+type NodeRole string
+
+const (
+	ValidatorRole NodeRole = "validator"
+	FullNodeRole  NodeRole = "node"
+)
+
+// ConfigOverride holds app.toml, config.toml and client.toml modifications that are applied on top of the
+// chain's CustomAppConfig, CustomConsensusConfig and CustomClientConfig for a subset of the nodes
+type ConfigOverride struct {
+	CustomAppConfig       map[string]interface{} `json:"custom_app_config,omitempty" yaml:"custom_app_config,omitempty"`
+	CustomConsensusConfig map[string]interface{} `json:"custom_consensus_config,omitempty" yaml:"custom_consensus_config,omitempty"`
+	CustomClientConfig    map[string]interface{} `json:"custom_client_config,omitempty" yaml:"custom_client_config,omitempty"`
+}
+
+// IsEmpty returns true if the override doesn't modify any config
+func (o ConfigOverride) IsEmpty() bool {
+	return len(o.CustomAppConfig) == 0 && len(o.CustomConsensusConfig) == 0 && len(o.CustomClientConfig) == 0
+}
+
+// merge returns a copy of the override with other deep-merged on top of it
+func (o ConfigOverride) merge(other ConfigOverride) ConfigOverride {
+	return ConfigOverride{
+		CustomAppConfig:       mergeConfigs(o.CustomAppConfig, other.CustomAppConfig),
+		CustomConsensusConfig: mergeConfigs(o.CustomConsensusConfig, other.CustomConsensusConfig),
+		CustomClientConfig:    mergeConfigs(o.CustomClientConfig, other.CustomClientConfig),
+	}
+}
+
+// mergeConfigs deep-merges override on top of base, nested tables are merged instead of replaced
+func mergeConfigs(base, override map[string]interface{}) map[string]interface{} {
+	if len(base) == 0 && len(override) == 0 {
+		return nil
+	}
+
+	merged := make(map[string]interface{}, len(base)+len(override))
+	for key, value := range base {
+		merged[key] = value
+	}
+
+	for key, value := range override {
+		baseTable, baseIsTable := merged[key].(map[string]interface{})
+		overrideTable, overrideIsTable := value.(map[string]interface{})
+		if baseIsTable && overrideIsTable {
+			merged[key] = mergeConfigs(baseTable, overrideTable)
+			continue
+		}
+		merged[key] = value
+	}
+
+	return merged
+}
+
+// NodeConfigOverride applies a ConfigOverride to every node with the given role or, if Index is set, only to
+// the validator or node with that index
+type NodeConfigOverride struct {
+	Role           NodeRole `json:"role" yaml:"role"`
+	Index          *int     `json:"index,omitempty" yaml:"index,omitempty"`
+	ConfigOverride `yaml:",inline"`
+}
+
+func (o NodeConfigOverride) ValidateBasic(numValidators, numNodes int) error {
+	count := 0
+	switch o.Role {
+	case ValidatorRole:
+		count = numValidators
+	case FullNodeRole:
+		count = numNodes
+	default:
+		return fmt.Errorf("role must be either %s or %s", ValidatorRole, FullNodeRole)
+	}
+
+	if o.Index != nil && (*o.Index < 0 || *o.Index >= count) {
+		return fmt.Errorf("%s index %d is out of range, the chain has %d", o.Role, *o.Index, count)
+	}
+
+	if o.IsEmpty() {
+		return fmt.Errorf("config override cannot be empty")
+	}
+
+	return nil
+}
+
+// ImageGroup runs a subset of the validators and nodes of a chain on a different image than the chain image,
+// e.g. to test consensus compatibility of two versions during a rolling upgrade
+type ImageGroup struct {
+	Image         string `json:"image"`
+	NumValidators int    `json:"num_validators"`
+	NumNodes      int    `json:"num_nodes"`
+}
+
+func (g ImageGroup) ValidateBasic() error {
+	if g.Image == "" {
+		return fmt.Errorf("image cannot be empty")
+	}
+
+	if g.NumValidators < 0 {
+		return fmt.Errorf("num validators cannot be negative")
+	}
+
+	if g.NumNodes < 0 {
+		return fmt.Errorf("num nodes cannot be negative")
+	}
+
+	return nil
+}
+
 // ChainI is an interface for a logical chain
 type ChainI interface {
 	Init(context.Context, ChainOptions) error
@@ -87,6 +199,13 @@ type ChainOptions struct {
 	WalletConfig       WalletConfig // WalletConfig is the default configuration of a chain's wallet
 	AdditionalAccounts int
 	BaseMnemonic       string
+
+	// CustomGenesis is a genesis (e.g. an exported mainnet state) the chain is started from instead of a genesis
+	// generated from gentxs. Our validators take over the bonded validators with the most tokens
+	CustomGenesis []byte
+	// ValidatorKeys are optional priv_validator_key.json files that are assigned to the validators in order when
+	// starting from a CustomGenesis
+	ValidatorKeys [][]byte
 }
 
 func (o ChainOptions) ValidateBasic() error {
@@ -118,6 +237,10 @@ type ChainConfig struct {
 
 	Image provider.ImageDefinition // Image is the Docker ImageDefinition of the chain
 
+	// ImageGroups are assigned to validators and nodes in order, e.g. a group with 2 validators runs validators
+	// 0 and 1 and the next group starts at validator 2. Validators and nodes outside of a group run Image
+	ImageGroups []ImageGroup
+
 	GasPrices string // GasPrices are the minimum gas prices to set on the chain
 
 	Bech32Prefix string // Bech32Prefix is the Bech32 prefix of the on-chain addresses
@@ -127,6 +250,10 @@ type ChainConfig struct {
 	CoinType string // CoinType is the coin type of the chain (e.g. 118)
 	ChainId  string // ChainId is the chain ID of the chain
 
+	// SigningAlgorithm is the key algorithm of the chain's wallets (Secp256k1 or EthSecp256k1). Wallets of evm
+	// chains default to EthSecp256k1 and wallets of other chains to Secp256k1
+	SigningAlgorithm string
+
 	UseGenesisSubCommand bool     // UseGenesisSubCommand is a flag that indicates whether to use the 'genesis' subcommand to initialize the chain. Set to true if Cosmos SDK >v0.50
 	AdditionalStartFlags []string // AdditionalStartFlags are additional flags to pass to the chain binary when starting the chain
 
@@ -137,17 +264,34 @@ type ChainConfig struct {
 	GenesisDelegation *big.Int
 	// number of tokens to allocate to the genesis account. This value defaults to 5_000_000 if not set.
 	GenesisBalance *big.Int
+	// StakeDistribution optionally skews the self-delegations of the validators, every validator self-delegates
+	// GenesisDelegation if not set
+	StakeDistribution *StakeDistribution
 
 	IsEVMChain            bool                   // IsEVMChain is used to set evm specific configs during chain creation
 	CustomAppConfig       map[string]interface{} // CustomAppConfig is the configuration for the chain's app.toml
 	CustomClientConfig    map[string]interface{} // CustomClientConfig is the configuration for the chain's client.toml
 	CustomConsensusConfig map[string]interface{} // CustomConsensusConfig is the configuration for the chain's config.toml
 
+	// ConfigOverrides are applied on top of the custom configs for nodes of a role or a single node,
+	// see NodeCustomConfigs for the precedence order
+	ConfigOverrides []NodeConfigOverride
+
 	// SetPersistentPeers is used to determine whether nodes and validators of the network are added as persistent
 	// peers to the consensus config
 	SetPersistentPeers bool
 	// SetPersistentPeers is used to determine whether a seed node is added to the consensus config
 	SetSeedNode bool
+	// Topology optionally determines the persistent peers of every node instead of SetPersistentPeers
+	Topology *Topology
+
+	// RemoteSigner optionally signs the votes of every validator in separate signer tasks instead of the validator's
+	// local consensus key
+	RemoteSigner *RemoteSigner
+
+	// Instrumented is set for chains running -cover or -race binaries, their nodes keep coverage data and race
+	// reports in their home directory so that they can be collected before the chain is torn down
+	Instrumented bool
 }
 
 func (c ChainConfig) GetGenesisBalance() *big.Int {
@@ -164,6 +308,60 @@ func (c ChainConfig) GetGenesisDelegation() *big.Int {
 	return c.GenesisDelegation
 }
 
+// ValidatorImage returns the image of the validator with the given index
+func (c ChainConfig) ValidatorImage(index int) string {
+	return c.groupImage(index, func(g ImageGroup) int { return g.NumValidators })
+}
+
+// NodeImage returns the image of the (non-validator) node with the given index
+func (c ChainConfig) NodeImage(index int) string {
+	return c.groupImage(index, func(g ImageGroup) int { return g.NumNodes })
+}
+
+func (c ChainConfig) groupImage(index int, groupSize func(ImageGroup) int) string {
+	start := 0
+	for _, group := range c.ImageGroups {
+		size := groupSize(group)
+		if index >= start && index < start+size {
+			return group.Image
+		}
+		start += size
+	}
+
+	return c.Image.Image
+}
+
+// NodeCustomConfigs resolves the custom configs of a single node. Overrides are deep-merged in the following order,
+// later ones taking precedence: the chain's custom configs, the overrides of the node's role, the override of the
+// node's region and the overrides of the node's index within its role
+func (c ChainConfig) NodeCustomConfigs(role NodeRole, region string, index int) ConfigOverride {
+	resolved := ConfigOverride{
+		CustomAppConfig:       c.CustomAppConfig,
+		CustomConsensusConfig: c.CustomConsensusConfig,
+		CustomClientConfig:    c.CustomClientConfig,
+	}
+
+	for _, override := range c.ConfigOverrides {
+		if override.Role == role && override.Index == nil {
+			resolved = resolved.merge(override.ConfigOverride)
+		}
+	}
+
+	for _, r := range c.RegionConfig {
+		if region != "" && r.Name == region {
+			resolved = resolved.merge(r.ConfigOverride)
+		}
+	}
+
+	for _, override := range c.ConfigOverrides {
+		if override.Role == role && override.Index != nil && *override.Index == index {
+			resolved = resolved.merge(override.ConfigOverride)
+		}
+	}
+
+	return resolved
+}
+
 func (c ChainConfig) ValidateBasic(providerType string) error {
 	if c.Name == "" {
 		return fmt.Errorf("name cannot be empty")
@@ -173,10 +371,6 @@ func (c ChainConfig) ValidateBasic(providerType string) error {
 		return fmt.Errorf("denom cannot be empty")
 	}
 
-	if c.Decimals == 0 {
-		return fmt.Errorf("decimals cannot be 0")
-	}
-
 	if providerType == DigitalOcean {
 		if len(c.RegionConfig) == 0 {
 			return fmt.Errorf("regional distribution cannot be empty")
@@ -215,6 +409,52 @@ func (c ChainConfig) ValidateBasic(providerType string) error {
 		return fmt.Errorf("image definition is invalid: %w", err)
 	}
 
+	numValidators, numNodes := c.NumValidators, c.NumNodes
+	if providerType == DigitalOcean {
+		numValidators, numNodes = 0, 0
+		for _, region := range c.RegionConfig {
+			numValidators += region.NumValidators
+			numNodes += region.NumNodes
+		}
+	}
+
+	groupValidators, groupNodes := 0, 0
+	for i, group := range c.ImageGroups {
+		if err := group.ValidateBasic(); err != nil {
+			return fmt.Errorf("image group %d is invalid: %w", i, err)
+		}
+		groupValidators += group.NumValidators
+		groupNodes += group.NumNodes
+	}
+
+	if groupValidators > numValidators || groupNodes > numNodes {
+		return fmt.Errorf("image groups contain more validators or nodes than the chain")
+	}
+
+	if c.Topology != nil {
+		if err := c.Topology.ValidateBasic(numValidators, numNodes); err != nil {
+			return fmt.Errorf("topology is invalid: %w", err)
+		}
+	}
+
+	if c.StakeDistribution != nil {
+		if err := c.StakeDistribution.ValidateBasic(numValidators); err != nil {
+			return fmt.Errorf("stake distribution is invalid: %w", err)
+		}
+	}
+
+	if c.RemoteSigner != nil {
+		if err := c.RemoteSigner.ValidateBasic(); err != nil {
+			return fmt.Errorf("remote signer is invalid: %w", err)
+		}
+	}
+
+	for i, override := range c.ConfigOverrides {
+		if err := override.ValidateBasic(numValidators, numNodes); err != nil {
+			return fmt.Errorf("config override %d is invalid: %w", i, err)
+		}
+	}
+
 	if c.Bech32Prefix == "" {
 		return fmt.Errorf("bech32 prefix cannot be empty")
 	}
@@ -0,0 +1,19 @@
+package types
+
+import "path"
+
+const (
+	// CoverageDir is the directory in the home of an instrumented node its GOCOVERDIR points to
+	CoverageDir = "coverage"
+	// RaceReportDir is the directory in the home of an instrumented node the race detector writes its reports to
+	RaceReportDir = "race"
+)
+
+// InstrumentationEnvironment returns the environment that makes -cover and -race binaries write their coverage data
+// and race reports into the node's home directory, where they outlive the node's process
+func InstrumentationEnvironment(homeDir string) map[string]string {
+	return map[string]string{
+		"GOCOVERDIR": path.Join(homeDir, CoverageDir),
+		"GORACE":     "log_path=" + path.Join(homeDir, RaceReportDir, "report"),
+	}
+}
@@ -21,8 +21,10 @@ type NodeOptions struct {
 
 // NodeConfig is the configuration structure for a logical node.
 type NodeConfig struct {
-	Name  string // Name is the name of the node
-	Index int    // Index denotes which node this is in the Validators/Nodes array
+	Name   string   // Name is the name of the node
+	Index  int      // Index denotes which node this is in the Validators/Nodes array
+	Role   NodeRole // Role denotes whether the node is a validator or a full node
+	Region string   // Region is the region the node runs in for DigitalOcean deployments
 
 	ChainConfig ChainConfig // ChainConfig is the config of the chain this node is running on
 }
@@ -72,6 +74,14 @@ type NodeI interface {
 	GenesisFileContent(context.Context) ([]byte, error)
 	// OverwriteGenesisFile overwrites the genesis file on the node with the given contents
 	OverwriteGenesisFile(context.Context, []byte) error
+	// ExportGenesis exports the node's application state at the given height as a genesis file
+	ExportGenesis(context.Context, uint64) ([]byte, error)
+	// MigrateGenesis runs a binary subcommand against the given genesis and returns the migrated genesis
+	MigrateGenesis(context.Context, []byte, []string) ([]byte, error)
+	// ResetState removes the node's blockchain data while keeping its configuration and keys
+	ResetState(context.Context) error
+	// ModifyTomlConfigFile applies modifications to a TOML config file (e.g. config/app.toml) of the node
+	ModifyTomlConfigFile(context.Context, string, map[string]interface{}) error
 
 	// SetupValidator performs validator initialization operations
 	SetupValidator(context.Context, WalletConfig, []sdk.Coin, sdk.Coin) (WalletI, string, error)
@@ -0,0 +1,64 @@
+package types
+
+import "fmt"
+
+const (
+	// DefaultRemoteSignerImage is the Horcrux image used when a remote signer doesn't specify one
+	DefaultRemoteSignerImage = "ghcr.io/strangelove-ventures/horcrux:v3.3.1"
+
+	// PrivValidatorPort is the port validators with a remote signer listen on for signer connections
+	PrivValidatorPort = "1234"
+	// CosignerPort is the port threshold cosigners communicate with each other on
+	CosignerPort = "2222"
+)
+
+// RemoteSigner moves the consensus key of every validator out of the validator's priv_validator_key.json and into
+// separate signer tasks the validator connects to through priv_validator_laddr. With more than one share, the key is
+// threshold-split across Shares Horcrux cosigners, Threshold of which have to take part in every signature
+type RemoteSigner struct {
+	Image string `json:"image,omitempty" yaml:"image,omitempty"`
+	// Shares is the number of signer tasks per validator, defaults to 1 (a single signer holding the full key)
+	Shares    int `json:"shares,omitempty" yaml:"shares,omitempty"`
+	Threshold int `json:"threshold,omitempty" yaml:"threshold,omitempty"`
+}
+
+func (s RemoteSigner) ValidateBasic() error {
+	if s.Shares < 0 {
+		return fmt.Errorf("shares cannot be negative")
+	}
+
+	if !s.IsThreshold() {
+		if s.Threshold > 1 {
+			return fmt.Errorf("threshold %d requires more than one share", s.Threshold)
+		}
+		return nil
+	}
+
+	// a threshold below a majority of the shares would let two disjoint sets of cosigners sign conflicting votes
+	if s.Threshold <= s.Shares/2 || s.Threshold > s.Shares {
+		return fmt.Errorf("threshold must be between %d and %d for %d shares", s.Shares/2+1, s.Shares, s.Shares)
+	}
+
+	return nil
+}
+
+// GetImage returns the signer image, defaulting to DefaultRemoteSignerImage
+func (s RemoteSigner) GetImage() string {
+	if s.Image == "" {
+		return DefaultRemoteSignerImage
+	}
+	return s.Image
+}
+
+// GetShares returns the number of signer tasks per validator
+func (s RemoteSigner) GetShares() int {
+	if s.Shares == 0 {
+		return 1
+	}
+	return s.Shares
+}
+
+// IsThreshold returns whether the validator keys are split across multiple cosigners
+func (s RemoteSigner) IsThreshold() bool {
+	return s.GetShares() > 1
+}
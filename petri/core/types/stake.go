@@ -0,0 +1,149 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// StakeDistributionType is the profile used to distribute the genesis stake among the validators of a chain
+type StakeDistributionType string
+
+const (
+	// UniformStake gives every validator the genesis delegation of the chain
+	UniformStake StakeDistributionType = "uniform"
+	// ExplicitStake gives every validator the amount set for it in Amounts
+	ExplicitStake StakeDistributionType = "explicit"
+	// PowerLawStake gives the validator with index i a stake proportional to 1/(i+1)^Exponent
+	PowerLawStake StakeDistributionType = "power-law"
+	// ZipfStake is a power-law distribution with an exponent of 1
+	ZipfStake StakeDistributionType = "zipf"
+	// TopNStake splits TopShare percent of the stake evenly among the first TopN validators and the rest of the
+	// stake evenly among the remaining validators
+	TopNStake StakeDistributionType = "top-n"
+)
+
+// StakeDistribution configures the self-delegations of the genesis validators. Except for explicit amounts, the
+// total stake of the chain is the same as with a uniform distribution (the genesis delegation times the number
+// of validators), only its distribution changes
+type StakeDistribution struct {
+	Type StakeDistributionType `json:"type" yaml:"type"`
+	// Amounts are the unscaled self-delegations of the validators for explicit distributions, in validator order
+	Amounts  []uint64 `json:"amounts,omitempty" yaml:"amounts,omitempty"`
+	Exponent float64  `json:"exponent,omitempty" yaml:"exponent,omitempty"`
+	TopN     int      `json:"top_n,omitempty" yaml:"top_n,omitempty"`
+	// TopShare is the percentage of the stake held by the first TopN validators
+	TopShare float64 `json:"top_share,omitempty" yaml:"top_share,omitempty"`
+}
+
+func (d StakeDistribution) ValidateBasic(numValidators int) error {
+	switch d.Type {
+	case UniformStake, ZipfStake:
+	case ExplicitStake:
+		if len(d.Amounts) != numValidators {
+			return fmt.Errorf("explicit stake distribution has %d amounts but the chain has %d validators", len(d.Amounts), numValidators)
+		}
+
+		for i, amount := range d.Amounts {
+			if amount == 0 {
+				return fmt.Errorf("stake of validator %d cannot be 0", i)
+			}
+		}
+	case PowerLawStake:
+		if d.Exponent <= 0 {
+			return fmt.Errorf("power-law exponent must be positive")
+		}
+	case TopNStake:
+		if d.TopN <= 0 || d.TopN >= numValidators {
+			return fmt.Errorf("top n must be between 1 and %d", numValidators-1)
+		}
+
+		if d.TopShare <= 0 || d.TopShare >= 100 {
+			return fmt.Errorf("top share must be a percentage between 0 and 100")
+		}
+	default:
+		return fmt.Errorf("unknown stake distribution type: %s", d.Type)
+	}
+
+	return nil
+}
+
+// weights returns the relative stake of each validator
+func (d StakeDistribution) weights(numValidators int) []float64 {
+	weights := make([]float64, numValidators)
+
+	for i := range weights {
+		switch d.Type {
+		case PowerLawStake:
+			weights[i] = 1 / math.Pow(float64(i+1), d.Exponent)
+		case ZipfStake:
+			weights[i] = 1 / float64(i+1)
+		case TopNStake:
+			if i < d.TopN {
+				weights[i] = d.TopShare / float64(d.TopN)
+			} else {
+				weights[i] = (100 - d.TopShare) / float64(numValidators-d.TopN)
+			}
+		default:
+			weights[i] = 1
+		}
+	}
+
+	return weights
+}
+
+// ValidatorDelegations returns the unscaled self-delegation of each of the chain's validators
+func (c ChainConfig) ValidatorDelegations(numValidators int) []*big.Int {
+	delegations := make([]*big.Int, numValidators)
+
+	if c.StakeDistribution == nil || c.StakeDistribution.Type == UniformStake {
+		for i := range delegations {
+			delegations[i] = new(big.Int).Set(c.GetGenesisDelegation())
+		}
+		return delegations
+	}
+
+	if c.StakeDistribution.Type == ExplicitStake {
+		for i := range delegations {
+			delegations[i] = new(big.Int).SetUint64(c.StakeDistribution.Amounts[i])
+		}
+		return delegations
+	}
+
+	weights := c.StakeDistribution.weights(numValidators)
+	totalWeight := 0.0
+	for _, w := range weights {
+		totalWeight += w
+	}
+
+	totalStake := new(big.Int).Mul(c.GetGenesisDelegation(), big.NewInt(int64(numValidators)))
+	distributed := new(big.Int)
+
+	for i, w := range weights {
+		delegations[i], _ = new(big.Float).Mul(new(big.Float).SetInt(totalStake), big.NewFloat(w/totalWeight)).Int(nil)
+
+		// every validator needs a non-zero self-delegation to be part of the genesis validator set
+		if delegations[i].Sign() == 0 {
+			delegations[i].SetInt64(1)
+		}
+
+		distributed.Add(distributed, delegations[i])
+	}
+
+	// rounding leftovers go to the first validator, so the total stake matches a uniform distribution
+	if remainder := new(big.Int).Sub(totalStake, distributed); remainder.Sign() > 0 {
+		delegations[0].Add(delegations[0], remainder)
+	}
+
+	return delegations
+}
+
+// ValidatorGenesisBalance returns the unscaled genesis balance of a validator with the given self-delegation. The
+// genesis balance is topped up for validators whose self-delegation exceeds it, so they can still pay fees
+func (c ChainConfig) ValidatorGenesisBalance(delegation *big.Int) *big.Int {
+	if delegation.Cmp(c.GetGenesisBalance()) < 0 {
+		return c.GetGenesisBalance()
+	}
+
+	return new(big.Int).Add(delegation, c.GetGenesisBalance())
+}
@@ -0,0 +1,214 @@
+package types
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+)
+
+// TopologyType is the shape of the peer graph of a chain
+type TopologyType string
+
+const (
+	// SentryTopology hides every validator behind its own sentries: validators only peer with their sentries and
+	// have pex disabled, sentries peer with each other and the remaining full nodes peer with the sentries
+	SentryTopology TopologyType = "sentry"
+	// RingTopology connects every node to its two neighbours, validators first and then full nodes
+	RingTopology TopologyType = "ring"
+	// RandomRegularTopology connects every node to Degree random other nodes
+	RandomRegularTopology TopologyType = "k-regular"
+	// ExplicitTopology uses the adjacency lists in Peers
+	ExplicitTopology TopologyType = "explicit"
+)
+
+// Topology configures the persistent peers of every validator and node of a chain instead of peering every node
+// with every other node. Nodes are referenced by their TopologyNodeName, e.g. validator-0 or node-2
+type Topology struct {
+	Type TopologyType `json:"type" yaml:"type"`
+	// SentriesPerValidator is the number of full nodes assigned as sentries to every validator, defaults to 1
+	SentriesPerValidator int `json:"sentries_per_validator,omitempty" yaml:"sentries_per_validator,omitempty"`
+	// Degree is the number of peers of every node in a k-regular topology
+	Degree int `json:"degree,omitempty" yaml:"degree,omitempty"`
+	// Seed makes the random k-regular topology reproducible
+	Seed int64 `json:"seed,omitempty" yaml:"seed,omitempty"`
+	// Peers are the persistent peers of every node in an explicit topology
+	Peers map[string][]string `json:"peers,omitempty" yaml:"peers,omitempty"`
+}
+
+// PeerAssignment is the p2p configuration of a single node in a topology, peers are referenced by their
+// TopologyNodeName
+type PeerAssignment struct {
+	Persistent []string
+	// Private peers are not gossiped to other peers
+	Private []string
+	// Unconditional peers are connected to even if the node exceeds its inbound or outbound peer limit
+	Unconditional []string
+	DisablePex    bool
+}
+
+// TopologyNodeName returns the name a node is referenced by in a topology
+func TopologyNodeName(role NodeRole, index int) string {
+	return fmt.Sprintf("%s-%d", role, index)
+}
+
+func (t Topology) ValidateBasic(numValidators, numNodes int) error {
+	_, err := t.Assign(numValidators, numNodes)
+	return err
+}
+
+// Assign computes the peers of every validator and node of a chain, keyed by TopologyNodeName
+func (t Topology) Assign(numValidators, numNodes int) (map[string]PeerAssignment, error) {
+	names := make([]string, 0, numValidators+numNodes)
+	for i := 0; i < numValidators; i++ {
+		names = append(names, TopologyNodeName(ValidatorRole, i))
+	}
+	for i := 0; i < numNodes; i++ {
+		names = append(names, TopologyNodeName(FullNodeRole, i))
+	}
+
+	switch t.Type {
+	case SentryTopology:
+		return t.assignSentries(numValidators, numNodes)
+	case RingTopology:
+		return assignRing(names), nil
+	case RandomRegularTopology:
+		return t.assignRandomRegular(names)
+	case ExplicitTopology:
+		return t.assignExplicit(names)
+	default:
+		return nil, fmt.Errorf("unknown topology type: %s", t.Type)
+	}
+}
+
+func (t Topology) assignSentries(numValidators, numNodes int) (map[string]PeerAssignment, error) {
+	sentriesPerValidator := t.SentriesPerValidator
+	if sentriesPerValidator == 0 {
+		sentriesPerValidator = 1
+	}
+
+	if sentriesPerValidator < 0 {
+		return nil, fmt.Errorf("sentries per validator cannot be negative")
+	}
+
+	numSentries := numValidators * sentriesPerValidator
+	if numNodes < numSentries {
+		return nil, fmt.Errorf("sentry topology requires %d full nodes but the chain has %d", numSentries, numNodes)
+	}
+
+	sentries := make([]string, 0, numSentries)
+	for i := 0; i < numSentries; i++ {
+		sentries = append(sentries, TopologyNodeName(FullNodeRole, i))
+	}
+
+	assignments := make(map[string]PeerAssignment, numValidators+numNodes)
+	for v := 0; v < numValidators; v++ {
+		validator := TopologyNodeName(ValidatorRole, v)
+		ownSentries := sentries[v*sentriesPerValidator : (v+1)*sentriesPerValidator]
+
+		assignments[validator] = PeerAssignment{
+			Persistent:    ownSentries,
+			Unconditional: ownSentries,
+			DisablePex:    true,
+		}
+
+		for _, sentry := range ownSentries {
+			assignments[sentry] = PeerAssignment{
+				Persistent:    append(without(sentries, sentry), validator),
+				Private:       []string{validator},
+				Unconditional: []string{validator},
+			}
+		}
+	}
+
+	for i := numSentries; i < numNodes; i++ {
+		assignments[TopologyNodeName(FullNodeRole, i)] = PeerAssignment{Persistent: sentries}
+	}
+
+	return assignments, nil
+}
+
+func assignRing(names []string) map[string]PeerAssignment {
+	assignments := make(map[string]PeerAssignment, len(names))
+
+	for i, name := range names {
+		next, prev := names[(i+1)%len(names)], names[(i+len(names)-1)%len(names)]
+
+		var peers []string
+		switch {
+		case len(names) == 2:
+			peers = []string{next}
+		case len(names) > 2:
+			peers = []string{next, prev}
+		}
+
+		assignments[name] = PeerAssignment{Persistent: peers}
+	}
+
+	return assignments
+}
+
+// assignRandomRegular builds a circulant graph over a random permutation of the nodes, in which every node is
+// connected to its Degree/2 nearest neighbours on both sides and, for odd degrees, to the opposite node
+func (t Topology) assignRandomRegular(names []string) (map[string]PeerAssignment, error) {
+	n := len(names)
+
+	if t.Degree <= 0 || t.Degree >= n {
+		return nil, fmt.Errorf("degree must be between 1 and %d", n-1)
+	}
+
+	if (t.Degree*n)%2 != 0 {
+		return nil, fmt.Errorf("a %d-regular graph requires an even number of nodes", t.Degree)
+	}
+
+	order := slices.Clone(names)
+	rand.New(rand.NewSource(t.Seed)).Shuffle(n, func(i, j int) {
+		order[i], order[j] = order[j], order[i]
+	})
+
+	assignments := make(map[string]PeerAssignment, n)
+	for i, name := range order {
+		var peers []string
+		for offset := 1; offset <= t.Degree/2; offset++ {
+			peers = append(peers, order[(i+offset)%n], order[(i-offset+n)%n])
+		}
+
+		if t.Degree%2 == 1 {
+			peers = append(peers, order[(i+n/2)%n])
+		}
+
+		assignments[name] = PeerAssignment{Persistent: peers}
+	}
+
+	return assignments, nil
+}
+
+func (t Topology) assignExplicit(names []string) (map[string]PeerAssignment, error) {
+	assignments := make(map[string]PeerAssignment, len(names))
+	for _, name := range names {
+		assignments[name] = PeerAssignment{}
+	}
+
+	for name, peers := range t.Peers {
+		if _, ok := assignments[name]; !ok {
+			return nil, fmt.Errorf("topology references unknown node %s", name)
+		}
+
+		for _, peer := range peers {
+			if _, ok := assignments[peer]; !ok {
+				return nil, fmt.Errorf("topology references unknown peer %s of node %s", peer, name)
+			}
+
+			if peer == name {
+				return nil, fmt.Errorf("node %s cannot peer with itself", name)
+			}
+		}
+
+		assignments[name] = PeerAssignment{Persistent: peers}
+	}
+
+	return assignments, nil
+}
+
+func without(names []string, name string) []string {
+	return slices.DeleteFunc(slices.Clone(names), func(n string) bool { return n == name })
+}
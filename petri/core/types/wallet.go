@@ -5,6 +5,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 )
 
+const (
+	Secp256k1    = "secp256k1"     // Secp256k1 is the signing algorithm of cosmos wallets
+	EthSecp256k1 = "eth_secp256k1" // EthSecp256k1 is the signing algorithm of evm wallets
+)
+
 // WalletConfig is a configuration for a Cosmos SDK type wallet
 type WalletConfig struct {
 	DerivationFn     hd.DeriveFn     // DerivationFn is the function to derive a seed from a mnemonic
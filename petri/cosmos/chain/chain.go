@@ -7,6 +7,8 @@ import (
 	"math"
 	"math/big"
 	"math/rand"
+	"path"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -34,6 +36,7 @@ type PackagedState struct {
 	State
 	ValidatorStates  [][]byte
 	NodeStates       [][]byte
+	SignerStates     [][][]byte
 	ValidatorWallets []string
 	FaucetWallet     string
 }
@@ -55,6 +58,9 @@ type Chain struct {
 
 	ValidatorWallets []petritypes.WalletI
 
+	// Signers are the remote signer tasks of every validator, in validator order
+	Signers [][]provider.TaskI
+
 	mu sync.RWMutex
 
 	// useExternalAddresses determines whether to use external addresses (DigitalOcean)
@@ -94,6 +100,8 @@ func CreateChain(ctx context.Context, logger *zap.Logger, infraProvider provider
 		validators, nodes, err = createLocalNodes(ctx, logger, &chain, infraProvider, config, opts)
 	}
 	if err != nil {
+		// the nodes that were created are destroyed, so that creating the chain can be retried without leaking them
+		destroyTasks(ctx, logger, slices.Concat(validators, nodes))
 		return nil, err
 	}
 
@@ -103,9 +111,38 @@ func CreateChain(ctx context.Context, logger *zap.Logger, infraProvider provider
 	chain.Validators = validators
 	chain.ValidatorWallets = make([]petritypes.WalletI, len(validators))
 
+	if config.RemoteSigner != nil {
+		chain.Signers, err = createRemoteSigners(ctx, infraProvider, config, validators)
+		if err != nil {
+			destroyTasks(ctx, logger, slices.Concat(validators, nodes))
+			destroyTasks(ctx, logger, slices.Concat(chain.Signers...))
+			return nil, err
+		}
+	}
+
 	return &chain, nil
 }
 
+// destroyTask is the part of a task that destroyTasks needs, it's implemented by both nodes and tasks
+type destroyTask interface {
+	Destroy(context.Context) error
+	GetDefinition() provider.TaskDefinition
+}
+
+// destroyTasks destroys the given tasks of a chain that could not be created, tasks that were never created are
+// skipped. Failures are only logged, the provider's teardown destroys the tasks that remain
+func destroyTasks[T destroyTask](ctx context.Context, logger *zap.Logger, tasks []T) {
+	for _, task := range tasks {
+		if any(task) == nil {
+			continue
+		}
+		if err := task.Destroy(ctx); err != nil {
+			logger.Error("failed to destroy task of chain that could not be created",
+				zap.String("task", task.GetDefinition().Name), zap.Error(err))
+		}
+	}
+}
+
 func createRegionalNodes(ctx context.Context, logger *zap.Logger, chain *Chain, infraProvider provider.ProviderI,
 	config petritypes.ChainConfig, opts petritypes.ChainOptions,
 ) ([]petritypes.NodeI, []petritypes.NodeI, error) {
@@ -124,11 +161,18 @@ func createRegionalNodes(ctx context.Context, logger *zap.Logger, chain *Chain,
 			currentRegion := region.Name
 			validatorName := fmt.Sprintf("%s-validator-%d-%s", config.Name, currentValidatorIndex, currentRegion)
 			eg.Go(func() error {
+				image := config.ValidatorImage(currentValidatorIndex)
+				if region.Image != "" {
+					image = region.Image
+				}
+
 				validator, err := opts.NodeCreator(ctx, logger, infraProvider, petritypes.NodeConfig{
 					Index:       currentValidatorIndex,
+					Role:        petritypes.ValidatorRole,
+					Region:      region.Name,
 					Name:        validatorName,
 					ChainConfig: config,
-				}, createRegionalNodeOptions(opts.NodeOptions, region))
+				}, withNodeImage(createRegionalNodeOptions(opts.NodeOptions, region), config, image))
 				if err != nil {
 					return err
 				}
@@ -146,11 +190,18 @@ func createRegionalNodes(ctx context.Context, logger *zap.Logger, chain *Chain,
 			currentRegion := region.Name
 			nodeName := fmt.Sprintf("%s-node-%d-%s", config.Name, currentNodeIndex, currentRegion)
 			eg.Go(func() error {
+				image := config.NodeImage(currentNodeIndex)
+				if region.Image != "" {
+					image = region.Image
+				}
+
 				node, err := opts.NodeCreator(ctx, logger, infraProvider, petritypes.NodeConfig{
 					Index:       currentNodeIndex,
+					Role:        petritypes.FullNodeRole,
+					Region:      region.Name,
 					Name:        nodeName,
 					ChainConfig: config,
-				}, createRegionalNodeOptions(opts.NodeOptions, region))
+				}, withNodeImage(createRegionalNodeOptions(opts.NodeOptions, region), config, image))
 				if err != nil {
 					return err
 				}
@@ -166,7 +217,7 @@ func createRegionalNodes(ctx context.Context, logger *zap.Logger, chain *Chain,
 
 	if err := eg.Wait(); err != nil {
 		logger.Error("error creating regional nodes", zap.Error(err))
-		return nil, nil, err
+		return validators, nodes, err
 	}
 
 	return validators, nodes, nil
@@ -185,9 +236,10 @@ func createLocalNodes(ctx context.Context, logger *zap.Logger, chain *Chain, inf
 		eg.Go(func() error {
 			validator, err := opts.NodeCreator(ctx, logger, infraProvider, petritypes.NodeConfig{
 				Index:       currentValidatorIndex,
+				Role:        petritypes.ValidatorRole,
 				Name:        validatorName,
 				ChainConfig: config,
-			}, opts.NodeOptions)
+			}, withNodeImage(opts.NodeOptions, config, config.ValidatorImage(currentValidatorIndex)))
 			if err != nil {
 				return err
 			}
@@ -205,9 +257,10 @@ func createLocalNodes(ctx context.Context, logger *zap.Logger, chain *Chain, inf
 		eg.Go(func() error {
 			node, err := opts.NodeCreator(ctx, logger, infraProvider, petritypes.NodeConfig{
 				Index:       currentNodeIndex,
+				Role:        petritypes.FullNodeRole,
 				Name:        nodeName,
 				ChainConfig: config,
-			}, opts.NodeOptions)
+			}, withNodeImage(opts.NodeOptions, config, config.NodeImage(currentNodeIndex)))
 			if err != nil {
 				return err
 			}
@@ -221,7 +274,7 @@ func createLocalNodes(ctx context.Context, logger *zap.Logger, chain *Chain, inf
 
 	if err := eg.Wait(); err != nil {
 		logger.Error("error creating local nodes", zap.Error(err))
-		return nil, nil, err
+		return validators, nodes, err
 	}
 
 	return validators, nodes, nil
@@ -254,6 +307,25 @@ func createRegionalNodeOptions(baseOpts petritypes.NodeOptions, region petritype
 	return baseOpts
 }
 
+// withNodeImage overrides the image of a node if it differs from the chain image, e.g. because the node is part
+// of an image group
+func withNodeImage(baseOpts petritypes.NodeOptions, config petritypes.ChainConfig, image string) petritypes.NodeOptions {
+	if image == "" || image == config.Image.Image {
+		return baseOpts
+	}
+
+	originalModifier := baseOpts.NodeDefinitionModifier
+	baseOpts.NodeDefinitionModifier = func(definition provider.TaskDefinition, nodeConfig petritypes.NodeConfig) provider.TaskDefinition {
+		if originalModifier != nil {
+			definition = originalModifier(definition, nodeConfig)
+		}
+		definition.Image.Image = image
+		return definition
+	}
+
+	return baseOpts
+}
+
 // RestoreChain restores a Chain object from a serialized state
 func RestoreChain(ctx context.Context, logger *zap.Logger, infraProvider provider.ProviderI, state []byte,
 	nodeRestore petritypes.NodeRestorer, walletConfig petritypes.WalletConfig,
@@ -265,10 +337,11 @@ func RestoreChain(ctx context.Context, logger *zap.Logger, infraProvider provide
 	}
 
 	chain := Chain{
-		State:      packagedState.State,
-		logger:     logger,
-		Validators: make([]petritypes.NodeI, len(packagedState.ValidatorStates)),
-		Nodes:      make([]petritypes.NodeI, len(packagedState.NodeStates)),
+		State:                packagedState.State,
+		logger:               logger,
+		Validators:           make([]petritypes.NodeI, len(packagedState.ValidatorStates)),
+		Nodes:                make([]petritypes.NodeI, len(packagedState.NodeStates)),
+		useExternalAddresses: infraProvider.GetType() == petritypes.DigitalOcean,
 	}
 
 	eg := new(errgroup.Group)
@@ -303,8 +376,23 @@ func RestoreChain(ctx context.Context, logger *zap.Logger, infraProvider provide
 		return nil, err
 	}
 
+	chain.Signers = make([][]provider.TaskI, len(packagedState.SignerStates))
+	for i, signerStates := range packagedState.SignerStates {
+		chain.Signers[i] = make([]provider.TaskI, len(signerStates))
+		for j, ss := range signerStates {
+			signer, err := infraProvider.DeserializeTask(ctx, ss)
+			if err != nil {
+				return nil, fmt.Errorf("failed to restore signer: %w", err)
+			}
+			chain.Signers[i][j] = signer
+		}
+	}
+
 	chain.ValidatorWallets = make([]petritypes.WalletI, len(packagedState.ValidatorWallets))
 	for i, mnemonic := range packagedState.ValidatorWallets {
+		if mnemonic == "" {
+			continue
+		}
 		w, err := wallet.NewWallet(petritypes.ValidatorKeyName, mnemonic, "", walletConfig)
 		if err != nil {
 			return nil, fmt.Errorf("failed to restore validator wallet: %w", err)
@@ -345,93 +433,129 @@ func (c *Chain) Height(ctx context.Context) (uint64, error) {
 // Init initializes the chain. That consists of generating the genesis transactions, genesis file, wallets,
 // the distribution of configuration files and starting the network nodes up
 func (c *Chain) Init(ctx context.Context, opts petritypes.ChainOptions) error {
-	if err := opts.ValidateBasic(); err != nil {
-		return fmt.Errorf("failed to validate chain options: %w", err)
+	if err := c.InitNodes(ctx, opts); err != nil {
+		return err
 	}
 
+	genbz, err := c.GenerateGenesis(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Configure(ctx, genbz); err != nil {
+		return err
+	}
+
+	return c.Start(ctx)
+}
+
+// genesisStakes returns the genesis balance of the faucet and the genesis balance and self-delegation of every
+// validator
+func (c *Chain) genesisStakes() ([]types.Coin, []validatorStake) {
 	decimalPow := int64(math.Pow10(int(c.GetConfig().Decimals)))
 
 	genesisCoin := types.Coin{
 		Amount: sdkmath.NewIntFromBigInt(c.GetConfig().GetGenesisBalance()).MulRaw(decimalPow),
 		Denom:  c.GetConfig().Denom,
 	}
-	c.logger.Info("creating genesis accounts", zap.String("coin", genesisCoin.String()))
 
-	genesisSelfDelegation := types.Coin{
-		Amount: sdkmath.NewIntFromBigInt(c.GetConfig().GetGenesisDelegation()).MulRaw(decimalPow),
-		Denom:  c.GetConfig().Denom,
+	validatorStakes := make([]validatorStake, len(c.Validators))
+	for i, delegation := range c.GetConfig().ValidatorDelegations(len(c.Validators)) {
+		validatorStakes[i] = validatorStake{
+			genesisAmounts: []types.Coin{{
+				Amount: sdkmath.NewIntFromBigInt(c.GetConfig().ValidatorGenesisBalance(delegation)).MulRaw(decimalPow),
+				Denom:  c.GetConfig().Denom,
+			}},
+			selfDelegation: types.Coin{
+				Amount: sdkmath.NewIntFromBigInt(delegation).MulRaw(decimalPow),
+				Denom:  c.GetConfig().Denom,
+			},
+		}
 	}
-	c.logger.Info("creating genesis self-delegations", zap.String("coin", genesisSelfDelegation.String()))
-
-	genesisAmounts := []types.Coin{genesisCoin}
-
-	eg := new(errgroup.Group)
-
-	for idx, v := range c.Validators {
-		v := v
-		idx := idx
-		eg.Go(func() error {
-			c.logger.Info("setting up validator", zap.String("validator", v.GetDefinition().Name))
 
-			validatorWallet, validatorAddress, err := v.SetupValidator(ctx, opts.WalletConfig, genesisAmounts, genesisSelfDelegation)
-			if err != nil {
-				return fmt.Errorf("error in validator setup: %v", err)
-			}
+	return []types.Coin{genesisCoin}, validatorStakes
+}
 
-			c.ValidatorWallets[idx] = validatorWallet
+// InitNodes initializes the home directories of the chain's nodes and creates the validator wallets. Nodes that
+// were initialized before have to be reset with ResetNodes first
+func (c *Chain) InitNodes(ctx context.Context, opts petritypes.ChainOptions) error {
+	if err := opts.ValidateBasic(); err != nil {
+		return fmt.Errorf("failed to validate chain options: %w", err)
+	}
 
-			c.logger.Info("validator setup finished", zap.String("validator", v.GetDefinition().Name), zap.String("address", validatorAddress))
+	if len(opts.CustomGenesis) != 0 {
+		return c.initCustomGenesisNodes(ctx, opts)
+	}
 
-			return nil
-		})
+	_, validatorStakes := c.genesisStakes()
+	for i, stake := range validatorStakes {
+		c.logger.Info("creating genesis self-delegation", zap.Int("validator", i),
+			zap.String("coin", stake.selfDelegation.String()))
 	}
 
-	for _, n := range c.Nodes {
-		n := n
+	return c.initNodes(ctx, opts, validatorStakes)
+}
 
-		eg.Go(func() error {
-			c.logger.Info("setting up node", zap.String("node", n.GetDefinition().Name))
+// ResetNodes removes everything InitNodes wrote to the home directories of the chain's nodes, so that the nodes can
+// be initialized again after InitNodes failed part way
+func (c *Chain) ResetNodes(ctx context.Context) error {
+	homeDir := c.GetConfig().HomeDir
+	script := fmt.Sprintf("rm -rf %s %s %s", path.Join(homeDir, "config"), path.Join(homeDir, "data"),
+		path.Join(homeDir, "keyring-test"))
 
-			if err := n.SetupNode(ctx); err != nil {
-				return err
+	eg := new(errgroup.Group)
+	for _, n := range append(slices.Clone(c.Validators), c.Nodes...) {
+		eg.Go(func() error {
+			c.logger.Info("resetting node", zap.String("node", n.GetDefinition().Name))
+			_, stderr, exitCode, err := n.RunCommand(ctx, []string{"/bin/sh", "-c", script})
+			if err != nil {
+				return fmt.Errorf("failed to reset %s: %w", n.GetDefinition().Name, err)
+			}
+			if exitCode != 0 {
+				return fmt.Errorf("failed to reset %s (exit code %d): %s", n.GetDefinition().Name, exitCode, stderr)
 			}
-			c.logger.Info("node setup finished", zap.String("node", n.GetDefinition().Name))
-
 			return nil
 		})
 	}
 
-	if err := eg.Wait(); err != nil {
-		return err
-	}
-
-	c.logger.Info("adding faucet genesis")
-	faucetWallet, err := c.BuildWallet(ctx, petritypes.FaucetAccountKeyName, "", opts.WalletConfig)
-	if err != nil {
-		return err
-	}
+	return eg.Wait()
+}
 
-	c.FaucetWallet = faucetWallet
+// GenerateGenesis generates the genesis of the chain from its initialized nodes and applies the genesis
+// modifications of the options. It can be run again if it failed part way
+func (c *Chain) GenerateGenesis(ctx context.Context, opts petritypes.ChainOptions) ([]byte, error) {
+	genesisAmounts, validatorStakes := c.genesisStakes()
+	c.logger.Info("creating genesis accounts", zap.String("coin", formatAmounts(genesisAmounts)))
 
-	firstValidator := c.Validators[0]
+	var (
+		genbz []byte
+		err   error
+	)
 
-	if err := c.executeGenesisOperations(ctx, opts.WalletConfig, firstValidator, faucetWallet, genesisAmounts, additionalAccountOpts{baseMnemonic: opts.BaseMnemonic, numAdditionalAccounts: opts.AdditionalAccounts}); err != nil {
-		return err
+	if len(opts.CustomGenesis) != 0 {
+		genbz, err = c.buildCustomGenesis(ctx, opts, genesisAmounts)
+	} else {
+		genbz, err = c.generateGenesis(ctx, opts, genesisAmounts, validatorStakes)
 	}
-
-	genbz, err := firstValidator.GenesisFileContent(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if opts.ModifyGenesis != nil {
 		c.logger.Info("modifying genesis")
 		genbz, err = opts.ModifyGenesis(genbz)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
+	return genbz, nil
+}
+
+// Configure distributes the genesis and the configuration files of the chain to its nodes
+func (c *Chain) Configure(ctx context.Context, genbz []byte) error {
+	eg := new(errgroup.Group)
+
 	var (
 		chainConfig     = c.GetConfig()
 		persistentPeers PeerSet
@@ -455,11 +579,16 @@ func (c *Chain) Init(ctx context.Context, opts petritypes.ChainOptions) error {
 		persistentPeers = NewPeerSet(append(c.Nodes, c.Validators...))
 	}
 
+	peers, err := c.assignPeers(persistentPeers, seeds)
+	if err != nil {
+		return err
+	}
+
 	for i := range c.Validators {
 		v := c.Validators[i]
 		eg.Go(func() error {
 			c.logger.Info("overwriting genesis for validator", zap.String("validator", v.GetDefinition().Name))
-			return configureNode(ctx, v, chainConfig, genbz, persistentPeers, seeds, c.useExternalAddresses, c.logger)
+			return configureNode(ctx, v, chainConfig, genbz, peers[v.GetDefinition().Name], c.useExternalAddresses, c.logger)
 		})
 	}
 
@@ -467,7 +596,7 @@ func (c *Chain) Init(ctx context.Context, opts petritypes.ChainOptions) error {
 		n := c.Nodes[i]
 		eg.Go(func() error {
 			c.logger.Info("overwriting node genesis", zap.String("node", n.GetDefinition().Name))
-			return configureNode(ctx, n, chainConfig, genbz, persistentPeers, seeds, c.useExternalAddresses, c.logger)
+			return configureNode(ctx, n, chainConfig, genbz, peers[n.GetDefinition().Name], c.useExternalAddresses, c.logger)
 		})
 	}
 
@@ -475,6 +604,12 @@ func (c *Chain) Init(ctx context.Context, opts petritypes.ChainOptions) error {
 		return err
 	}
 
+	if chainConfig.RemoteSigner != nil {
+		if err := c.configureRemoteSigners(ctx); err != nil {
+			return fmt.Errorf("failed to configure remote signers: %w", err)
+		}
+	}
+
 	if chainConfig.SetSeedNode && seedNode != nil {
 		c.logger.Info("configuring seed node mode", zap.String("seed_node", seedNode.GetDefinition().Name))
 		if err := seedNode.SetSeedMode(ctx); err != nil {
@@ -482,33 +617,82 @@ func (c *Chain) Init(ctx context.Context, opts petritypes.ChainOptions) error {
 		}
 	}
 
-	for i := range c.Validators {
-		v := c.Validators[i]
+	return nil
+}
+
+// formatAmounts formats coins as a comma separated list of amounts for the CLI, e.g. 100stake,50foo
+func formatAmounts(coins []types.Coin) string {
+	amounts := make([]string, 0, len(coins))
+	for _, coin := range coins {
+		amounts = append(amounts, fmt.Sprintf("%s%s", coin.Amount.String(), coin.Denom))
+	}
+	return strings.Join(amounts, ",")
+}
+
+// validatorStake is the genesis balance and self-delegation of a single validator
+type validatorStake struct {
+	genesisAmounts []types.Coin
+	selfDelegation types.Coin
+}
+
+// initNodes sets up the validators and nodes of the chain, which creates the validators' gentxs
+func (c *Chain) initNodes(ctx context.Context, opts petritypes.ChainOptions, validatorStakes []validatorStake) error {
+	eg := new(errgroup.Group)
+
+	for idx, v := range c.Validators {
+		v := v
+		idx := idx
 		eg.Go(func() error {
-			c.logger.Info("starting validator task", zap.String("validator", v.GetDefinition().Name))
-			if err := v.Start(ctx); err != nil {
-				return err
+			c.logger.Info("setting up validator", zap.String("validator", v.GetDefinition().Name))
+
+			validatorWallet, validatorAddress, err := v.SetupValidator(ctx, opts.WalletConfig, validatorStakes[idx].genesisAmounts, validatorStakes[idx].selfDelegation)
+			if err != nil {
+				return fmt.Errorf("error in validator setup: %v", err)
 			}
+
+			c.ValidatorWallets[idx] = validatorWallet
+
+			c.logger.Info("validator setup finished", zap.String("validator", v.GetDefinition().Name), zap.String("address", validatorAddress))
+
 			return nil
 		})
 	}
 
-	for i := range c.Nodes {
-		n := c.Nodes[i]
+	for _, n := range c.Nodes {
+		n := n
+
 		eg.Go(func() error {
-			c.logger.Info("starting node task", zap.String("node", n.GetDefinition().Name))
-			if err := n.Start(ctx); err != nil {
+			c.logger.Info("setting up node", zap.String("node", n.GetDefinition().Name))
+
+			if err := n.SetupNode(ctx); err != nil {
 				return err
 			}
+			c.logger.Info("node setup finished", zap.String("node", n.GetDefinition().Name))
+
 			return nil
 		})
 	}
 
-	if err := eg.Wait(); err != nil {
-		return err
+	return eg.Wait()
+}
+
+// generateGenesis generates a fresh genesis from the gentxs of the initialized validators
+func (c *Chain) generateGenesis(ctx context.Context, opts petritypes.ChainOptions, genesisAmounts []types.Coin, validatorStakes []validatorStake) ([]byte, error) {
+	c.logger.Info("adding faucet genesis")
+	faucetWallet, err := c.BuildWallet(ctx, petritypes.FaucetAccountKeyName, "", opts.WalletConfig)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	c.FaucetWallet = faucetWallet
+
+	firstValidator := c.Validators[0]
+
+	if err := c.executeGenesisOperations(ctx, opts.WalletConfig, firstValidator, faucetWallet, genesisAmounts, validatorStakes, additionalAccountOpts{baseMnemonic: opts.BaseMnemonic, numAdditionalAccounts: opts.AdditionalAccounts}); err != nil {
+		return nil, err
+	}
+
+	return firstValidator.GenesisFileContent(ctx)
 }
 
 // Teardown destroys all resources related to a chain and its' nodes
@@ -527,6 +711,14 @@ func (c *Chain) Teardown(ctx context.Context) error {
 		}
 	}
 
+	for _, signers := range c.Signers {
+		for _, signer := range signers {
+			if err := signer.Destroy(ctx); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -662,8 +854,25 @@ func (c *Chain) Serialize(ctx context.Context, p provider.ProviderI) ([]byte, er
 		state.NodeStates = append(state.NodeStates, ns)
 	}
 
+	for _, signers := range c.Signers {
+		signerStates := make([][]byte, 0, len(signers))
+		for _, signer := range signers {
+			ss, err := p.SerializeTask(ctx, signer)
+			if err != nil {
+				return nil, err
+			}
+			signerStates = append(signerStates, ss)
+		}
+		state.SignerStates = append(state.SignerStates, signerStates)
+	}
+
+	// validator wallets are created when the nodes are initialized, chains are serialized before that too
 	for _, w := range c.ValidatorWallets {
-		state.ValidatorWallets = append(state.ValidatorWallets, w.Mnemonic())
+		var mnemonic string
+		if w != nil {
+			mnemonic = w.Mnemonic()
+		}
+		state.ValidatorWallets = append(state.ValidatorWallets, mnemonic)
 	}
 
 	if c.FaucetWallet != nil {
@@ -697,18 +906,20 @@ type Balance struct {
 // 1. Add the faucet account to the genesis file
 // 2. Add the validator accounts to the genesis file
 // 3. Collect the gentxs from the validators and create the genesis file
-func (c *Chain) executeGenesisOperations(ctx context.Context, walletCfg petritypes.WalletConfig, firstValidator petritypes.NodeI, faucetWallet petritypes.WalletI, genesisAmounts []types.Coin, accountOpts additionalAccountOpts) error {
+func (c *Chain) executeGenesisOperations(ctx context.Context, walletCfg petritypes.WalletConfig, firstValidator petritypes.NodeI, faucetWallet petritypes.WalletI, genesisAmounts []types.Coin, validatorStakes []validatorStake, accountOpts additionalAccountOpts) error {
 	c.logger.Info("executing genesis operations", zap.String("validator", firstValidator.GetDefinition().Name))
 
 	var scriptBuilder strings.Builder
 	scriptBuilder.WriteString("#!/bin/sh\nset -e\n")
 	useGenesisSubCommand := c.GetConfig().UseGenesisSubCommand
 
-	var faucetAmounts []string
-	for _, coin := range genesisAmounts {
-		faucetAmounts = append(faucetAmounts, fmt.Sprintf("%s%s", coin.Amount.String(), coin.Denom))
-	}
-	faucetAmount := strings.Join(faucetAmounts, ",")
+	// the genesis of the first validator is backed up before it's modified, so that the operations can be run again
+	// if they failed part way
+	genesisFile := path.Join(c.GetConfig().HomeDir, "config", "genesis.json")
+	scriptBuilder.WriteString(fmt.Sprintf("if [ -f %[1]s.init ]; then cp %[1]s.init %[1]s; else cp %[1]s %[1]s.init; fi\n",
+		genesisFile))
+
+	faucetAmount := formatAmounts(genesisAmounts)
 
 	firstValidatorNode := firstValidator.(*node.Node)
 
@@ -731,7 +942,7 @@ func (c *Chain) executeGenesisOperations(ctx context.Context, walletCfg petrityp
 		if useGenesisSubCommand {
 			addValidatorCmd = append(addValidatorCmd, "genesis")
 		}
-		addValidatorCmd = append(addValidatorCmd, "add-genesis-account", validatorAddress, faucetAmount, "--keyring-backend", "test")
+		addValidatorCmd = append(addValidatorCmd, "add-genesis-account", validatorAddress, formatAmounts(validatorStakes[i].genesisAmounts), "--keyring-backend", "test")
 		validatorCommand := firstValidatorNode.BinCommand(addValidatorCmd...)
 		scriptBuilder.WriteString(fmt.Sprintf("%s\n", strings.Join(validatorCommand, " ")))
 
@@ -968,13 +1179,70 @@ func buildBalances(accounts []Account, funds types.Coins) []Balance {
 	return balances
 }
 
+// nodePeers are the peers a single node is configured with
+type nodePeers struct {
+	persistent    PeerSet
+	seeds         PeerSet
+	private       PeerSet
+	unconditional PeerSet
+	disablePex    bool
+}
+
+// assignPeers returns the peers of every node keyed by node name. Without a topology every node is configured
+// with the given persistent peers and seeds
+func (c *Chain) assignPeers(persistentPeers, seeds PeerSet) (map[string]nodePeers, error) {
+	peers := make(map[string]nodePeers, len(c.Validators)+len(c.Nodes))
+	topology := c.GetConfig().Topology
+
+	if topology == nil {
+		for _, n := range append(append([]petritypes.NodeI{}, c.Validators...), c.Nodes...) {
+			peers[n.GetDefinition().Name] = nodePeers{persistent: persistentPeers, seeds: seeds}
+		}
+		return peers, nil
+	}
+
+	assignments, err := topology.Assign(len(c.Validators), len(c.Nodes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to assign peers: %w", err)
+	}
+
+	topologyNodes := make(map[string]petritypes.NodeI, len(c.Validators)+len(c.Nodes))
+	for i, v := range c.Validators {
+		topologyNodes[petritypes.TopologyNodeName(petritypes.ValidatorRole, i)] = v
+	}
+	for i, n := range c.Nodes {
+		topologyNodes[petritypes.TopologyNodeName(petritypes.FullNodeRole, i)] = n
+	}
+
+	for name, n := range topologyNodes {
+		assignment := assignments[name]
+		c.logger.Info("assigning topology peers", zap.String("node", n.GetDefinition().Name),
+			zap.Strings("persistent_peers", assignment.Persistent))
+
+		assigned := nodePeers{
+			persistent:    NewTopologyPeerSet(assignment.Persistent, topologyNodes),
+			private:       NewTopologyPeerSet(assignment.Private, topologyNodes),
+			unconditional: NewTopologyPeerSet(assignment.Unconditional, topologyNodes),
+			disablePex:    assignment.DisablePex,
+		}
+
+		// nodes without pex only ever connect to their persistent peers
+		if !assignment.DisablePex {
+			assigned.seeds = seeds
+		}
+
+		peers[n.GetDefinition().Name] = assigned
+	}
+
+	return peers, nil
+}
+
 func configureNode(
 	ctx context.Context,
 	node petritypes.NodeI,
 	chainConfig petritypes.ChainConfig,
 	genbz []byte,
-	persistentPeers PeerSet,
-	seeds PeerSet,
+	peers nodePeers,
 	useExternalAddress bool,
 	logger *zap.Logger,
 ) error {
@@ -1001,7 +1269,7 @@ func configureNode(
 		return err
 	}
 
-	persistentPeersString, err := persistentPeers.AsCometPeerString(ctx, useExternalAddress)
+	persistentPeersString, err := peers.persistent.AsCometPeerString(ctx, useExternalAddress)
 	if err != nil {
 		return fmt.Errorf("failed to get comet peer string for persistent peers: %w", err)
 	}
@@ -1011,7 +1279,7 @@ func configureNode(
 		return err
 	}
 
-	seedPeersString, err := seeds.AsCometPeerString(ctx, useExternalAddress)
+	seedPeersString, err := peers.seeds.AsCometPeerString(ctx, useExternalAddress)
 	if err != nil {
 		return fmt.Errorf("failed to get comet peer string for seeds: %w", err)
 	}
@@ -1021,14 +1289,18 @@ func configureNode(
 		return err
 	}
 
+	if err := setPeerPolicy(ctx, node, peers); err != nil {
+		return fmt.Errorf("failed to set peer policy: %w", err)
+	}
+
 	if chainConfig.UseLibP2P() {
 		logger.Info("Using lib-p2p, setting bootstrap_peers in config.toml")
 		bootstrapPeers, err := composeLibP2PBootstrapPeers(
 			ctx,
 			node,
 			useExternalAddress,
-			seeds,
-			persistentPeers,
+			peers.seeds,
+			peers.persistent,
 		)
 
 		if err != nil {
@@ -1043,6 +1315,32 @@ func configureNode(
 	return nil
 }
 
+// setPeerPolicy writes the private and unconditional peer IDs and the pex setting of a node, nodes without
+// private or unconditional peers and with pex enabled keep their default config
+func setPeerPolicy(ctx context.Context, node petritypes.NodeI, peers nodePeers) error {
+	if peers.private.Empty() && peers.unconditional.Empty() && !peers.disablePex {
+		return nil
+	}
+
+	privatePeerIDs, err := peers.private.AsNodeIDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	unconditionalPeerIDs, err := peers.unconditional.AsNodeIDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	return node.ModifyTomlConfigFile(ctx, "config/config.toml", map[string]interface{}{
+		"p2p": map[string]interface{}{
+			"private_peer_ids":       privatePeerIDs,
+			"unconditional_peer_ids": unconditionalPeerIDs,
+			"pex":                    !peers.disablePex,
+		},
+	})
+}
+
 // composeLibP2PBootstrapPeers creates lib-p2p bootstrap peers from the given peer sets.
 // @see https://github.com/cometbft/cometbft/blob/6837f04ce6c122a1c575f5281c8ba171df8dd9d4/config/config.go#L631
 func composeLibP2PBootstrapPeers(
@@ -2,6 +2,7 @@ package chain_test
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -11,8 +12,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cometbft/cometbft/crypto/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	evmhd "github.com/cosmos/evm/crypto/hd"
 	gonanoid "github.com/matoous/go-nanoid/v2"
 	"github.com/pelletier/go-toml/v2"
@@ -248,6 +251,59 @@ func TestChainSerialization(t *testing.T) {
 	}
 }
 
+func TestChainResumedInit(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := zap.NewDevelopment()
+	providerName := gonanoid.MustGenerate(idAlphabet, 10)
+	chainName := gonanoid.MustGenerate(idAlphabet, 5)
+
+	p, err := docker.CreateProvider(ctx, logger, providerName)
+	require.NoError(t, err)
+	defer func(p provider.ProviderI, ctx context.Context) {
+		if !t.Failed() {
+			require.NoError(t, p.Teardown(ctx))
+		}
+	}(p, ctx)
+
+	chainConfig := defaultChainConfig
+	chainConfig.Name = chainName
+
+	c, err := chain.CreateChain(ctx, logger, p, chainConfig, defaultChainOptions)
+	require.NoError(t, err)
+
+	// every step runs on a chain restored from the state serialized after the previous one
+	restore := func(c *chain.Chain) *chain.Chain {
+		state, err := c.Serialize(ctx, p)
+		require.NoError(t, err)
+
+		restored, err := chain.RestoreChain(ctx, logger, p, state, node.RestoreNode, defaultChainOptions.WalletConfig)
+		require.NoError(t, err)
+		return restored
+	}
+
+	c = restore(c)
+	require.NoError(t, c.InitNodes(ctx, defaultChainOptions))
+	require.NoError(t, c.ResetNodes(ctx))
+	require.NoError(t, c.InitNodes(ctx, defaultChainOptions))
+
+	c = restore(c)
+	_, err = c.GenerateGenesis(ctx, defaultChainOptions)
+	require.NoError(t, err)
+	genbz, err := c.GenerateGenesis(ctx, defaultChainOptions)
+	require.NoError(t, err)
+
+	c = restore(c)
+	require.NoError(t, c.Configure(ctx, genbz))
+
+	c = restore(c)
+	require.NoError(t, c.Start(ctx))
+	require.NoError(t, c.WaitForBlocks(ctx, 5))
+
+	if !t.Failed() {
+		require.NoError(t, c.Teardown(ctx))
+	}
+}
+
 func TestGenesisModifier(t *testing.T) {
 	ctx := context.Background()
 	logger, _ := zap.NewDevelopment()
@@ -643,3 +699,314 @@ func TestGenesisAlteration_Balance(t *testing.T) {
 	expectedTotal := big.NewInt(1122200)
 	require.Equal(t, expectedTotal.String(), stakeSupply.String())
 }
+
+func TestSubstituteValidators(t *testing.T) {
+	bz, err := os.ReadFile("internal/testdata/exportedgenesis.json")
+	require.NoError(t, err)
+
+	var data map[string]any
+	require.NoError(t, json.Unmarshal(bz, &data))
+
+	validators := []chain.GenesisValidator{
+		{Name: "validator-0", PubKey: ed25519.GenPrivKeyFromSecret([]byte("ironbird-0")).PubKey().Bytes()},
+		{Name: "validator-1", PubKey: ed25519.GenPrivKeyFromSecret([]byte("ironbird-1")).PubKey().Bytes()},
+	}
+
+	oldConsAddress, err := bech32.ConvertAndEncode("cosmosvalcons",
+		ed25519.GenPrivKeyFromSecret([]byte("mainnet-validator-0")).PubKey().Address())
+	require.NoError(t, err)
+	unbondedConsAddress, err := bech32.ConvertAndEncode("cosmosvalcons",
+		ed25519.GenPrivKeyFromSecret([]byte("mainnet-validator-2")).PubKey().Address())
+	require.NoError(t, err)
+	newConsAddress, err := bech32.ConvertAndEncode("cosmosvalcons", ed25519.PubKey(validators[0].PubKey).Address())
+	require.NoError(t, err)
+
+	data, err = chain.SubstituteValidators(data, validators, "cosmos")
+	require.NoError(t, err)
+
+	appState := data["app_state"].(map[string]any)
+	staking := appState["staking"].(map[string]any)
+
+	// the two validators with the most tokens are taken over, the third one is unbonded
+	stakingValidators := staking["validators"].([]any)
+	for i, raw := range stakingValidators {
+		v := raw.(map[string]any)
+		if i < len(validators) {
+			require.Equal(t, "BOND_STATUS_BONDED", v["status"])
+			require.Equal(t, base64.StdEncoding.EncodeToString(validators[i].PubKey), v["consensus_pubkey"].(map[string]any)["key"])
+		} else {
+			require.Equal(t, "BOND_STATUS_UNBONDED", v["status"])
+		}
+	}
+
+	require.Len(t, staking["last_validator_powers"], 2)
+	require.Equal(t, "500", staking["last_total_power"])
+	require.EqualValues(t, 2, staking["params"].(map[string]any)["max_validators"])
+
+	// the unbonded validator's tokens are moved from the bonded to the not bonded pool
+	balances := map[string]string{}
+	for _, raw := range appState["bank"].(map[string]any)["balances"].([]any) {
+		bal := raw.(map[string]any)
+		balances[bal["address"].(string)] = bal["coins"].([]any)[0].(map[string]any)["amount"].(string)
+	}
+	require.Equal(t, "500000000", balances["cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh"])
+	require.Equal(t, "100000000", balances["cosmos1tygms3xhhs3yv487phx3dw4a95jn7t7lpm470r"])
+
+	// consensus addresses are rewritten outside of the staking state
+	signingInfos := appState["slashing"].(map[string]any)["signing_infos"].([]any)
+	require.Equal(t, newConsAddress, signingInfos[0].(map[string]any)["address"])
+	require.Equal(t, newConsAddress, signingInfos[0].(map[string]any)["validator_signing_info"].(map[string]any)["address"])
+	require.Equal(t, unbondedConsAddress, signingInfos[2].(map[string]any)["address"])
+	require.Equal(t, newConsAddress, appState["distribution"].(map[string]any)["previous_proposer"])
+	require.NotContains(t, fmt.Sprint(appState), oldConsAddress)
+
+	consensusValidators := data["consensus"].(map[string]any)["validators"].([]any)
+	require.Len(t, consensusValidators, 2)
+	require.Equal(t, "validator-0", consensusValidators[0].(map[string]any)["name"])
+	require.Equal(t, "300", consensusValidators[0].(map[string]any)["power"])
+	require.Equal(t, ed25519.PubKey(validators[0].PubKey).Address().String(), consensusValidators[0].(map[string]any)["address"])
+}
+
+func TestSubstituteValidatorsNotEnoughValidators(t *testing.T) {
+	bz, err := os.ReadFile("internal/testdata/exportedgenesis.json")
+	require.NoError(t, err)
+
+	var data map[string]any
+	require.NoError(t, json.Unmarshal(bz, &data))
+
+	validators := make([]chain.GenesisValidator, 4)
+	for i := range validators {
+		validators[i] = chain.GenesisValidator{
+			Name:   fmt.Sprintf("validator-%d", i),
+			PubKey: ed25519.GenPrivKeyFromSecret([]byte(fmt.Sprintf("ironbird-%d", i))).PubKey().Bytes(),
+		}
+	}
+
+	_, err = chain.SubstituteValidators(data, validators, "cosmos")
+	require.ErrorContains(t, err, "genesis has 3 eligible bonded validators, need 4")
+}
+
+func TestChainConfigImageGroups(t *testing.T) {
+	config := defaultChainConfig
+	config.Name = "image-groups"
+	config.ChainId = "image-groups"
+	config.NumNodes = 2
+	config.ImageGroups = []types.ImageGroup{
+		{Image: "ghcr.io/cosmos/simapp:v0.50", NumValidators: 2},
+		{Image: "ghcr.io/cosmos/simapp:v0.53", NumValidators: 1, NumNodes: 1},
+	}
+
+	require.NoError(t, config.ValidateBasic(types.Docker))
+
+	require.Equal(t, "ghcr.io/cosmos/simapp:v0.50", config.ValidatorImage(0))
+	require.Equal(t, "ghcr.io/cosmos/simapp:v0.50", config.ValidatorImage(1))
+	require.Equal(t, "ghcr.io/cosmos/simapp:v0.53", config.ValidatorImage(2))
+	require.Equal(t, config.Image.Image, config.ValidatorImage(3))
+	require.Equal(t, "ghcr.io/cosmos/simapp:v0.53", config.NodeImage(0))
+	require.Equal(t, config.Image.Image, config.NodeImage(1))
+
+	config.ImageGroups = append(config.ImageGroups, types.ImageGroup{Image: "ghcr.io/cosmos/simapp:v0.53", NumValidators: 2})
+	require.ErrorContains(t, config.ValidateBasic(types.Docker), "image groups contain more validators or nodes than the chain")
+}
+
+func TestChainConfigNodeCustomConfigs(t *testing.T) {
+	archiveIndex := 0
+	config := defaultChainConfig
+	config.Name = "config-overrides"
+	config.ChainId = "config-overrides"
+	config.NumNodes = 2
+	config.CustomAppConfig = map[string]interface{}{"pruning": "default"}
+	config.CustomConsensusConfig = map[string]interface{}{
+		"consensus": map[string]interface{}{"timeout_commit": "2s", "timeout_propose": "2s"},
+	}
+	config.RegionConfig = []types.RegionConfig{
+		{
+			Name: "ams3",
+			ConfigOverride: types.ConfigOverride{
+				CustomConsensusConfig: map[string]interface{}{
+					"consensus": map[string]interface{}{"timeout_commit": "5s"},
+				},
+			},
+		},
+	}
+	config.ConfigOverrides = []types.NodeConfigOverride{
+		{
+			Role:           types.FullNodeRole,
+			Index:          &archiveIndex,
+			ConfigOverride: types.ConfigOverride{CustomAppConfig: map[string]interface{}{"pruning": "nothing"}},
+		},
+		{
+			Role: types.FullNodeRole,
+			ConfigOverride: types.ConfigOverride{
+				CustomAppConfig:       map[string]interface{}{"pruning": "everything"},
+				CustomConsensusConfig: map[string]interface{}{"mempool": map[string]interface{}{"size": 10000}},
+			},
+		},
+	}
+
+	require.NoError(t, config.ValidateBasic(types.Docker))
+
+	validator := config.NodeCustomConfigs(types.ValidatorRole, "", 0)
+	require.Equal(t, config.CustomAppConfig, validator.CustomAppConfig)
+	require.Equal(t, config.CustomConsensusConfig, validator.CustomConsensusConfig)
+
+	regional := config.NodeCustomConfigs(types.ValidatorRole, "ams3", 0)
+	require.Equal(t, map[string]interface{}{
+		"consensus": map[string]interface{}{"timeout_commit": "5s", "timeout_propose": "2s"},
+	}, regional.CustomConsensusConfig)
+
+	archive := config.NodeCustomConfigs(types.FullNodeRole, "", 0)
+	require.Equal(t, "nothing", archive.CustomAppConfig["pruning"])
+	require.Equal(t, map[string]interface{}{"size": 10000}, archive.CustomConsensusConfig["mempool"])
+
+	rpc := config.NodeCustomConfigs(types.FullNodeRole, "", 1)
+	require.Equal(t, "everything", rpc.CustomAppConfig["pruning"])
+
+	// resolving overrides must not modify the chain's custom configs
+	require.Equal(t, "default", config.CustomAppConfig["pruning"])
+	require.Equal(t, "2s", config.CustomConsensusConfig["consensus"].(map[string]interface{})["timeout_commit"])
+
+	outOfRange := 2
+	config.ConfigOverrides[0].Index = &outOfRange
+	require.ErrorContains(t, config.ValidateBasic(types.Docker), "node index 2 is out of range")
+}
+
+func TestChainConfigValidatorDelegations(t *testing.T) {
+	config := defaultChainConfig
+	config.Name = "stake-distribution"
+	config.ChainId = "stake-distribution"
+
+	sum := func(delegations []*big.Int) *big.Int {
+		total := new(big.Int)
+		for _, d := range delegations {
+			total.Add(total, d)
+		}
+		return total
+	}
+
+	uniformTotal := new(big.Int).Mul(config.GetGenesisDelegation(), big.NewInt(int64(config.NumValidators)))
+
+	uniform := config.ValidatorDelegations(config.NumValidators)
+	for _, d := range uniform {
+		require.Equal(t, config.GetGenesisDelegation(), d)
+	}
+
+	config.StakeDistribution = &types.StakeDistribution{Type: types.TopNStake, TopN: 1, TopShare: 67}
+	require.NoError(t, config.ValidateBasic(types.Docker))
+
+	topN := config.ValidatorDelegations(config.NumValidators)
+	require.Equal(t, uniformTotal, sum(topN))
+	require.Equal(t, big.NewInt(13_400_000), topN[0])
+	require.Equal(t, big.NewInt(2_200_000), topN[1])
+
+	config.StakeDistribution = &types.StakeDistribution{Type: types.ZipfStake}
+	require.NoError(t, config.ValidateBasic(types.Docker))
+
+	zipf := config.ValidatorDelegations(config.NumValidators)
+	require.Equal(t, uniformTotal, sum(zipf))
+	for i := 1; i < len(zipf); i++ {
+		require.Equal(t, 1, zipf[i-1].Cmp(zipf[i]))
+	}
+
+	config.StakeDistribution = &types.StakeDistribution{Type: types.ExplicitStake, Amounts: []uint64{100, 10, 1, 1}}
+	require.NoError(t, config.ValidateBasic(types.Docker))
+	require.Equal(t, big.NewInt(100), config.ValidatorDelegations(config.NumValidators)[0])
+
+	// validators whose stake exceeds the genesis balance are funded with their stake on top of the balance
+	require.Equal(t, config.GetGenesisBalance(), config.ValidatorGenesisBalance(big.NewInt(100)))
+	require.Equal(t, big.NewInt(300_000_000), config.ValidatorGenesisBalance(big.NewInt(200_000_000)))
+
+	config.StakeDistribution = &types.StakeDistribution{Type: types.TopNStake, TopN: 4, TopShare: 67}
+	require.ErrorContains(t, config.ValidateBasic(types.Docker), "top n must be between 1 and 3")
+}
+
+func TestChainConfigTopology(t *testing.T) {
+	config := defaultChainConfig
+	config.Name = "topology"
+	config.ChainId = "topology"
+	config.NumNodes = 4
+
+	config.Topology = &types.Topology{Type: types.SentryTopology}
+	require.NoError(t, config.ValidateBasic(types.Docker))
+
+	sentry, err := config.Topology.Assign(config.NumValidators, config.NumNodes)
+	require.NoError(t, err)
+	require.Equal(t, []string{"node-0"}, sentry["validator-0"].Persistent)
+	require.True(t, sentry["validator-0"].DisablePex)
+	require.ElementsMatch(t, []string{"node-1", "node-2", "node-3", "validator-0"}, sentry["node-0"].Persistent)
+	require.Equal(t, []string{"validator-0"}, sentry["node-0"].Private)
+
+	config.Topology = &types.Topology{Type: types.SentryTopology, SentriesPerValidator: 2}
+	require.ErrorContains(t, config.ValidateBasic(types.Docker), "sentry topology requires 8 full nodes but the chain has 4")
+
+	ring, err := (&types.Topology{Type: types.RingTopology}).Assign(config.NumValidators, config.NumNodes)
+	require.NoError(t, err)
+	require.Equal(t, []string{"validator-1", "node-3"}, ring["validator-0"].Persistent)
+
+	regular := types.Topology{Type: types.RandomRegularTopology, Degree: 3, Seed: 42}
+	assignments, err := regular.Assign(config.NumValidators, config.NumNodes)
+	require.NoError(t, err)
+	require.Len(t, assignments, 8)
+
+	degrees := make(map[string]int)
+	for name, assignment := range assignments {
+		require.Len(t, assignment.Persistent, 3)
+		require.NotContains(t, assignment.Persistent, name)
+		for _, peer := range assignment.Persistent {
+			degrees[peer]++
+		}
+	}
+	for _, degree := range degrees {
+		require.Equal(t, 3, degree)
+	}
+
+	reproduced, err := regular.Assign(config.NumValidators, config.NumNodes)
+	require.NoError(t, err)
+	require.Equal(t, assignments, reproduced)
+
+	explicit := types.Topology{Type: types.ExplicitTopology, Peers: map[string][]string{"validator-0": {"node-9"}}}
+	_, err = explicit.Assign(config.NumValidators, config.NumNodes)
+	require.ErrorContains(t, err, "unknown peer node-9 of node validator-0")
+}
+
+func TestChainConfigRemoteSigner(t *testing.T) {
+	config := defaultChainConfig
+	config.Name = "remote-signer"
+	config.ChainId = "remote-signer"
+
+	config.RemoteSigner = &types.RemoteSigner{}
+	require.NoError(t, config.ValidateBasic(types.Docker))
+	require.False(t, config.RemoteSigner.IsThreshold())
+	require.Equal(t, types.DefaultRemoteSignerImage, config.RemoteSigner.GetImage())
+
+	config.RemoteSigner = &types.RemoteSigner{Shares: 3, Threshold: 2}
+	require.NoError(t, config.ValidateBasic(types.Docker))
+
+	config.RemoteSigner = &types.RemoteSigner{Shares: 3, Threshold: 1}
+	require.ErrorContains(t, config.ValidateBasic(types.Docker), "threshold must be between 2 and 3 for 3 shares")
+
+	config.RemoteSigner = &types.RemoteSigner{Threshold: 2}
+	require.ErrorContains(t, config.ValidateBasic(types.Docker), "threshold 2 requires more than one share")
+
+	require.Equal(t, "signMode: single\nchainNodes:\n  - privValAddr: tcp://10.0.0.2:1234\n",
+		chain.GenerateHorcruxConfig("10.0.0.2:1234", 0, nil))
+
+	threshold := chain.GenerateHorcruxConfig("10.0.0.2:1234", 2, []string{"10.0.0.3:2222", "10.0.0.4:2222", "10.0.0.5:2222"})
+	require.Contains(t, threshold, "signMode: threshold\nthresholdMode:\n  threshold: 2\n")
+	require.Contains(t, threshold, "    - shardID: 3\n      p2pAddr: tcp://10.0.0.5:2222\n")
+	require.Contains(t, threshold, "chainNodes:\n  - privValAddr: tcp://10.0.0.2:1234\n")
+}
+
+func TestMergeCoverProfiles(t *testing.T) {
+	merged, err := chain.MergeCoverProfiles(
+		[]byte("mode: atomic\napp/app.go:10.2,12.3 2 1\napp/app.go:14.2,15.3 1 0\n"),
+		[]byte("mode: atomic\napp/app.go:14.2,15.3 1 4\napp/app.go:10.2,12.3 2 2\nx/mod/keeper.go:5.1,6.2 3 0\n"),
+	)
+	require.NoError(t, err)
+	require.Equal(t, "mode: atomic\napp/app.go:10.2,12.3 2 3\napp/app.go:14.2,15.3 1 4\nx/mod/keeper.go:5.1,6.2 3 0\n",
+		string(merged))
+	require.InDelta(t, 50.0, chain.CoverPercentage(merged), 0.001)
+
+	_, err = chain.MergeCoverProfiles([]byte("mode: set\na.go:1.1,2.2 1 1\n"), []byte("mode: atomic\na.go:1.1,2.2 1 1\n"))
+	require.ErrorContains(t, err, "cannot merge coverage profiles")
+}
@@ -0,0 +1,494 @@
+package chain
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	petritypes "github.com/skip-mev/ironbird/petri/core/types"
+)
+
+const (
+	bondedStatus   = "BOND_STATUS_BONDED"
+	unbondedStatus = "BOND_STATUS_UNBONDED"
+
+	moduleAccountType   = "/cosmos.auth.v1beta1.ModuleAccount"
+	bondedPoolName      = "bonded_tokens_pool"
+	notBondedPoolName   = "not_bonded_tokens_pool"
+	ed25519PubKeyType   = "/cosmos.crypto.ed25519.PubKey"
+	cometEd25519KeyType = "tendermint/PubKeyEd25519"
+)
+
+// GenesisValidator is one of our validators that takes over a bonded validator of a custom genesis
+type GenesisValidator struct {
+	Name   string
+	PubKey []byte // PubKey is the validator's ed25519 consensus public key
+}
+
+// privValidatorKey is the subset of a priv_validator_key.json file needed to substitute a validator
+type privValidatorKey struct {
+	PubKey struct {
+		Type  string `json:"type"`
+		Value []byte `json:"value"`
+	} `json:"pub_key"`
+}
+
+// initCustomGenesisNodes initializes the nodes of a chain launched from a custom genesis and writes the validator
+// keys of the chain options
+func (c *Chain) initCustomGenesisNodes(ctx context.Context, opts petritypes.ChainOptions) error {
+	if len(opts.ValidatorKeys) > len(c.Validators) {
+		return fmt.Errorf("got %d validator keys for %d validators", len(opts.ValidatorKeys), len(c.Validators))
+	}
+
+	eg := new(errgroup.Group)
+
+	for idx, v := range c.Validators {
+		eg.Go(func() error {
+			c.logger.Info("setting up validator", zap.String("validator", v.GetDefinition().Name))
+
+			validatorWallet, err := v.CreateWallet(ctx, petritypes.ValidatorKeyName, opts.WalletConfig)
+			if err != nil {
+				return fmt.Errorf("failed to generate wallet: %w", err)
+			}
+
+			c.ValidatorWallets[idx] = validatorWallet
+
+			if err := v.SetupNode(ctx); err != nil {
+				return err
+			}
+
+			if idx < len(opts.ValidatorKeys) {
+				c.logger.Info("writing validator key", zap.String("validator", v.GetDefinition().Name))
+				if err := v.WriteFile(ctx, "config/priv_validator_key.json", opts.ValidatorKeys[idx]); err != nil {
+					return fmt.Errorf("failed to write validator key: %w", err)
+				}
+			}
+
+			return nil
+		})
+	}
+
+	for _, n := range c.Nodes {
+		eg.Go(func() error {
+			c.logger.Info("setting up node", zap.String("node", n.GetDefinition().Name))
+			return n.SetupNode(ctx)
+		})
+	}
+
+	return eg.Wait()
+}
+
+// buildCustomGenesis returns the custom genesis of the chain options with our validators substituted in and the
+// faucet, validator and additional accounts funded
+func (c *Chain) buildCustomGenesis(ctx context.Context, opts petritypes.ChainOptions, genesisAmounts []types.Coin) ([]byte, error) {
+	c.logger.Info("adding faucet genesis")
+	faucetWallet, err := c.BuildWallet(ctx, petritypes.FaucetAccountKeyName, "", opts.WalletConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	c.FaucetWallet = faucetWallet
+
+	validators := make([]GenesisValidator, len(c.Validators))
+	for i, v := range c.Validators {
+		bz, err := v.ReadFile(ctx, "config/priv_validator_key.json")
+		if err != nil {
+			return nil, fmt.Errorf("failed to read validator key of %s: %w", v.GetDefinition().Name, err)
+		}
+
+		var key privValidatorKey
+		if err := json.Unmarshal(bz, &key); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal validator key of %s: %w", v.GetDefinition().Name, err)
+		}
+
+		if key.PubKey.Type != cometEd25519KeyType {
+			return nil, fmt.Errorf("unsupported consensus key type %q for %s", key.PubKey.Type, v.GetDefinition().Name)
+		}
+
+		validators[i] = GenesisValidator{Name: v.GetDefinition().Name, PubKey: key.PubKey.Value}
+	}
+
+	var genesis map[string]any
+	if err := json.Unmarshal(opts.CustomGenesis, &genesis); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal custom genesis: %w", err)
+	}
+
+	genesis["chain_id"] = c.GetConfig().ChainId
+
+	c.logger.Info("substituting genesis validators", zap.Int("validators", len(validators)))
+	genesis, err = SubstituteValidators(genesis, validators, c.GetConfig().Bech32Prefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to substitute validators: %w", err)
+	}
+
+	accountNumber, err := nextAccountNumber(genesis)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := []string{faucetWallet.FormattedAddress()}
+	for _, w := range c.ValidatorWallets {
+		addresses = append(addresses, w.FormattedAddress())
+	}
+
+	accounts := make([]Account, 0, len(addresses))
+	for i, address := range addresses {
+		accounts = append(accounts, Account{
+			Type:          accountType,
+			Address:       address,
+			AccountNumber: strconv.Itoa(accountNumber + i),
+			Sequence:      "0",
+		})
+	}
+
+	if opts.AdditionalAccounts > 0 {
+		if opts.BaseMnemonic == "" {
+			return nil, fmt.Errorf("base-mnemonic is required when additional accounts > 0")
+		}
+
+		additionalAccounts, err := buildAccounts(opts.WalletConfig, opts.BaseMnemonic, accountNumber+len(accounts), opts.AdditionalAccounts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build additional accounts: %w", err)
+		}
+
+		accounts = append(accounts, additionalAccounts...)
+	}
+
+	genesis, err = UpdateGenesisAccounts(accounts, genesis)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update genesis accounts: %w", err)
+	}
+
+	genesis, err = UpdateGenesisBalances(buildBalances(accounts, genesisAmounts), genesis)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update genesis balances: %w", err)
+	}
+
+	return json.Marshal(genesis)
+}
+
+// SubstituteValidators hands the bonded validators of a genesis with the most tokens over to the given
+// validators by replacing their consensus keys. The consensus addresses of the replaced validators are rewritten
+// throughout the app state (e.g. slashing signing infos). Every other bonded validator is unbonded, its tokens
+// moved to the not bonded pool, and max_validators is capped so that the substituted validators hold all the
+// voting power of the chain
+func SubstituteValidators(genesis map[string]any, validators []GenesisValidator, bech32Prefix string) (map[string]any, error) {
+	appState, ok := genesis["app_state"].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("genesis has no app state")
+	}
+
+	staking, ok := appState["staking"].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("genesis has no staking state")
+	}
+
+	stakingParams, _ := staking["params"].(map[string]any)
+	bondDenom, _ := stakingParams["bond_denom"].(string)
+	if bondDenom == "" {
+		return nil, fmt.Errorf("genesis has no bond denom")
+	}
+
+	stakingValidators, _ := staking["validators"].([]any)
+
+	type bondedValidator struct {
+		validator map[string]any
+		tokens    *big.Int
+	}
+
+	var bonded []bondedValidator
+	for _, raw := range stakingValidators {
+		v, ok := raw.(map[string]any)
+		if !ok || v["status"] != bondedStatus {
+			continue
+		}
+
+		tokens, ok := new(big.Int).SetString(fmt.Sprint(v["tokens"]), 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid tokens for validator %v", v["operator_address"])
+		}
+
+		bonded = append(bonded, bondedValidator{validator: v, tokens: tokens})
+	}
+
+	sort.SliceStable(bonded, func(i, j int) bool {
+		return bonded[i].tokens.Cmp(bonded[j].tokens) > 0
+	})
+
+	powers := make(map[string]any)
+	lastPowers, _ := staking["last_validator_powers"].([]any)
+	for _, raw := range lastPowers {
+		if p, ok := raw.(map[string]any); ok {
+			powers[fmt.Sprint(p["address"])] = p["power"]
+		}
+	}
+
+	var (
+		replacements        = make(map[string]string)
+		keptPowers          []any
+		consensusValidators []any
+		totalPower          = new(big.Int)
+		unbondedTokens      = new(big.Int)
+	)
+
+	for _, bv := range bonded {
+		operator := fmt.Sprint(bv.validator["operator_address"])
+		jailed, _ := bv.validator["jailed"].(bool)
+
+		if len(keptPowers) == len(validators) || jailed {
+			bv.validator["status"] = unbondedStatus
+			unbondedTokens.Add(unbondedTokens, bv.tokens)
+			continue
+		}
+
+		substitute := validators[len(keptPowers)]
+
+		consensusKey, _ := bv.validator["consensus_pubkey"].(map[string]any)
+		if consensusKey["@type"] != ed25519PubKeyType {
+			return nil, fmt.Errorf("unsupported consensus key type %v for validator %s", consensusKey["@type"], operator)
+		}
+
+		oldPubKey, err := base64.StdEncoding.DecodeString(fmt.Sprint(consensusKey["key"]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid consensus key for validator %s: %w", operator, err)
+		}
+
+		oldConsAddress, err := bech32.ConvertAndEncode(bech32Prefix+"valcons", ed25519.PubKey(oldPubKey).Address())
+		if err != nil {
+			return nil, err
+		}
+
+		newConsAddress, err := bech32.ConvertAndEncode(bech32Prefix+"valcons", ed25519.PubKey(substitute.PubKey).Address())
+		if err != nil {
+			return nil, err
+		}
+
+		replacements[oldConsAddress] = newConsAddress
+		bv.validator["consensus_pubkey"] = map[string]any{
+			"@type": ed25519PubKeyType,
+			"key":   base64.StdEncoding.EncodeToString(substitute.PubKey),
+		}
+
+		power, ok := powers[operator]
+		if !ok {
+			return nil, fmt.Errorf("no last validator power for bonded validator %s", operator)
+		}
+
+		powerInt, ok := new(big.Int).SetString(fmt.Sprint(power), 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid power %v for validator %s", power, operator)
+		}
+		totalPower.Add(totalPower, powerInt)
+
+		keptPowers = append(keptPowers, map[string]any{"address": operator, "power": powerInt.String()})
+		consensusValidators = append(consensusValidators, map[string]any{
+			"address": ed25519.PubKey(substitute.PubKey).Address().String(),
+			"pub_key": map[string]any{
+				"type":  cometEd25519KeyType,
+				"value": base64.StdEncoding.EncodeToString(substitute.PubKey),
+			},
+			"power": powerInt.String(),
+			"name":  substitute.Name,
+		})
+	}
+
+	if len(keptPowers) < len(validators) {
+		return nil, fmt.Errorf("genesis has %d eligible bonded validators, need %d", len(keptPowers), len(validators))
+	}
+
+	staking["last_validator_powers"] = keptPowers
+	staking["last_total_power"] = totalPower.String()
+	stakingParams["max_validators"] = len(keptPowers)
+
+	if unbondedTokens.Sign() > 0 {
+		if err := moveModuleTokens(appState, bondedPoolName, notBondedPoolName, bondDenom, unbondedTokens); err != nil {
+			return nil, fmt.Errorf("failed to move unbonded tokens: %w", err)
+		}
+	}
+
+	for module, state := range appState {
+		if module == "staking" {
+			continue
+		}
+		appState[module] = replaceStrings(state, replacements)
+	}
+
+	if consensus, ok := genesis["consensus"].(map[string]any); ok {
+		consensus["validators"] = consensusValidators
+	} else {
+		genesis["validators"] = consensusValidators
+	}
+
+	return genesis, nil
+}
+
+// replaceStrings recursively replaces every string in v that is a key of replacements
+func replaceStrings(v any, replacements map[string]string) any {
+	switch val := v.(type) {
+	case string:
+		if r, ok := replacements[val]; ok {
+			return r
+		}
+	case map[string]any:
+		for k, inner := range val {
+			val[k] = replaceStrings(inner, replacements)
+		}
+	case []any:
+		for i, inner := range val {
+			val[i] = replaceStrings(inner, replacements)
+		}
+	}
+
+	return v
+}
+
+// moduleAccountAddress returns the address of the named module account in the auth genesis state
+func moduleAccountAddress(appState map[string]any, name string) (string, error) {
+	auth, _ := appState["auth"].(map[string]any)
+	accounts, _ := auth["accounts"].([]any)
+
+	for _, raw := range accounts {
+		acc, ok := raw.(map[string]any)
+		if !ok || acc["@type"] != moduleAccountType || acc["name"] != name {
+			continue
+		}
+
+		baseAccount, _ := acc["base_account"].(map[string]any)
+		if address, ok := baseAccount["address"].(string); ok {
+			return address, nil
+		}
+	}
+
+	return "", fmt.Errorf("module account %s not found", name)
+}
+
+// moveModuleTokens moves amount of denom from one module account's bank balance to another's
+func moveModuleTokens(appState map[string]any, from, to, denom string, amount *big.Int) error {
+	fromAddress, err := moduleAccountAddress(appState, from)
+	if err != nil {
+		return err
+	}
+
+	toAddress, err := moduleAccountAddress(appState, to)
+	if err != nil {
+		return err
+	}
+
+	bank, _ := appState["bank"].(map[string]any)
+	balances, _ := bank["balances"].([]any)
+
+	balances, err = addBalance(balances, fromAddress, denom, new(big.Int).Neg(amount))
+	if err != nil {
+		return err
+	}
+
+	balances, err = addBalance(balances, toAddress, denom, amount)
+	if err != nil {
+		return err
+	}
+
+	bank["balances"] = balances
+	return nil
+}
+
+// addBalance adds delta to the balance of denom held by address, creating the balance if it doesn't exist
+func addBalance(balances []any, address, denom string, delta *big.Int) ([]any, error) {
+	for _, raw := range balances {
+		bal, ok := raw.(map[string]any)
+		if !ok || bal["address"] != address {
+			continue
+		}
+
+		coins, _ := bal["coins"].([]any)
+		for _, rawCoin := range coins {
+			coin, ok := rawCoin.(map[string]any)
+			if !ok || coin["denom"] != denom {
+				continue
+			}
+
+			amount, ok := new(big.Int).SetString(fmt.Sprint(coin["amount"]), 10)
+			if !ok {
+				return nil, fmt.Errorf("invalid %s balance for %s", denom, address)
+			}
+
+			amount.Add(amount, delta)
+			if amount.Sign() < 0 {
+				return nil, fmt.Errorf("insufficient %s balance for %s", denom, address)
+			}
+
+			coin["amount"] = amount.String()
+			return balances, nil
+		}
+
+		if delta.Sign() < 0 {
+			return nil, fmt.Errorf("insufficient %s balance for %s", denom, address)
+		}
+
+		bal["coins"] = append(coins, map[string]any{"denom": denom, "amount": delta.String()})
+		return balances, nil
+	}
+
+	if delta.Sign() < 0 {
+		return nil, fmt.Errorf("no balance for %s", address)
+	}
+
+	return append(balances, map[string]any{
+		"address": address,
+		"coins":   []any{map[string]any{"denom": denom, "amount": delta.String()}},
+	}), nil
+}
+
+// nextAccountNumber returns the first account number that is not taken by an account in the genesis
+func nextAccountNumber(genesis map[string]any) (int, error) {
+	appState, _ := genesis["app_state"].(map[string]any)
+	auth, _ := appState["auth"].(map[string]any)
+	accounts, _ := auth["accounts"].([]any)
+
+	next := 0
+	for _, raw := range accounts {
+		acc, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		accountNumber, ok := findAccountNumber(acc)
+		if !ok {
+			continue
+		}
+
+		number, err := strconv.Atoi(fmt.Sprint(accountNumber))
+		if err != nil {
+			return 0, fmt.Errorf("invalid account number %v", accountNumber)
+		}
+
+		next = max(next, number+1)
+	}
+
+	return next, nil
+}
+
+// findAccountNumber returns the account number of a genesis account. Module and vesting accounts nest the
+// account number in their base account
+func findAccountNumber(acc map[string]any) (any, bool) {
+	if accountNumber, ok := acc["account_number"]; ok {
+		return accountNumber, true
+	}
+
+	for _, key := range []string{"base_account", "base_vesting_account"} {
+		if inner, ok := acc[key].(map[string]any); ok {
+			return findAccountNumber(inner)
+		}
+	}
+
+	return nil, false
+}
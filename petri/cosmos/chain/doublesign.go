@@ -0,0 +1,220 @@
+package chain
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"github.com/skip-mev/ironbird/petri/core/provider"
+	petritypes "github.com/skip-mev/ironbird/petri/core/types"
+)
+
+const privValidatorKeyPath = "config/priv_validator_key.json"
+
+// DoubleSignResult is the staking and slashing state of a validator before and after it double signed
+type DoubleSignResult struct {
+	OperatorAddress  string
+	ConsensusAddress string
+	TokensBefore     string
+	TokensAfter      string
+	Jailed           bool
+	Tombstoned       bool
+}
+
+// DoubleSign makes the validator with the given index equivocate: its consensus key is cloned onto a second node
+// task that joins the network next to the validator, so that both sign votes with the same key. DoubleSign then
+// waits until the evidence is committed and the validator is jailed, tombstoned and slashed. The second node is
+// destroyed before DoubleSign returns
+func (c *Chain) DoubleSign(ctx context.Context, p provider.ProviderI, opts petritypes.ChainOptions, validatorIndex int) (DoubleSignResult, error) {
+	var result DoubleSignResult
+	config := c.GetConfig()
+
+	if validatorIndex < 0 || validatorIndex >= len(c.Validators) {
+		return result, fmt.Errorf("validator index %d is out of range, the chain has %d validators", validatorIndex, len(c.Validators))
+	}
+
+	if config.RemoteSigner != nil {
+		return result, fmt.Errorf("validators with a remote signer don't hold their consensus key")
+	}
+
+	validator := c.Validators[validatorIndex]
+	logger := c.logger.With(zap.String("validator", validator.GetDefinition().Name))
+
+	key, err := validator.ReadFile(ctx, privValidatorKeyPath)
+	if err != nil {
+		return result, fmt.Errorf("failed to read consensus key: %w", err)
+	}
+
+	result.ConsensusAddress, err = consensusAddress(key, config.Bech32Prefix)
+	if err != nil {
+		return result, err
+	}
+	result.OperatorAddress = c.ValidatorWallets[validatorIndex].FormattedAddressWithPrefix(config.Bech32Prefix + "valoper")
+
+	before, _, err := c.querySlashingState(ctx, result.OperatorAddress, result.ConsensusAddress)
+	if err != nil {
+		return result, err
+	}
+
+	if before.Jailed {
+		return result, fmt.Errorf("validator %s is already jailed", result.OperatorAddress)
+	}
+	result.TokensBefore = before.Tokens.String()
+
+	logger.Info("launching double signer", zap.String("operator_address", result.OperatorAddress),
+		zap.String("consensus_address", result.ConsensusAddress))
+
+	twin, err := c.createDoubleSigner(ctx, p, opts, validator, key)
+	if twin != nil {
+		defer func() {
+			if err := twin.Destroy(context.Background()); err != nil {
+				logger.Error("failed to destroy double signer", zap.Error(err))
+			}
+		}()
+	}
+	if err != nil {
+		return result, fmt.Errorf("failed to launch double signer: %w", err)
+	}
+
+	ticker := time.NewTicker(2 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return result, fmt.Errorf("validator was not jailed and tombstoned for double signing: %w", ctx.Err())
+		case <-ticker.C:
+			after, signingInfo, err := c.querySlashingState(ctx, result.OperatorAddress, result.ConsensusAddress)
+			if err != nil {
+				logger.Debug("failed to query slashing state", zap.Error(err))
+				continue
+			}
+
+			result.Jailed, result.Tombstoned = after.Jailed, signingInfo.Tombstoned
+			result.TokensAfter = after.Tokens.String()
+
+			if !result.Jailed || !result.Tombstoned {
+				continue
+			}
+
+			logger.Info("validator was punished for double signing", zap.String("tokens_before", result.TokensBefore),
+				zap.String("tokens_after", result.TokensAfter))
+
+			if !after.Tokens.LT(before.Tokens) {
+				return result, fmt.Errorf("validator was jailed and tombstoned but not slashed (tokens %s)", result.TokensAfter)
+			}
+
+			return result, nil
+		}
+	}
+}
+
+// createDoubleSigner creates a node with the validator's image and custom configs, peers it with the rest of the
+// network and starts it with the cloned consensus key
+func (c *Chain) createDoubleSigner(ctx context.Context, p provider.ProviderI, opts petritypes.ChainOptions,
+	validator petritypes.NodeI, key []byte,
+) (petritypes.NodeI, error) {
+	config := c.GetConfig()
+	validatorConfig := validator.GetConfig()
+
+	nodeOpts := opts.NodeOptions
+	if validatorConfig.Region != "" {
+		i := slices.IndexFunc(config.RegionConfig, func(r petritypes.RegionConfig) bool { return r.Name == validatorConfig.Region })
+		if i < 0 {
+			return nil, fmt.Errorf("validator runs in unknown region %s", validatorConfig.Region)
+		}
+		nodeOpts = createRegionalNodeOptions(nodeOpts, config.RegionConfig[i])
+	}
+
+	twin, err := opts.NodeCreator(ctx, c.logger, p, petritypes.NodeConfig{
+		Index:       validatorConfig.Index,
+		Role:        petritypes.ValidatorRole,
+		Region:      validatorConfig.Region,
+		Name:        fmt.Sprintf("%s-double-signer-%d", config.Name, validatorConfig.Index),
+		ChainConfig: config,
+	}, withNodeImage(nodeOpts, config, validator.GetDefinition().Image.Image))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := twin.SetupNode(ctx); err != nil {
+		return twin, err
+	}
+
+	genbz, err := validator.GenesisFileContent(ctx)
+	if err != nil {
+		return twin, fmt.Errorf("failed to read genesis: %w", err)
+	}
+
+	peers := nodePeers{persistent: NewPeerSet(append(slices.Clone(c.Nodes), c.Validators...))}
+	if err := configureNode(ctx, twin, config, genbz, peers, c.useExternalAddresses, c.logger); err != nil {
+		return twin, err
+	}
+
+	if err := twin.WriteFile(ctx, privValidatorKeyPath, key); err != nil {
+		return twin, fmt.Errorf("failed to clone consensus key: %w", err)
+	}
+
+	return twin, twin.Start(ctx)
+}
+
+// querySlashingState returns the staking validator and the slashing signing info of a validator
+func (c *Chain) querySlashingState(ctx context.Context, operatorAddress, consensusAddress string,
+) (stakingtypes.Validator, slashingtypes.ValidatorSigningInfo, error) {
+	cc, err := c.GetGRPCClient(ctx)
+	if err != nil {
+		return stakingtypes.Validator{}, slashingtypes.ValidatorSigningInfo{}, err
+	}
+	defer cc.Close()
+
+	// the cosmos-sdk query types are gogoproto messages, which the default grpc codec can't marshal
+	protoCodec := grpc.ForceCodec(codec.NewProtoCodec(codectypes.NewInterfaceRegistry()).GRPCCodec())
+
+	validatorResp, err := stakingtypes.NewQueryClient(cc).Validator(ctx,
+		&stakingtypes.QueryValidatorRequest{ValidatorAddr: operatorAddress}, protoCodec)
+	if err != nil {
+		return stakingtypes.Validator{}, slashingtypes.ValidatorSigningInfo{}, fmt.Errorf("failed to query validator: %w", err)
+	}
+
+	signingInfoResp, err := slashingtypes.NewQueryClient(cc).SigningInfo(ctx,
+		&slashingtypes.QuerySigningInfoRequest{ConsAddress: consensusAddress}, protoCodec)
+	if err != nil {
+		return stakingtypes.Validator{}, slashingtypes.ValidatorSigningInfo{}, fmt.Errorf("failed to query signing info: %w", err)
+	}
+
+	if validatorResp.Validator.Tokens.IsNil() {
+		validatorResp.Validator.Tokens = sdkmath.ZeroInt()
+	}
+
+	return validatorResp.Validator, signingInfoResp.ValSigningInfo, nil
+}
+
+// consensusAddress returns the bech32 consensus address of a priv_validator_key.json
+func consensusAddress(key []byte, bech32Prefix string) (string, error) {
+	var pvKey struct {
+		Address string `json:"address"`
+	}
+
+	if err := json.Unmarshal(key, &pvKey); err != nil {
+		return "", fmt.Errorf("failed to parse consensus key: %w", err)
+	}
+
+	address, err := hex.DecodeString(pvKey.Address)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode consensus address: %w", err)
+	}
+
+	return bech32.ConvertAndEncode(bech32Prefix+"valcons", address)
+}
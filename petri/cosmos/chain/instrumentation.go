@@ -0,0 +1,250 @@
+package chain
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	petritypes "github.com/skip-mev/ironbird/petri/core/types"
+)
+
+// coverProfileFile is the text coverage profile covdata writes into the home of a node
+const coverProfileFile = "coverage.txt"
+
+// RaceReport is a report the race detector wrote on one of the chain's nodes
+type RaceReport struct {
+	Node   string
+	Name   string
+	Report []byte
+}
+
+// InstrumentationReport is the coverage and race detector output of every node of an instrumented chain
+type InstrumentationReport struct {
+	// CoverProfile is the merged text coverage profile of every node, it is empty if the binaries weren't built
+	// with -cover
+	CoverProfile []byte
+	RaceReports  []RaceReport
+}
+
+// CollectInstrumentation stops every node of an instrumented chain, so that -cover binaries flush their counters,
+// and gathers the coverage data and race reports the nodes left in their home directory. Coverage data is converted
+// to a text profile on every node with covdata, which instrumented images ship with, and merged into one profile
+func (c *Chain) CollectInstrumentation(ctx context.Context) (InstrumentationReport, error) {
+	config := c.GetConfig()
+	if !config.Instrumented {
+		return InstrumentationReport{}, fmt.Errorf("chain %s is not instrumented", config.Name)
+	}
+
+	var mu sync.Mutex
+	var report InstrumentationReport
+	var profiles [][]byte
+
+	eg, egCtx := errgroup.WithContext(ctx)
+	for _, node := range append(slices.Clone(c.Validators), c.Nodes...) {
+		eg.Go(func() error {
+			name := node.GetDefinition().Name
+
+			if err := node.Stop(egCtx); err != nil {
+				return fmt.Errorf("failed to stop %s: %w", name, err)
+			}
+
+			profile, err := readCoverProfile(egCtx, node, config.HomeDir)
+			if err != nil {
+				return fmt.Errorf("failed to collect coverage of %s: %w", name, err)
+			}
+
+			raceReports, err := readRaceReports(egCtx, node, config.HomeDir)
+			if err != nil {
+				return fmt.Errorf("failed to collect race reports of %s: %w", name, err)
+			}
+
+			c.logger.Info("collected instrumentation", zap.String("node", name),
+				zap.Int("cover_profile_size", len(profile)), zap.Int("race_reports", len(raceReports)))
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if len(profile) > 0 {
+				profiles = append(profiles, profile)
+			}
+			report.RaceReports = append(report.RaceReports, raceReports...)
+			return nil
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return InstrumentationReport{}, err
+	}
+
+	slices.SortFunc(report.RaceReports, func(a, b RaceReport) int {
+		return strings.Compare(a.Node+"/"+a.Name, b.Node+"/"+b.Name)
+	})
+
+	if len(profiles) > 0 {
+		var err error
+		if report.CoverProfile, err = MergeCoverProfiles(profiles...); err != nil {
+			return InstrumentationReport{}, err
+		}
+	}
+
+	return report, nil
+}
+
+func readCoverProfile(ctx context.Context, node petritypes.NodeI, homeDir string) ([]byte, error) {
+	files, err := listDir(ctx, node, path.Join(homeDir, petritypes.CoverageDir))
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.ContainsFunc(files, func(f string) bool { return strings.HasPrefix(f, "covmeta.") }) {
+		return nil, nil
+	}
+
+	stdout, stderr, exitCode, err := node.RunCommand(ctx, []string{"covdata", "textfmt",
+		"-i=" + path.Join(homeDir, petritypes.CoverageDir), "-o=" + path.Join(homeDir, coverProfileFile)})
+	if err != nil {
+		return nil, err
+	}
+
+	if exitCode != 0 {
+		return nil, fmt.Errorf("covdata failed (exit code %d): %s, stdout: %s", exitCode, stderr, stdout)
+	}
+
+	return node.ReadFile(ctx, coverProfileFile)
+}
+
+func readRaceReports(ctx context.Context, node petritypes.NodeI, homeDir string) ([]RaceReport, error) {
+	files, err := listDir(ctx, node, path.Join(homeDir, petritypes.RaceReportDir))
+	if err != nil {
+		return nil, err
+	}
+
+	var reports []RaceReport
+	for _, file := range files {
+		content, err := node.ReadFile(ctx, path.Join(petritypes.RaceReportDir, file))
+		if err != nil {
+			return nil, err
+		}
+
+		if len(bytes.TrimSpace(content)) == 0 {
+			continue
+		}
+
+		reports = append(reports, RaceReport{Node: node.GetDefinition().Name, Name: file, Report: content})
+	}
+
+	return reports, nil
+}
+
+func listDir(ctx context.Context, node petritypes.NodeI, dir string) ([]string, error) {
+	stdout, stderr, exitCode, err := node.RunCommand(ctx, []string{"ls", "-1", dir})
+	if err != nil {
+		return nil, err
+	}
+
+	if exitCode != 0 {
+		return nil, fmt.Errorf("failed to list %s (exit code %d): %s", dir, exitCode, stderr)
+	}
+
+	return strings.Fields(stdout), nil
+}
+
+// MergeCoverProfiles merges text coverage profiles of the same binary. Counts of a block are summed, or combined
+// with a logical or for profiles in set mode
+func MergeCoverProfiles(profiles ...[]byte) ([]byte, error) {
+	var mode string
+	counts := make(map[string]int64)
+
+	for _, profile := range profiles {
+		scanner := bufio.NewScanner(bytes.NewReader(profile))
+		scanner.Buffer(nil, 1<<20)
+
+		for scanner.Scan() {
+			line := scanner.Text()
+			if line == "" {
+				continue
+			}
+
+			if profileMode, ok := strings.CutPrefix(line, "mode: "); ok {
+				if mode != "" && mode != profileMode {
+					return nil, fmt.Errorf("cannot merge coverage profiles with modes %s and %s", mode, profileMode)
+				}
+				mode = profileMode
+				continue
+			}
+
+			// file.go:startLine.startCol,endLine.endCol numStatements count
+			i := strings.LastIndexByte(line, ' ')
+			if i < 0 {
+				return nil, fmt.Errorf("invalid coverage profile line %q", line)
+			}
+
+			count, err := strconv.ParseInt(line[i+1:], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid count in coverage profile line %q: %w", line, err)
+			}
+
+			block := line[:i]
+			if mode == "set" {
+				counts[block] = max(counts[block], min(count, 1))
+			} else {
+				counts[block] += count
+			}
+		}
+
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("failed to read coverage profile: %w", err)
+		}
+	}
+
+	if mode == "" {
+		return nil, fmt.Errorf("coverage profile has no mode")
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "mode: %s\n", mode)
+	for _, block := range slices.Sorted(maps.Keys(counts)) {
+		fmt.Fprintf(&b, "%s %d\n", block, counts[block])
+	}
+
+	return b.Bytes(), nil
+}
+
+// CoverPercentage returns the percentage of statements of a text coverage profile that were executed
+func CoverPercentage(profile []byte) float64 {
+	var total, covered int64
+
+	for _, line := range strings.Split(string(profile), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 3 || strings.HasPrefix(line, "mode:") {
+			continue
+		}
+
+		statements, err1 := strconv.ParseInt(fields[1], 10, 64)
+		count, err2 := strconv.ParseInt(fields[2], 10, 64)
+		if err1 != nil || err2 != nil {
+			continue
+		}
+
+		total += statements
+		if count > 0 {
+			covered += statements
+		}
+	}
+
+	if total == 0 {
+		return 0
+	}
+
+	return 100 * float64(covered) / float64(total)
+}
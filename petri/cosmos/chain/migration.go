@@ -0,0 +1,146 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/skip-mev/ironbird/petri/core/provider"
+	petritypes "github.com/skip-mev/ironbird/petri/core/types"
+)
+
+// GenesisMigrationOptions configures an export-and-restart of a running chain
+type GenesisMigrationOptions struct {
+	// ExportHeight is the height the chain is run to before it is halted and exported. If 0, the chain is
+	// halted immediately and the latest state is exported
+	ExportHeight uint64
+
+	// NodeDefinitionModifier is applied to every node's task definition before the chain is restarted,
+	// e.g. to swap the image for a newer version. The migration command runs on the modified definition
+	NodeDefinitionModifier petritypes.NodeDefinitionModifier
+
+	// MigrationCommand is an optional binary subcommand that is run against the exported genesis,
+	// e.g. ["genesis", "migrate", "v0.50"]. The path of the exported genesis is appended as the last argument
+	MigrationCommand []string
+
+	// ModifyGenesis transforms the exported (and migrated) genesis before it is distributed to the nodes
+	ModifyGenesis petritypes.GenesisModifier
+}
+
+// Stop halts every validator and node of the chain and the remote signers of the validators
+func (c *Chain) Stop(ctx context.Context) error {
+	c.logger.Info("stopping chain")
+
+	if err := c.forEachNode(func(n petritypes.NodeI) error {
+		c.logger.Info("stopping node task", zap.String("node", n.GetDefinition().Name))
+		return n.Stop(ctx)
+	}); err != nil {
+		return err
+	}
+
+	return c.forEachValidatorSigners(func(_ petritypes.NodeI, signers []provider.TaskI) error {
+		return c.stopSigners(ctx, signers)
+	})
+}
+
+// Start starts every validator and node of the chain and the remote signers of the validators
+func (c *Chain) Start(ctx context.Context) error {
+	c.logger.Info("starting chain")
+
+	if err := c.forEachNode(func(n petritypes.NodeI) error {
+		c.logger.Info("starting node task", zap.String("node", n.GetDefinition().Name))
+		return n.Start(ctx)
+	}); err != nil {
+		return err
+	}
+
+	return c.forEachValidatorSigners(func(validator petritypes.NodeI, signers []provider.TaskI) error {
+		return c.startSigners(ctx, validator, signers)
+	})
+}
+
+// MigrateGenesis runs the chain to the export height, halts every node and exports the state of the first
+// validator. The exported genesis is migrated and transformed according to the options, every node's state
+// is reset and the chain is restarted from the new genesis
+func (c *Chain) MigrateGenesis(ctx context.Context, opts GenesisMigrationOptions) error {
+	if opts.ExportHeight > 0 {
+		if err := c.WaitForHeight(ctx, opts.ExportHeight); err != nil {
+			return fmt.Errorf("failed to wait for export height: %w", err)
+		}
+	}
+
+	if err := c.Stop(ctx); err != nil {
+		return fmt.Errorf("failed to halt chain: %w", err)
+	}
+
+	exportNode := c.Validators[0]
+	genbz, err := exportNode.ExportGenesis(ctx, opts.ExportHeight)
+	if err != nil {
+		return fmt.Errorf("failed to export genesis from %s: %w", exportNode.GetDefinition().Name, err)
+	}
+
+	if opts.NodeDefinitionModifier != nil {
+		if err := c.forEachNode(func(n petritypes.NodeI) error {
+			c.logger.Info("modifying node definition", zap.String("node", n.GetDefinition().Name))
+			return n.Modify(ctx, opts.NodeDefinitionModifier(n.GetDefinition(), n.GetConfig()))
+		}); err != nil {
+			return fmt.Errorf("failed to modify node definitions: %w", err)
+		}
+
+		c.State.Config.Image = exportNode.GetDefinition().Image
+	}
+
+	if len(opts.MigrationCommand) > 0 {
+		genbz, err = exportNode.MigrateGenesis(ctx, genbz, opts.MigrationCommand)
+		if err != nil {
+			return fmt.Errorf("failed to migrate genesis: %w", err)
+		}
+	}
+
+	if opts.ModifyGenesis != nil {
+		c.logger.Info("modifying exported genesis")
+		genbz, err = opts.ModifyGenesis(genbz)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := c.forEachNode(func(n petritypes.NodeI) error {
+		if err := n.ResetState(ctx); err != nil {
+			return err
+		}
+
+		c.logger.Info("overwriting genesis", zap.String("node", n.GetDefinition().Name))
+		return n.OverwriteGenesisFile(ctx, genbz)
+	}); err != nil {
+		return fmt.Errorf("failed to distribute migrated genesis: %w", err)
+	}
+
+	// the signers would refuse to sign the heights of the new genesis that they already signed before the migration
+	if err := c.forEachValidatorSigners(func(_ petritypes.NodeI, signers []provider.TaskI) error {
+		return c.resetSignerState(ctx, signers)
+	}); err != nil {
+		return fmt.Errorf("failed to reset signer state: %w", err)
+	}
+
+	if err := c.Start(ctx); err != nil {
+		return fmt.Errorf("failed to restart chain: %w", err)
+	}
+
+	return c.WaitForStartup(ctx)
+}
+
+// forEachNode runs fn concurrently for every validator and node of the chain
+func (c *Chain) forEachNode(fn func(petritypes.NodeI) error) error {
+	eg := new(errgroup.Group)
+
+	for _, n := range append(append([]petritypes.NodeI{}, c.Validators...), c.Nodes...) {
+		eg.Go(func() error {
+			return fn(n)
+		})
+	}
+
+	return eg.Wait()
+}
@@ -24,6 +24,16 @@ func NewPeerSet(peers []petri.NodeI) PeerSet {
 	return PeerSet{peers: peers}
 }
 
+// NewTopologyPeerSet returns the peer set of the given topology node names
+func NewTopologyPeerSet(names []string, nodes map[string]petri.NodeI) PeerSet {
+	peers := make([]petri.NodeI, 0, len(names))
+	for _, name := range names {
+		peers = append(peers, nodes[name])
+	}
+
+	return NewPeerSet(peers)
+}
+
 func (ps *PeerSet) Empty() bool {
 	return len(ps.peers) == 0
 }
@@ -59,6 +69,22 @@ func (ps *PeerSet) AsCometPeerString(ctx context.Context, useExternal bool) (str
 	return strings.Join(peerStrings, ","), nil
 }
 
+// AsNodeIDs returns a comma-delimited string with the node IDs of the peers
+func (ps *PeerSet) AsNodeIDs(ctx context.Context) (string, error) {
+	nodeIDs := make([]string, 0, len(ps.peers))
+
+	for _, n := range ps.peers {
+		nodeID, err := n.NodeId(ctx)
+		if err != nil {
+			return "", errors.Wrap(err, "node id")
+		}
+
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+
+	return strings.Join(nodeIDs, ","), nil
+}
+
 // AsLibP2PBootstrapPeers returns a list of bootstrap peers for libp2p config.
 // Format: [{host: "1.2.3.4:26656", id: "<lib-p2p-peer-id>", persistent: true}, {...}, ...]
 // All peers are marked as persistent.
@@ -0,0 +1,401 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/privval"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/skip-mev/ironbird/petri/core/provider"
+	petritypes "github.com/skip-mev/ironbird/petri/core/types"
+)
+
+const (
+	signerHome      = "/home/horcrux/.horcrux"
+	signerShardsDir = "shards"
+)
+
+// createRemoteSigners creates the signer tasks of every validator. Signers run next to their validator, i.e. with
+// the provider specific config (region, size) of the validator's task
+func createRemoteSigners(ctx context.Context, infraProvider provider.ProviderI, config petritypes.ChainConfig,
+	validators []petritypes.NodeI,
+) ([][]provider.TaskI, error) {
+	signers := make([][]provider.TaskI, len(validators))
+
+	var eg errgroup.Group
+	for i, validator := range validators {
+		signers[i] = make([]provider.TaskI, config.RemoteSigner.GetShares())
+
+		for shard := range signers[i] {
+			eg.Go(func() error {
+				task, err := infraProvider.CreateTask(ctx, provider.TaskDefinition{
+					Name: fmt.Sprintf("%s-signer-%d", validator.GetDefinition().Name, shard+1),
+					Image: provider.ImageDefinition{
+						Image: config.RemoteSigner.GetImage(),
+						UID:   "1025",
+						GID:   "1025",
+					},
+					Ports:      []string{petritypes.CosignerPort},
+					DataDir:    signerHome,
+					Entrypoint: []string{"horcrux", "start", "--home", signerHome},
+
+					ProviderSpecificConfig: validator.GetDefinition().ProviderSpecificConfig,
+				})
+				if err != nil {
+					return fmt.Errorf("failed to create signer %d of %s: %w", shard+1, validator.GetDefinition().Name, err)
+				}
+
+				signers[i][shard] = task
+				return nil
+			})
+		}
+	}
+
+	// the signers that were created are returned with the error, so that they can be destroyed
+	if err := eg.Wait(); err != nil {
+		return signers, err
+	}
+
+	return signers, nil
+}
+
+// configureRemoteSigners hands the consensus key of every validator to its signers and points the validator's
+// priv_validator_laddr at them
+func (c *Chain) configureRemoteSigners(ctx context.Context) error {
+	eg := new(errgroup.Group)
+
+	for i, validator := range c.Validators {
+		eg.Go(func() error {
+			c.logger.Info("configuring remote signer", zap.String("validator", validator.GetDefinition().Name),
+				zap.Int("shares", len(c.Signers[i])))
+			return c.configureRemoteSigner(ctx, validator, c.Signers[i])
+		})
+	}
+
+	return eg.Wait()
+}
+
+func (c *Chain) configureRemoteSigner(ctx context.Context, validator petritypes.NodeI, signers []provider.TaskI) error {
+	chainConfig := c.GetConfig()
+
+	if err := validator.ModifyTomlConfigFile(ctx, "config/config.toml", map[string]interface{}{
+		"priv_validator_laddr": fmt.Sprintf("tcp://0.0.0.0:%s", petritypes.PrivValidatorPort),
+	}); err != nil {
+		return fmt.Errorf("failed to set priv_validator_laddr: %w", err)
+	}
+
+	// a rerun finds the placeholder key on the validator once the signers were handed the consensus key, which
+	// must not replace the key they hold
+	handedOut, err := signersHoldKey(ctx, signers, chainConfig.ChainId, chainConfig.RemoteSigner.IsThreshold())
+	if err != nil {
+		return err
+	}
+
+	if handedOut {
+		c.logger.Info("signers already hold the consensus key", zap.String("validator", validator.GetDefinition().Name))
+	} else if err := c.handOutConsensusKey(ctx, validator, signers); err != nil {
+		return err
+	}
+
+	// the consensus key only lives on the signers, the validator signs through them with priv_validator_laddr set
+	placeholderKey, err := placeholderPrivValidatorKey()
+	if err != nil {
+		return err
+	}
+
+	if err := validator.WriteFile(ctx, privValidatorKeyPath, placeholderKey); err != nil {
+		return fmt.Errorf("failed to remove consensus key from validator: %w", err)
+	}
+
+	return c.writeSignerConfigs(ctx, validator, signers)
+}
+
+// handOutConsensusKey writes the consensus key of a validator to its signer, or its shards to its cosigners
+func (c *Chain) handOutConsensusKey(ctx context.Context, validator petritypes.NodeI, signers []provider.TaskI) error {
+	chainConfig := c.GetConfig()
+
+	key, err := validator.ReadFile(ctx, privValidatorKeyPath)
+	if err != nil {
+		return fmt.Errorf("failed to read consensus key: %w", err)
+	}
+
+	if !chainConfig.RemoteSigner.IsThreshold() {
+		if err := signers[0].WriteFile(ctx, fmt.Sprintf("%s_priv_validator_key.json", chainConfig.ChainId), key); err != nil {
+			return err
+		}
+	} else {
+		shards, err := splitConsensusKey(ctx, signers[0], chainConfig.ChainId, key, len(signers), chainConfig.RemoteSigner.Threshold)
+		if err != nil {
+			return err
+		}
+
+		for i, signer := range signers {
+			for name, content := range shards[i] {
+				if err := signer.WriteFile(ctx, name, content); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// signersHoldKey reports whether every signer of a validator holds its consensus key, or its shard of it
+func signersHoldKey(ctx context.Context, signers []provider.TaskI, chainID string, threshold bool) (bool, error) {
+	keyFile := fmt.Sprintf("%s_priv_validator_key.json", chainID)
+	if threshold {
+		keyFile = fmt.Sprintf("%s_shard.json", chainID)
+	}
+
+	for _, signer := range signers {
+		_, stderr, exitCode, err := signer.RunCommand(ctx, []string{"test", "-f", signerHome + "/" + keyFile})
+		if err != nil {
+			return false, fmt.Errorf("failed to look up consensus key of %s: %w", signer.GetDefinition().Name, err)
+		}
+
+		switch exitCode {
+		case 0:
+		case 1:
+			return false, nil
+		default:
+			return false, fmt.Errorf("failed to look up consensus key of %s (exit code %d): %s",
+				signer.GetDefinition().Name, exitCode, stderr)
+		}
+
+		// a single signer only ever holds the key of signers[0]
+		if !threshold {
+			break
+		}
+	}
+
+	return true, nil
+}
+
+// writeSignerConfigs writes the Horcrux config of the signers of a validator, which points them at the validator's
+// current address
+func (c *Chain) writeSignerConfigs(ctx context.Context, validator petritypes.NodeI, signers []provider.TaskI) error {
+	chainConfig := c.GetConfig()
+
+	privValAddr, err := taskAddress(ctx, validator, petritypes.PrivValidatorPort, c.useExternalAddresses)
+	if err != nil {
+		return fmt.Errorf("failed to get priv validator address: %w", err)
+	}
+
+	threshold := 0
+	var cosignerAddrs []string
+	if chainConfig.RemoteSigner.IsThreshold() {
+		threshold = chainConfig.RemoteSigner.Threshold
+		cosignerAddrs = make([]string, len(signers))
+		for i, signer := range signers {
+			cosignerAddrs[i], err = taskAddress(ctx, signer, petritypes.CosignerPort, c.useExternalAddresses)
+			if err != nil {
+				return fmt.Errorf("failed to get cosigner address: %w", err)
+			}
+		}
+	}
+
+	config := GenerateHorcruxConfig(privValAddr, threshold, cosignerAddrs)
+	for _, signer := range signers {
+		if err := signer.WriteFile(ctx, "config.yaml", []byte(config)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// placeholderPrivValidatorKey returns a priv_validator_key.json of a random key that replaces the consensus key of
+// a validator whose votes are signed by remote signers
+func placeholderPrivValidatorKey() ([]byte, error) {
+	privKey := ed25519.GenPrivKey()
+
+	bz, err := cmtjson.MarshalIndent(privval.FilePVKey{
+		Address: privKey.PubKey().Address(),
+		PubKey:  privKey.PubKey(),
+		PrivKey: privKey,
+	}, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal placeholder consensus key: %w", err)
+	}
+
+	return bz, nil
+}
+
+// signersOf returns the remote signers of a validator, or nil if it has none
+func (c *Chain) signersOf(n petritypes.NodeI) []provider.TaskI {
+	i := slices.Index(c.Validators, n)
+	if i < 0 || i >= len(c.Signers) {
+		return nil
+	}
+
+	return c.Signers[i]
+}
+
+// startSigners points the signers of a validator at the validator's current address, which changes if the
+// validator's task was recreated, and starts them
+func (c *Chain) startSigners(ctx context.Context, validator petritypes.NodeI, signers []provider.TaskI) error {
+	if len(signers) == 0 {
+		return nil
+	}
+
+	if err := c.writeSignerConfigs(ctx, validator, signers); err != nil {
+		return err
+	}
+
+	for _, signer := range signers {
+		c.logger.Info("starting signer task", zap.String("signer", signer.GetDefinition().Name))
+		if err := signer.Start(ctx); err != nil {
+			return fmt.Errorf("failed to start signer %s: %w", signer.GetDefinition().Name, err)
+		}
+	}
+
+	return nil
+}
+
+// stopSigners stops the signers of a validator
+func (c *Chain) stopSigners(ctx context.Context, signers []provider.TaskI) error {
+	for _, signer := range signers {
+		c.logger.Info("stopping signer task", zap.String("signer", signer.GetDefinition().Name))
+		if err := signer.Stop(ctx); err != nil {
+			return fmt.Errorf("failed to stop signer %s: %w", signer.GetDefinition().Name, err)
+		}
+	}
+
+	return nil
+}
+
+// resetSignerState removes the sign state of the signers of a validator, which Horcrux recreates at height 0 on
+// start. The signers must be stopped
+func (c *Chain) resetSignerState(ctx context.Context, signers []provider.TaskI) error {
+	script := fmt.Sprintf("rm -f %[1]s/state/*_priv_validator_state.json %[1]s/state/*_share_sign_state.json", signerHome)
+
+	for _, signer := range signers {
+		c.logger.Info("resetting signer state", zap.String("signer", signer.GetDefinition().Name))
+
+		stdout, stderr, exitCode, err := signer.RunCommand(ctx, []string{"/bin/sh", "-c", script})
+		if err != nil {
+			return fmt.Errorf("failed to reset state of signer %s: %w", signer.GetDefinition().Name, err)
+		}
+
+		if exitCode != 0 {
+			return fmt.Errorf("state reset of signer %s failed (exit code %d): %s, stdout: %s",
+				signer.GetDefinition().Name, exitCode, stderr, stdout)
+		}
+	}
+
+	return nil
+}
+
+// forEachValidatorSigners concurrently runs fn for every validator that has remote signers
+func (c *Chain) forEachValidatorSigners(fn func(petritypes.NodeI, []provider.TaskI) error) error {
+	eg := new(errgroup.Group)
+
+	for _, validator := range c.Validators {
+		if signers := c.signersOf(validator); len(signers) != 0 {
+			eg.Go(func() error {
+				return fn(validator, signers)
+			})
+		}
+	}
+
+	return eg.Wait()
+}
+
+// splitConsensusKey splits a consensus key into the ed25519 key shards and ecies keys of every cosigner, keyed by
+// their file name in the cosigner's home directory. The full key is removed from the signer after the split
+func splitConsensusKey(ctx context.Context, signer provider.TaskI, chainID string, key []byte, shares, threshold int,
+) ([]map[string][]byte, error) {
+	if err := signer.WriteFile(ctx, "priv_validator_key.json", key); err != nil {
+		return nil, err
+	}
+
+	commands := [][]string{
+		{"horcrux", "create-ed25519-shards", "--home", signerHome, "--chain-id", chainID,
+			"--key-file", signerHome + "/priv_validator_key.json",
+			"--threshold", fmt.Sprint(threshold), "--shards", fmt.Sprint(shares),
+			"--out", signerHome + "/" + signerShardsDir},
+		{"horcrux", "create-ecies-shards", "--home", signerHome, "--shards", fmt.Sprint(shares),
+			"--out", signerHome + "/" + signerShardsDir},
+	}
+
+	for _, cmd := range commands {
+		stdout, stderr, exitCode, err := signer.RunCommand(ctx, cmd)
+		if err != nil {
+			return nil, fmt.Errorf("failed to split consensus key: %w", err)
+		}
+
+		if exitCode != 0 {
+			return nil, fmt.Errorf("failed to split consensus key (exit code %d): %s, stdout: %s", exitCode, stderr, stdout)
+		}
+	}
+
+	shardFile := fmt.Sprintf("%s_shard.json", chainID)
+	shards := make([]map[string][]byte, shares)
+
+	for i := range shards {
+		shards[i] = make(map[string][]byte)
+
+		for _, name := range []string{shardFile, "ecies_keys.json"} {
+			content, err := signer.ReadFile(ctx, fmt.Sprintf("%s/cosigner_%d/%s", signerShardsDir, i+1, name))
+			if err != nil {
+				return nil, fmt.Errorf("failed to read %s of cosigner %d: %w", name, i+1, err)
+			}
+
+			shards[i][name] = content
+		}
+	}
+
+	_, _, _, err := signer.RunCommand(ctx, []string{"rm", "-rf",
+		signerHome + "/priv_validator_key.json", signerHome + "/" + signerShardsDir})
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove consensus key from signer: %w", err)
+	}
+
+	return shards, nil
+}
+
+// GenerateHorcruxConfig returns the Horcrux config of the signers of a validator listening on privValAddr. A
+// threshold of 0 configures a single signer, otherwise every cosigner shares the same config
+func GenerateHorcruxConfig(privValAddr string, threshold int, cosignerAddrs []string) string {
+	var b strings.Builder
+
+	if threshold == 0 {
+		b.WriteString("signMode: single\n")
+	} else {
+		b.WriteString("signMode: threshold\n")
+		b.WriteString("thresholdMode:\n")
+		fmt.Fprintf(&b, "  threshold: %d\n", threshold)
+		b.WriteString("  cosigners:\n")
+		for i, addr := range cosignerAddrs {
+			fmt.Fprintf(&b, "    - shardID: %d\n      p2pAddr: tcp://%s\n", i+1, addr)
+		}
+		b.WriteString("  grpcTimeout: 1000ms\n")
+		b.WriteString("  raftTimeout: 1000ms\n")
+	}
+
+	b.WriteString("chainNodes:\n")
+	fmt.Fprintf(&b, "  - privValAddr: tcp://%s\n", privValAddr)
+
+	return b.String()
+}
+
+// taskAddress returns the host:port a task is reachable on by other tasks
+func taskAddress(ctx context.Context, task provider.TaskI, port string, useExternalAddress bool) (string, error) {
+	if useExternalAddress {
+		return task.GetExternalAddress(ctx, port)
+	}
+
+	ip, err := task.GetIP(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s:%s", ip, port), nil
+}
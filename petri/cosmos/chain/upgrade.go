@@ -0,0 +1,196 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	petritypes "github.com/skip-mev/ironbird/petri/core/types"
+)
+
+const appConfigPath = "config/app.toml"
+
+// RollingRestart restarts the nodes of the chain one at a time, validators first. Every node is restarted on the
+// task definition returned by modifier (e.g. with a different image) and the restart only moves on to the next
+// node once the restarted node caught up with the chain. A nil modifier restarts the nodes on their current definition
+func (c *Chain) RollingRestart(ctx context.Context, modifier petritypes.NodeDefinitionModifier) error {
+	for _, n := range append(append([]petritypes.NodeI{}, c.Validators...), c.Nodes...) {
+		if err := c.restartNode(ctx, n, modifier); err != nil {
+			return fmt.Errorf("failed to restart %s: %w", n.GetDefinition().Name, err)
+		}
+	}
+
+	c.updateImage(modifier)
+
+	return nil
+}
+
+// HaltHeightUpgrade performs a coordinated upgrade without governance: halt-height is set in the app config of
+// every node (applied through a rolling restart), the chain is run until it halts at haltHeight, and every node is
+// restarted on the task definition returned by modifier with the halt-height cleared
+func (c *Chain) HaltHeightUpgrade(ctx context.Context, haltHeight uint64, modifier petritypes.NodeDefinitionModifier) error {
+	height, err := c.Height(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get chain height: %w", err)
+	}
+
+	if height >= haltHeight {
+		return fmt.Errorf("halt height %d has already been reached (height %d)", haltHeight, height)
+	}
+
+	c.logger.Info("setting halt height", zap.Uint64("halt_height", haltHeight))
+
+	for _, n := range append(append([]petritypes.NodeI{}, c.Validators...), c.Nodes...) {
+		if err := n.ModifyTomlConfigFile(ctx, appConfigPath, map[string]interface{}{"halt-height": haltHeight}); err != nil {
+			return fmt.Errorf("failed to set halt height on %s: %w", n.GetDefinition().Name, err)
+		}
+
+		// a node that restarts past the halt height would halt right away and never catch up
+		if height, err := n.Height(ctx); err == nil && height >= haltHeight {
+			return fmt.Errorf("chain reached halt height %d before every node was configured", haltHeight)
+		}
+
+		if err := c.restartNode(ctx, n, nil); err != nil {
+			return fmt.Errorf("failed to restart %s: %w", n.GetDefinition().Name, err)
+		}
+	}
+
+	if err := c.waitForHalt(ctx, haltHeight); err != nil {
+		return fmt.Errorf("failed to wait for chain halt: %w", err)
+	}
+
+	if err := c.Stop(ctx); err != nil {
+		return fmt.Errorf("failed to stop halted chain: %w", err)
+	}
+
+	if err := c.forEachNode(func(n petritypes.NodeI) error {
+		if err := n.ModifyTomlConfigFile(ctx, appConfigPath, map[string]interface{}{"halt-height": 0}); err != nil {
+			return err
+		}
+
+		if modifier == nil {
+			return nil
+		}
+
+		c.logger.Info("modifying node definition", zap.String("node", n.GetDefinition().Name))
+		return n.Modify(ctx, modifier(n.GetDefinition(), n.GetConfig()))
+	}); err != nil {
+		return fmt.Errorf("failed to upgrade nodes: %w", err)
+	}
+
+	c.updateImage(modifier)
+
+	if err := c.Start(ctx); err != nil {
+		return fmt.Errorf("failed to restart chain: %w", err)
+	}
+
+	return c.WaitForHeight(ctx, haltHeight+1)
+}
+
+// restartNode stops a node, optionally modifies its task definition, starts it again and waits until it
+// caught up and is past the height it was stopped at
+func (c *Chain) restartNode(ctx context.Context, n petritypes.NodeI, modifier petritypes.NodeDefinitionModifier) error {
+	logger := c.logger.With(zap.String("node", n.GetDefinition().Name))
+
+	height, err := n.Height(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get node height: %w", err)
+	}
+
+	logger.Info("restarting node", zap.Uint64("height", height))
+
+	// the remote signers of a validator are restarted with it
+	signers := c.signersOf(n)
+
+	if err := n.Stop(ctx); err != nil {
+		return err
+	}
+
+	if err := c.stopSigners(ctx, signers); err != nil {
+		return err
+	}
+
+	if modifier != nil {
+		if err := n.Modify(ctx, modifier(n.GetDefinition(), n.GetConfig())); err != nil {
+			return err
+		}
+	}
+
+	if err := n.Start(ctx); err != nil {
+		return err
+	}
+
+	if err := c.startSigners(ctx, n, signers); err != nil {
+		return err
+	}
+
+	return c.waitForCatchUp(ctx, n, height+1)
+}
+
+// waitForCatchUp blocks until the node is no longer catching up and reached the given height
+func (c *Chain) waitForCatchUp(ctx context.Context, n petritypes.NodeI, height uint64) error {
+	ticker := time.NewTicker(2 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			client, err := n.GetTMClient(ctx)
+			if err != nil {
+				continue
+			}
+
+			status, err := client.Status(ctx)
+			if err != nil {
+				c.logger.Debug("node is not up yet", zap.String("node", n.GetDefinition().Name), zap.Error(err))
+				continue
+			}
+
+			if !status.SyncInfo.CatchingUp && uint64(status.SyncInfo.LatestBlockHeight) >= height {
+				c.logger.Info("node caught up", zap.String("node", n.GetDefinition().Name),
+					zap.Int64("height", status.SyncInfo.LatestBlockHeight))
+				return nil
+			}
+		}
+	}
+}
+
+// waitForHalt blocks until the chain reached the halt height. Nodes shut down once they committed the halt
+// height, so a chain that becomes unreachable right before the halt height is considered halted as well
+func (c *Chain) waitForHalt(ctx context.Context, haltHeight uint64) error {
+	c.logger.Info("waiting for chain to halt", zap.Uint64("halt_height", haltHeight))
+
+	ticker := time.NewTicker(2 * time.Second)
+	defer ticker.Stop()
+
+	var lastHeight uint64
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			height, err := c.Height(ctx)
+			if err == nil {
+				lastHeight = height
+				if height < haltHeight {
+					continue
+				}
+			} else if lastHeight+1 < haltHeight {
+				continue
+			}
+
+			c.logger.Info("chain halted", zap.Uint64("last_height", lastHeight))
+			return nil
+		}
+	}
+}
+
+func (c *Chain) updateImage(modifier petritypes.NodeDefinitionModifier) {
+	if modifier != nil && len(c.Validators) > 0 {
+		c.State.Config.Image = c.Validators[0].GetDefinition().Image
+	}
+}
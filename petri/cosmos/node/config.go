@@ -229,35 +229,37 @@ func (n *Node) SetChainConfigs(ctx context.Context, chainID string, p2pExternalA
 	return nil
 }
 
-// ApplyCustomConfigs applies custom configurations from ChainConfig to the respective config files
+// ApplyCustomConfigs applies custom configurations from ChainConfig to the respective config files, including the
+// overrides of the node's role, region and index
 func (n *Node) ApplyCustomConfigs(ctx context.Context) error {
-	chainConfig := n.GetChainConfig()
+	nodeConfig := n.GetConfig()
+	customConfigs := n.GetChainConfig().NodeCustomConfigs(nodeConfig.Role, nodeConfig.Region, nodeConfig.Index)
 
-	if len(chainConfig.CustomAppConfig) > 0 {
+	if len(customConfigs.CustomAppConfig) > 0 {
 		if err := n.ModifyTomlConfigFile(
 			ctx,
 			"config/app.toml",
-			chainConfig.CustomAppConfig,
+			customConfigs.CustomAppConfig,
 		); err != nil {
 			return fmt.Errorf("failed to apply custom app config: %w", err)
 		}
 	}
 
-	if len(chainConfig.CustomConsensusConfig) > 0 {
+	if len(customConfigs.CustomConsensusConfig) > 0 {
 		if err := n.ModifyTomlConfigFile(
 			ctx,
 			"config/config.toml",
-			chainConfig.CustomConsensusConfig,
+			customConfigs.CustomConsensusConfig,
 		); err != nil {
 			return fmt.Errorf("failed to apply custom consensus config: %w", err)
 		}
 	}
 
-	if len(chainConfig.CustomClientConfig) > 0 {
+	if len(customConfigs.CustomClientConfig) > 0 {
 		if err := n.ModifyTomlConfigFile(
 			ctx,
 			"config/client.toml",
-			chainConfig.CustomClientConfig,
+			customConfigs.CustomClientConfig,
 		); err != nil {
 			return fmt.Errorf("failed to apply custom client config: %w", err)
 		}
@@ -2,7 +2,11 @@ package node
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"path"
+	"strconv"
+	"strings"
 
 	"go.uber.org/zap"
 
@@ -48,3 +52,81 @@ func (n *Node) OverwriteGenesisFile(ctx context.Context, bz []byte) error {
 
 	return n.WriteFile(ctx, "config/genesis.json", bz)
 }
+
+// ExportGenesis runs the binary's export command against the node's data directory and returns the
+// exported genesis. The node should be stopped before exporting. A height of 0 exports the latest state
+func (n *Node) ExportGenesis(ctx context.Context, height uint64) ([]byte, error) {
+	n.logger.Info("exporting genesis", zap.String("node", n.GetDefinition().Name), zap.Uint64("height", height))
+
+	exportCmd := []string{"export"}
+	if height > 0 {
+		exportCmd = append(exportCmd, "--height", strconv.FormatUint(height, 10))
+	}
+
+	stdout, stderr, exitCode, err := n.RunCommand(ctx, n.BinCommand(exportCmd...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to run export: %w", err)
+	}
+
+	if exitCode != 0 {
+		return nil, fmt.Errorf("export failed (exit code %d): %s", exitCode, stderr)
+	}
+
+	return genesisFromOutput(stdout, stderr)
+}
+
+// MigrateGenesis writes the genesis to the node's home directory, runs the given binary subcommand with the
+// path of that file as its last argument and returns the migrated genesis
+func (n *Node) MigrateGenesis(ctx context.Context, genesis []byte, command []string) ([]byte, error) {
+	n.logger.Info("migrating genesis", zap.String("node", n.GetDefinition().Name), zap.Strings("command", command))
+
+	if err := n.WriteFile(ctx, "config/exported_genesis.json", genesis); err != nil {
+		return nil, fmt.Errorf("failed to write exported genesis: %w", err)
+	}
+
+	migrateCmd := append(append([]string{}, command...), path.Join(n.GetChainConfig().HomeDir, "config/exported_genesis.json"))
+
+	stdout, stderr, exitCode, err := n.RunCommand(ctx, n.BinCommand(migrateCmd...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to run genesis migration: %w", err)
+	}
+
+	if exitCode != 0 {
+		return nil, fmt.Errorf("genesis migration failed (exit code %d): %s", exitCode, stderr)
+	}
+
+	return genesisFromOutput(stdout, stderr)
+}
+
+// ResetState removes the node's blockchain data and resets its validator signing state while keeping its
+// configuration and keys, the equivalent of unsafe-reset-all
+func (n *Node) ResetState(ctx context.Context) error {
+	n.logger.Info("resetting node state", zap.String("node", n.GetDefinition().Name))
+
+	dataDir := path.Join(n.GetChainConfig().HomeDir, "data")
+	script := fmt.Sprintf("rm -rf %[1]s && mkdir -p %[1]s && echo '{\"height\":\"0\",\"round\":0,\"step\":0}' > %[1]s/priv_validator_state.json", dataDir)
+
+	stdout, stderr, exitCode, err := n.RunCommand(ctx, []string{"/bin/sh", "-c", script})
+	if err != nil {
+		return fmt.Errorf("failed to reset node state: %w", err)
+	}
+
+	if exitCode != 0 {
+		return fmt.Errorf("node state reset failed (exit code %d): %s, stdout: %s", exitCode, stderr, stdout)
+	}
+
+	return nil
+}
+
+// genesisFromOutput picks the genesis document out of a command's output. Older SDK versions write the
+// exported genesis to stderr instead of stdout
+func genesisFromOutput(stdout, stderr string) ([]byte, error) {
+	for _, out := range []string{stdout, stderr} {
+		bz := []byte(strings.TrimSpace(out))
+		if len(bz) > 0 && json.Valid(bz) {
+			return bz, nil
+		}
+	}
+
+	return nil, fmt.Errorf("command did not output a valid genesis: %s", stderr)
+}
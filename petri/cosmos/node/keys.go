@@ -28,19 +28,31 @@ func (n *Node) CreateWallet(ctx context.Context, name string, walletConfig types
 	return keyWallet, nil
 }
 
-// RecoverWallet recovers a wallet on the node using a mnemonic
+// RecoverKey recovers a key on the node using a mnemonic. A key with the same name is replaced, so that recovering a
+// key can be retried and a retried launch recovering a newly generated wallet doesn't keep the previous one
 func (n *Node) RecoverKey(ctx context.Context, name, mnemonic string) error {
 	n.logger.Info("recovering wallet", zap.String("name", name), zap.String("mnemonic", mnemonic))
 
+	chainConfig := n.GetChainConfig()
+	keyringFlags := fmt.Sprintf("--keyring-backend %s --home %s", keyring.BackendTest, chainConfig.HomeDir)
+
 	command := []string{
 		"sh",
 		"-c",
-		fmt.Sprintf(`echo %q | %s keys add %s --recover --keyring-backend %s --coin-type %s --home %s --output json`, mnemonic, n.GetChainConfig().BinaryName, name, keyring.BackendTest, n.GetChainConfig().CoinType, n.GetChainConfig().HomeDir),
+		fmt.Sprintf(`%[1]s keys delete %[2]s --yes %[3]s >/dev/null 2>&1; echo %[4]q | %[1]s keys add %[2]s --recover %[3]s --coin-type %[5]s --output json`,
+			chainConfig.BinaryName, name, keyringFlags, mnemonic, chainConfig.CoinType),
 	}
 
 	stdout, stderr, exitCode, err := n.RunCommand(ctx, command)
 	n.logger.Debug("RecoverKey", zap.String("name", name), zap.String("stdout", stdout),
 		zap.String("stderr", stderr), zap.Any("exitCode", exitCode))
+	if err != nil {
+		return err
+	}
+
+	if exitCode != 0 {
+		return fmt.Errorf("failed to recover key %s (exit code %d): %s", name, exitCode, stderr)
+	}
 
-	return err
+	return nil
 }
@@ -4,8 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"net"
 	"net/http"
+	"path"
 	"strings"
 	"time"
 
@@ -67,10 +69,15 @@ func CreateNode(ctx context.Context, logger *zap.Logger, infraProvider provider.
 		entrypoint = chainConfig.Entrypoint
 	}
 
+	ports := append([]string{"9464", "9090", "26656", "26657", "26660", "1317"}, chainConfig.AdditionalPorts...)
+	if nodeConfig.Role == petritypes.ValidatorRole && chainConfig.RemoteSigner != nil {
+		ports = append(ports, petritypes.PrivValidatorPort)
+	}
+
 	def := provider.TaskDefinition{
 		Name:  nodeConfig.Name,
 		Image: chainConfig.Image,
-		Ports: append([]string{"9464", "9090", "26656", "26657", "26660", "1317"}, chainConfig.AdditionalPorts...),
+		Ports: ports,
 		Entrypoint: append(
 			append(entrypoint, "--home", chainConfig.HomeDir, "start"),
 			chainConfig.AdditionalStartFlags...),
@@ -81,6 +88,10 @@ func CreateNode(ctx context.Context, logger *zap.Logger, infraProvider provider.
 		},
 	}
 
+	if chainConfig.Instrumented {
+		maps.Copy(def.Environment, petritypes.InstrumentationEnvironment(chainConfig.HomeDir))
+	}
+
 	if opts.NodeDefinitionModifier != nil {
 		def = opts.NodeDefinitionModifier(def, nodeConfig)
 	}
@@ -339,6 +350,12 @@ func (n *Node) SetupNode(ctx context.Context) error {
 	initCmd := n.BinCommand([]string{"init", n.GetDefinition().Name, "--chain-id", n.GetChainConfig().ChainId}...)
 	script := strings.Join(initCmd, " ")
 
+	// the coverage and race report directories have to exist before the binary first runs
+	if chainConfig := n.GetChainConfig(); chainConfig.Instrumented {
+		script = fmt.Sprintf("mkdir -p %s %s && %s", path.Join(chainConfig.HomeDir, petritypes.CoverageDir),
+			path.Join(chainConfig.HomeDir, petritypes.RaceReportDir), script)
+	}
+
 	stdout, stderr, exitCode, err := n.RunCommand(ctx, []string{"/bin/sh", "-c", script})
 	if err != nil {
 		return fmt.Errorf("failed to setup node: %w", err)
@@ -95,6 +95,33 @@ func (w *Workflow) LoadTestSpecJSON() ([]byte, error) {
 	return w.LoadTestSpec, nil
 }
 
+// BuildLogChunk is a batch of BuildKit output of one of a workflow's image builds
+type BuildLogChunk struct {
+	ID         int64     `json:"id" db:"id"`
+	WorkflowID string    `json:"workflow_id" db:"workflow_id"`
+	Build      string    `json:"build" db:"build"`
+	Content    string    `json:"content" db:"content"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+}
+
+// WorkflowArtifact is a file collected from a workflow's testnet, e.g. a coverage profile or a race report
+type WorkflowArtifact struct {
+	ID         int64     `json:"id" db:"id"`
+	WorkflowID string    `json:"workflow_id" db:"workflow_id"`
+	Name       string    `json:"name" db:"name"`
+	Content    []byte    `json:"content" db:"content"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+}
+
+// ProviderState is the latest compressed provider state of a workflow's testnet. State is empty once the provider
+// was torn down
+type ProviderState struct {
+	WorkflowID string              `json:"workflow_id" db:"workflow_id"`
+	RunnerType messages.RunnerType `json:"runner_type" db:"runner_type"`
+	State      []byte              `json:"state" db:"state"`
+	UpdatedAt  time.Time           `json:"updated_at" db:"updated_at"`
+}
+
 // Workflow template for pre-configured workflows
 type WorkflowTemplate struct {
 	ID          string                          `json:"template_id" db:"template_id"`
@@ -34,6 +34,15 @@ type DB interface {
 
 	ListTemplateWorkflows(templateID string, limit, offset int) ([]Workflow, error)
 
+	AppendBuildLogs(chunk *BuildLogChunk) error
+	ListBuildLogs(workflowID string, afterID int64, limit int) ([]BuildLogChunk, error)
+
+	SaveWorkflowArtifact(artifact *WorkflowArtifact) error
+	ListWorkflowArtifacts(workflowID string) ([]WorkflowArtifact, error)
+
+	SaveProviderState(state *ProviderState) error
+	GetProviderState(workflowID string) (*ProviderState, error)
+
 	Ping() error
 	Close() error
 }
@@ -721,3 +730,148 @@ func (s *SQLiteDB) ListTemplateWorkflows(templateID string, limit, offset int) (
 
 	return
 }
+
+func (s *SQLiteDB) AppendBuildLogs(chunk *BuildLogChunk) error {
+	now := time.Now()
+	query := `
+		INSERT INTO build_logs (workflow_id, build, content, created_at)
+		VALUES (?, ?, ?, ?)
+		RETURNING id`
+
+	err := s.db.QueryRow(query, chunk.WorkflowID, chunk.Build, chunk.Content, now).Scan(&chunk.ID)
+	if err != nil {
+		return fmt.Errorf("failed to append build logs: %w", err)
+	}
+
+	chunk.CreatedAt = now
+
+	return nil
+}
+
+// ListBuildLogs returns the build log chunks of a workflow with an ID greater than afterID, oldest first
+func (s *SQLiteDB) ListBuildLogs(workflowID string, afterID int64, limit int) (chunks []BuildLogChunk, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	query := `
+		SELECT id, workflow_id, build, content, created_at
+		FROM build_logs
+		WHERE workflow_id = ? AND id > ?
+		ORDER BY id ASC
+		LIMIT ?`
+
+	rows, err := s.db.QueryContext(ctx, query, workflowID, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list build logs: %w", err)
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			s.logger.Error("failed to close rows", zap.Error(closeErr))
+		}
+	}()
+
+	for rows.Next() {
+		var chunk BuildLogChunk
+		if err := rows.Scan(&chunk.ID, &chunk.WorkflowID, &chunk.Build, &chunk.Content, &chunk.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan build logs: %w", err)
+		}
+		chunks = append(chunks, chunk)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return
+}
+
+// SaveWorkflowArtifact stores an artifact of a workflow, replacing an artifact with the same name
+func (s *SQLiteDB) SaveWorkflowArtifact(artifact *WorkflowArtifact) error {
+	now := time.Now()
+	query := `
+		INSERT INTO workflow_artifacts (workflow_id, name, content, created_at)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT (workflow_id, name) DO UPDATE SET content = excluded.content, created_at = excluded.created_at
+		RETURNING id`
+
+	err := s.db.QueryRow(query, artifact.WorkflowID, artifact.Name, artifact.Content, now).Scan(&artifact.ID)
+	if err != nil {
+		return fmt.Errorf("failed to save workflow artifact: %w", err)
+	}
+
+	artifact.CreatedAt = now
+
+	return nil
+}
+
+func (s *SQLiteDB) ListWorkflowArtifacts(workflowID string) (artifacts []WorkflowArtifact, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	query := `
+		SELECT id, workflow_id, name, content, created_at
+		FROM workflow_artifacts
+		WHERE workflow_id = ?
+		ORDER BY name ASC`
+
+	rows, err := s.db.QueryContext(ctx, query, workflowID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workflow artifacts: %w", err)
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			s.logger.Error("failed to close rows", zap.Error(closeErr))
+		}
+	}()
+
+	for rows.Next() {
+		var artifact WorkflowArtifact
+		err := rows.Scan(&artifact.ID, &artifact.WorkflowID, &artifact.Name, &artifact.Content, &artifact.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan workflow artifact: %w", err)
+		}
+		artifacts = append(artifacts, artifact)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rows: %w", err)
+	}
+
+	return
+}
+
+// SaveProviderState stores the provider state of a workflow, replacing the previous one
+func (s *SQLiteDB) SaveProviderState(state *ProviderState) error {
+	now := time.Now()
+	query := `
+		INSERT INTO provider_states (workflow_id, runner_type, state, updated_at)
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT (workflow_id) DO UPDATE SET
+			runner_type = excluded.runner_type, state = excluded.state, updated_at = excluded.updated_at`
+
+	if _, err := s.db.Exec(query, state.WorkflowID, state.RunnerType, state.State, now); err != nil {
+		return fmt.Errorf("failed to save provider state: %w", err)
+	}
+
+	state.UpdatedAt = now
+
+	return nil
+}
+
+func (s *SQLiteDB) GetProviderState(workflowID string) (*ProviderState, error) {
+	query := `
+		SELECT workflow_id, runner_type, state, updated_at
+		FROM provider_states
+		WHERE workflow_id = ?`
+
+	var state ProviderState
+	err := s.db.QueryRow(query, workflowID).Scan(&state.WorkflowID, &state.RunnerType, &state.State, &state.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("provider state not found: %s", workflowID)
+		}
+		return nil, fmt.Errorf("failed to get provider state: %w", err)
+	}
+
+	return &state, nil
+}
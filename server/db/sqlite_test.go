@@ -100,3 +100,115 @@ func TestSQLiteDB_Interface(t *testing.T) {
 	err = dbInterface.Ping()
 	require.NoError(t, err)
 }
+
+func TestSQLiteDB_BuildLogs(t *testing.T) {
+	dbPath := "/tmp/test_build_logs.db"
+	defer os.Remove(dbPath)
+
+	logger, _ := zap.NewDevelopment()
+	db, err := NewSQLiteDB(dbPath, logger)
+	require.NoError(t, err)
+	defer db.Close()
+
+	err = db.RunMigrations("../../migrations")
+	require.NoError(t, err)
+
+	for _, content := range []string{"#1 [internal] load build definition\n", "#2 [builder 1/4] RUN make build\n"} {
+		chunk := &BuildLogChunk{WorkflowID: "test-workflow-logs", Build: "simapp-v50", Content: content}
+		require.NoError(t, db.AppendBuildLogs(chunk))
+		assert.NotZero(t, chunk.ID)
+	}
+	require.NoError(t, db.AppendBuildLogs(&BuildLogChunk{WorkflowID: "other-workflow", Build: "simapp-v50", Content: "other"}))
+
+	chunks, err := db.ListBuildLogs("test-workflow-logs", 0, 10)
+	require.NoError(t, err)
+	require.Len(t, chunks, 2)
+	assert.Equal(t, "#1 [internal] load build definition\n", chunks[0].Content)
+	assert.Equal(t, "simapp-v50", chunks[1].Build)
+
+	chunks, err = db.ListBuildLogs("test-workflow-logs", chunks[0].ID, 10)
+	require.NoError(t, err)
+	require.Len(t, chunks, 1)
+	assert.Equal(t, "#2 [builder 1/4] RUN make build\n", chunks[0].Content)
+
+	err = db.CreateWorkflow(&Workflow{
+		WorkflowID:   "test-workflow-logs",
+		Config:       messages.TestnetWorkflowRequest{},
+		LoadTestSpec: json.RawMessage("{}"),
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.DeleteWorkflow("test-workflow-logs"))
+
+	chunks, err = db.ListBuildLogs("test-workflow-logs", 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, chunks)
+}
+
+func TestSQLiteDB_WorkflowArtifacts(t *testing.T) {
+	dbPath := "/tmp/test_workflow_artifacts.db"
+	defer os.Remove(dbPath)
+
+	logger, _ := zap.NewDevelopment()
+	db, err := NewSQLiteDB(dbPath, logger)
+	require.NoError(t, err)
+	defer db.Close()
+
+	err = db.RunMigrations("../../migrations")
+	require.NoError(t, err)
+
+	require.NoError(t, db.SaveWorkflowArtifact(&WorkflowArtifact{
+		WorkflowID: "test-workflow-artifacts", Name: "coverage.out", Content: []byte("mode: set\n"),
+	}))
+	require.NoError(t, db.SaveWorkflowArtifact(&WorkflowArtifact{
+		WorkflowID: "test-workflow-artifacts", Name: "race/validator-0/report.1", Content: []byte("WARNING: DATA RACE"),
+	}))
+	// artifacts with the same name replace each other
+	require.NoError(t, db.SaveWorkflowArtifact(&WorkflowArtifact{
+		WorkflowID: "test-workflow-artifacts", Name: "coverage.out", Content: []byte("mode: atomic\n"),
+	}))
+
+	artifacts, err := db.ListWorkflowArtifacts("test-workflow-artifacts")
+	require.NoError(t, err)
+	require.Len(t, artifacts, 2)
+	assert.Equal(t, "coverage.out", artifacts[0].Name)
+	assert.Equal(t, []byte("mode: atomic\n"), artifacts[0].Content)
+	assert.Equal(t, "race/validator-0/report.1", artifacts[1].Name)
+}
+
+func TestSQLiteDB_ProviderState(t *testing.T) {
+	dbPath := "/tmp/test_provider_state.db"
+	defer os.Remove(dbPath)
+
+	logger, _ := zap.NewDevelopment()
+	db, err := NewSQLiteDB(dbPath, logger)
+	require.NoError(t, err)
+	defer db.Close()
+
+	err = db.RunMigrations("../../migrations")
+	require.NoError(t, err)
+
+	_, err = db.GetProviderState("test-workflow-provider")
+	require.Error(t, err)
+
+	require.NoError(t, db.SaveProviderState(&ProviderState{
+		WorkflowID: "test-workflow-provider", RunnerType: messages.DigitalOcean, State: []byte("created"),
+	}))
+	// later states replace earlier ones
+	require.NoError(t, db.SaveProviderState(&ProviderState{
+		WorkflowID: "test-workflow-provider", RunnerType: messages.DigitalOcean, State: []byte("launched"),
+	}))
+
+	state, err := db.GetProviderState("test-workflow-provider")
+	require.NoError(t, err)
+	assert.Equal(t, messages.DigitalOcean, state.RunnerType)
+	assert.Equal(t, []byte("launched"), state.State)
+
+	// the state is cleared once the provider was torn down
+	require.NoError(t, db.SaveProviderState(&ProviderState{
+		WorkflowID: "test-workflow-provider", RunnerType: messages.DigitalOcean,
+	}))
+
+	state, err = db.GetProviderState("test-workflow-provider")
+	require.NoError(t, err)
+	assert.Empty(t, state.State)
+}
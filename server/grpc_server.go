@@ -21,6 +21,9 @@ import (
 	"google.golang.org/grpc/reflection"
 )
 
+// maxRecvMsgSize is the largest message the server accepts
+const maxRecvMsgSize = 64 << 20
+
 type GRPCServer struct {
 	temporalClient  temporalclient.Client
 	db              db.DB
@@ -44,7 +47,8 @@ func NewGRPCServer(config types.TemporalConfig, database db.DB, logger *zap.Logg
 		return nil, fmt.Errorf("failed to create temporal client: %w", err)
 	}
 
-	grpcServer := grpc.NewServer()
+	// workers upload collected artifacts like coverage profiles, which exceed the default 4MB limit
+	grpcServer := grpc.NewServer(grpc.MaxRecvMsgSize(maxRecvMsgSize))
 	logger.Info("Creating new workflow service", zap.Any("temporal_config", config))
 	workflowService := workflow.NewService(database, logger, temporalClient)
 
@@ -43,8 +43,27 @@ type CreateWorkflowRequest struct {
 	// Optional: provider specific configuration.
 	// Each key overrides the default value for the provider.
 	ProviderConfig map[string]string `protobuf:"bytes,16,rep,name=provider_config,json=providerConfig,proto3" json:"provider_config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Optional: export-and-restart the testnet from its exported genesis.
+	GenesisMigration *GenesisMigration `protobuf:"bytes,17,opt,name=genesis_migration,json=genesisMigration,proto3" json:"genesis_migration,omitempty"`
+	// Optional: start the testnet from a custom genesis instead of a generated one.
+	CustomGenesis *CustomGenesis `protobuf:"bytes,18,opt,name=custom_genesis,json=customGenesis,proto3" json:"custom_genesis,omitempty"`
+	// Optional: chains launched next to the primary chain.
+	AdditionalChains []*AdditionalChain `protobuf:"bytes,19,rep,name=additional_chains,json=additionalChains,proto3" json:"additional_chains,omitempty"`
+	// Optional: IBC relayer connecting the primary chain to every additional chain.
+	Relayer *Relayer `protobuf:"bytes,20,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	// Optional: faults injected into the chain while the testnet is running.
+	Faults []*Fault `protobuf:"bytes,21,rep,name=faults,proto3" json:"faults,omitempty"`
+	// Optional: go.mod replacements applied when building the chain image.
+	GoModReplaces []*GoModReplace `protobuf:"bytes,22,rep,name=go_mod_replaces,json=goModReplaces,proto3" json:"go_mod_replaces,omitempty"`
+	// Optional: fork, patch or source tarball the chain is built from.
+	Source *ChainSource `protobuf:"bytes,23,opt,name=source,proto3" json:"source,omitempty"`
+	// Optional: build the chain binary with -cover ("cover") or -race ("race") and collect the coverage profile or
+	// data race reports of every node at teardown.
+	BuildVariant string `protobuf:"bytes,24,opt,name=build_variant,json=buildVariant,proto3" json:"build_variant,omitempty"`
+	// Optional: health checks, upstream selection and limits of the load balancer, requires launch_load_balancer.
+	LoadBalancer  *LoadBalancerSpec `protobuf:"bytes,25,opt,name=load_balancer,json=loadBalancer,proto3" json:"load_balancer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateWorkflowRequest) Reset() {
@@ -182,82 +201,99 @@ func (x *CreateWorkflowRequest) GetProviderConfig() map[string]string {
 	return nil
 }
 
-type GenesisKV struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CreateWorkflowRequest) GetGenesisMigration() *GenesisMigration {
+	if x != nil {
+		return x.GenesisMigration
+	}
+	return nil
 }
 
-func (x *GenesisKV) Reset() {
-	*x = GenesisKV{}
-	mi := &file_server_proto_ironbird_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CreateWorkflowRequest) GetCustomGenesis() *CustomGenesis {
+	if x != nil {
+		return x.CustomGenesis
+	}
+	return nil
 }
 
-func (x *GenesisKV) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateWorkflowRequest) GetAdditionalChains() []*AdditionalChain {
+	if x != nil {
+		return x.AdditionalChains
+	}
+	return nil
 }
 
-func (*GenesisKV) ProtoMessage() {}
+func (x *CreateWorkflowRequest) GetRelayer() *Relayer {
+	if x != nil {
+		return x.Relayer
+	}
+	return nil
+}
 
-func (x *GenesisKV) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_ironbird_proto_msgTypes[1]
+func (x *CreateWorkflowRequest) GetFaults() []*Fault {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Faults
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GenesisKV.ProtoReflect.Descriptor instead.
-func (*GenesisKV) Descriptor() ([]byte, []int) {
-	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{1}
+func (x *CreateWorkflowRequest) GetGoModReplaces() []*GoModReplace {
+	if x != nil {
+		return x.GoModReplaces
+	}
+	return nil
 }
 
-func (x *GenesisKV) GetKey() string {
+func (x *CreateWorkflowRequest) GetSource() *ChainSource {
 	if x != nil {
-		return x.Key
+		return x.Source
 	}
-	return ""
+	return nil
 }
 
-func (x *GenesisKV) GetValue() string {
+func (x *CreateWorkflowRequest) GetBuildVariant() string {
 	if x != nil {
-		return x.Value
+		return x.BuildVariant
 	}
 	return ""
 }
 
-type RegionConfig struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	NumOfNodes      uint64                 `protobuf:"varint,2,opt,name=num_of_nodes,json=numOfNodes,proto3" json:"num_of_nodes,omitempty"`
-	NumOfValidators uint64                 `protobuf:"varint,3,opt,name=num_of_validators,json=numOfValidators,proto3" json:"num_of_validators,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *CreateWorkflowRequest) GetLoadBalancer() *LoadBalancerSpec {
+	if x != nil {
+		return x.LoadBalancer
+	}
+	return nil
 }
 
-func (x *RegionConfig) Reset() {
-	*x = RegionConfig{}
-	mi := &file_server_proto_ironbird_proto_msgTypes[2]
+// LoadBalancerOptions configure how a load balancer domain routes traffic to its nodes.
+type LoadBalancerOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// take nodes out of rotation while they catch up (http) or their gRPC server doesn't answer (grpc)
+	HealthCheck bool `protobuf:"varint,1,opt,name=health_check,json=healthCheck,proto3" json:"health_check,omitempty"`
+	// Caddy lb_policy, e.g. round_robin or least_conn
+	Policy string `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	// requests per second per client IP, requires a load balancer image with the caddy-ratelimit module
+	RateLimit int32 `protobuf:"varint,3,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	// e.g. 1MB
+	MaxRequestBodySize string `protobuf:"bytes,4,opt,name=max_request_body_size,json=maxRequestBodySize,proto3" json:"max_request_body_size,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *LoadBalancerOptions) Reset() {
+	*x = LoadBalancerOptions{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegionConfig) String() string {
+func (x *LoadBalancerOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegionConfig) ProtoMessage() {}
+func (*LoadBalancerOptions) ProtoMessage() {}
 
-func (x *RegionConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_ironbird_proto_msgTypes[2]
+func (x *LoadBalancerOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_ironbird_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -268,65 +304,63 @@ func (x *RegionConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegionConfig.ProtoReflect.Descriptor instead.
-func (*RegionConfig) Descriptor() ([]byte, []int) {
-	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use LoadBalancerOptions.ProtoReflect.Descriptor instead.
+func (*LoadBalancerOptions) Descriptor() ([]byte, []int) {
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *RegionConfig) GetName() string {
+func (x *LoadBalancerOptions) GetHealthCheck() bool {
 	if x != nil {
-		return x.Name
+		return x.HealthCheck
+	}
+	return false
+}
+
+func (x *LoadBalancerOptions) GetPolicy() string {
+	if x != nil {
+		return x.Policy
 	}
 	return ""
 }
 
-func (x *RegionConfig) GetNumOfNodes() uint64 {
+func (x *LoadBalancerOptions) GetRateLimit() int32 {
 	if x != nil {
-		return x.NumOfNodes
+		return x.RateLimit
 	}
 	return 0
 }
 
-func (x *RegionConfig) GetNumOfValidators() uint64 {
+func (x *LoadBalancerOptions) GetMaxRequestBodySize() string {
 	if x != nil {
-		return x.NumOfValidators
+		return x.MaxRequestBodySize
 	}
-	return 0
+	return ""
 }
 
-type ChainConfig struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	Name                  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	NumOfNodes            uint64                 `protobuf:"varint,2,opt,name=num_of_nodes,json=numOfNodes,proto3" json:"num_of_nodes,omitempty"`
-	NumOfValidators       uint64                 `protobuf:"varint,3,opt,name=num_of_validators,json=numOfValidators,proto3" json:"num_of_validators,omitempty"`
-	GenesisModifications  []*GenesisKV           `protobuf:"bytes,4,rep,name=genesis_modifications,json=genesisModifications,proto3" json:"genesis_modifications,omitempty"`
-	Image                 string                 `protobuf:"bytes,5,opt,name=image,proto3" json:"image,omitempty"`
-	CustomAppConfig       string                 `protobuf:"bytes,6,opt,name=custom_app_config,json=customAppConfig,proto3" json:"custom_app_config,omitempty"`
-	CustomConsensusConfig string                 `protobuf:"bytes,7,opt,name=custom_consensus_config,json=customConsensusConfig,proto3" json:"custom_consensus_config,omitempty"`
-	CustomClientConfig    string                 `protobuf:"bytes,8,opt,name=custom_client_config,json=customClientConfig,proto3" json:"custom_client_config,omitempty"`
-	SetSeedNode           bool                   `protobuf:"varint,9,opt,name=set_seed_node,json=setSeedNode,proto3" json:"set_seed_node,omitempty"`
-	SetPersistentPeers    bool                   `protobuf:"varint,10,opt,name=set_persistent_peers,json=setPersistentPeers,proto3" json:"set_persistent_peers,omitempty"`
-	RegionConfigs         []*RegionConfig        `protobuf:"bytes,11,rep,name=region_configs,json=regionConfigs,proto3" json:"region_configs,omitempty"`
-	Version               string                 `protobuf:"bytes,12,opt,name=version,proto3" json:"version,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type LoadBalancerDomainOptions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// rpc, lcd, grpc, evmrpc or evmws
+	DomainType    string               `protobuf:"bytes,1,opt,name=domain_type,json=domainType,proto3" json:"domain_type,omitempty"`
+	Options       *LoadBalancerOptions `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChainConfig) Reset() {
-	*x = ChainConfig{}
-	mi := &file_server_proto_ironbird_proto_msgTypes[3]
+func (x *LoadBalancerDomainOptions) Reset() {
+	*x = LoadBalancerDomainOptions{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChainConfig) String() string {
+func (x *LoadBalancerDomainOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChainConfig) ProtoMessage() {}
+func (*LoadBalancerDomainOptions) ProtoMessage() {}
 
-func (x *ChainConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_ironbird_proto_msgTypes[3]
+func (x *LoadBalancerDomainOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_ironbird_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -337,116 +371,116 @@ func (x *ChainConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChainConfig.ProtoReflect.Descriptor instead.
-func (*ChainConfig) Descriptor() ([]byte, []int) {
-	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use LoadBalancerDomainOptions.ProtoReflect.Descriptor instead.
+func (*LoadBalancerDomainOptions) Descriptor() ([]byte, []int) {
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *ChainConfig) GetName() string {
+func (x *LoadBalancerDomainOptions) GetDomainType() string {
 	if x != nil {
-		return x.Name
+		return x.DomainType
 	}
 	return ""
 }
 
-func (x *ChainConfig) GetNumOfNodes() uint64 {
-	if x != nil {
-		return x.NumOfNodes
-	}
-	return 0
-}
-
-func (x *ChainConfig) GetNumOfValidators() uint64 {
-	if x != nil {
-		return x.NumOfValidators
-	}
-	return 0
-}
-
-func (x *ChainConfig) GetGenesisModifications() []*GenesisKV {
+func (x *LoadBalancerDomainOptions) GetOptions() *LoadBalancerOptions {
 	if x != nil {
-		return x.GenesisModifications
+		return x.Options
 	}
 	return nil
 }
 
-func (x *ChainConfig) GetImage() string {
-	if x != nil {
-		return x.Image
-	}
-	return ""
+type LoadBalancerSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// options of every domain without domain_options
+	Options       *LoadBalancerOptions         `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	DomainOptions []*LoadBalancerDomainOptions `protobuf:"bytes,2,rep,name=domain_options,json=domainOptions,proto3" json:"domain_options,omitempty"`
+	// only route traffic to full nodes
+	ExcludeValidators bool `protobuf:"varint,3,opt,name=exclude_validators,json=excludeValidators,proto3" json:"exclude_validators,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *ChainConfig) GetCustomAppConfig() string {
-	if x != nil {
-		return x.CustomAppConfig
-	}
-	return ""
+func (x *LoadBalancerSpec) Reset() {
+	*x = LoadBalancerSpec{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChainConfig) GetCustomConsensusConfig() string {
-	if x != nil {
-		return x.CustomConsensusConfig
-	}
-	return ""
+func (x *LoadBalancerSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ChainConfig) GetCustomClientConfig() string {
+func (*LoadBalancerSpec) ProtoMessage() {}
+
+func (x *LoadBalancerSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_ironbird_proto_msgTypes[3]
 	if x != nil {
-		return x.CustomClientConfig
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ChainConfig) GetSetSeedNode() bool {
-	if x != nil {
-		return x.SetSeedNode
-	}
-	return false
+// Deprecated: Use LoadBalancerSpec.ProtoReflect.Descriptor instead.
+func (*LoadBalancerSpec) Descriptor() ([]byte, []int) {
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ChainConfig) GetSetPersistentPeers() bool {
+func (x *LoadBalancerSpec) GetOptions() *LoadBalancerOptions {
 	if x != nil {
-		return x.SetPersistentPeers
+		return x.Options
 	}
-	return false
+	return nil
 }
 
-func (x *ChainConfig) GetRegionConfigs() []*RegionConfig {
+func (x *LoadBalancerSpec) GetDomainOptions() []*LoadBalancerDomainOptions {
 	if x != nil {
-		return x.RegionConfigs
+		return x.DomainOptions
 	}
 	return nil
 }
 
-func (x *ChainConfig) GetVersion() string {
+func (x *LoadBalancerSpec) GetExcludeValidators() bool {
 	if x != nil {
-		return x.Version
+		return x.ExcludeValidators
 	}
-	return ""
+	return false
 }
 
-type GetWorkflowRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	WorkflowId    string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+// ChainSource builds the chain from a different git remote and/or with local changes applied on top of the sha.
+type ChainSource struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// https url of the repository, e.g. a fork.
+	GitUrl string `protobuf:"bytes,1,opt,name=git_url,json=gitUrl,proto3" json:"git_url,omitempty"`
+	// Optional: name of the builder's auth_env_configs entry holding the token git_url is cloned with.
+	GitAuth string `protobuf:"bytes,2,opt,name=git_auth,json=gitAuth,proto3" json:"git_auth,omitempty"`
+	// Optional: git diff applied on top of the sha.
+	Patch []byte `protobuf:"bytes,3,opt,name=patch,proto3" json:"patch,omitempty"`
+	// Optional: gzipped tarball of source files extracted over the checkout of the sha.
+	Tarball       []byte `protobuf:"bytes,4,opt,name=tarball,proto3" json:"tarball,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetWorkflowRequest) Reset() {
-	*x = GetWorkflowRequest{}
+func (x *ChainSource) Reset() {
+	*x = ChainSource{}
 	mi := &file_server_proto_ironbird_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWorkflowRequest) String() string {
+func (x *ChainSource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWorkflowRequest) ProtoMessage() {}
+func (*ChainSource) ProtoMessage() {}
 
-func (x *GetWorkflowRequest) ProtoReflect() protoreflect.Message {
+func (x *ChainSource) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_ironbird_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -458,40 +492,63 @@ func (x *GetWorkflowRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWorkflowRequest.ProtoReflect.Descriptor instead.
-func (*GetWorkflowRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChainSource.ProtoReflect.Descriptor instead.
+func (*ChainSource) Descriptor() ([]byte, []int) {
 	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetWorkflowRequest) GetWorkflowId() string {
+func (x *ChainSource) GetGitUrl() string {
 	if x != nil {
-		return x.WorkflowId
+		return x.GitUrl
 	}
 	return ""
 }
 
-type ListWorkflowsRequest struct {
+func (x *ChainSource) GetGitAuth() string {
+	if x != nil {
+		return x.GitAuth
+	}
+	return ""
+}
+
+func (x *ChainSource) GetPatch() []byte {
+	if x != nil {
+		return x.Patch
+	}
+	return nil
+}
+
+func (x *ChainSource) GetTarball() []byte {
+	if x != nil {
+		return x.Tarball
+	}
+	return nil
+}
+
+// GoModReplace replaces a module of the chain's go.mod, e.g. github.com/cosmos/iavl with github.com/cosmos/iavl@v1.2.6
+// or a fork like https://github.com/org/iavl@<sha>.
+type GoModReplace struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Module        string                 `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	Replacement   string                 `protobuf:"bytes,2,opt,name=replacement,proto3" json:"replacement,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListWorkflowsRequest) Reset() {
-	*x = ListWorkflowsRequest{}
+func (x *GoModReplace) Reset() {
+	*x = GoModReplace{}
 	mi := &file_server_proto_ironbird_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListWorkflowsRequest) String() string {
+func (x *GoModReplace) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListWorkflowsRequest) ProtoMessage() {}
+func (*GoModReplace) ProtoMessage() {}
 
-func (x *ListWorkflowsRequest) ProtoReflect() protoreflect.Message {
+func (x *GoModReplace) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_ironbird_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -503,46 +560,53 @@ func (x *ListWorkflowsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListWorkflowsRequest.ProtoReflect.Descriptor instead.
-func (*ListWorkflowsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GoModReplace.ProtoReflect.Descriptor instead.
+func (*GoModReplace) Descriptor() ([]byte, []int) {
 	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ListWorkflowsRequest) GetLimit() int32 {
+func (x *GoModReplace) GetModule() string {
 	if x != nil {
-		return x.Limit
+		return x.Module
 	}
-	return 0
+	return ""
 }
 
-func (x *ListWorkflowsRequest) GetOffset() int32 {
+func (x *GoModReplace) GetReplacement() string {
 	if x != nil {
-		return x.Offset
+		return x.Replacement
 	}
-	return 0
+	return ""
 }
 
-type CancelWorkflowRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	WorkflowId    string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+// Fault is injected into a validator of the chain once the testnet has been running for a delay.
+type Fault struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// type is "double-sign".
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// after is the delay after the testnet launched, e.g. "5m".
+	After     string `protobuf:"bytes,2,opt,name=after,proto3" json:"after,omitempty"`
+	Validator uint32 `protobuf:"varint,3,opt,name=validator,proto3" json:"validator,omitempty"`
+	// Optional: how long to wait for the chain to react to the fault, e.g. "10m".
+	Timeout       string `protobuf:"bytes,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelWorkflowRequest) Reset() {
-	*x = CancelWorkflowRequest{}
+func (x *Fault) Reset() {
+	*x = Fault{}
 	mi := &file_server_proto_ironbird_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelWorkflowRequest) String() string {
+func (x *Fault) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelWorkflowRequest) ProtoMessage() {}
+func (*Fault) ProtoMessage() {}
 
-func (x *CancelWorkflowRequest) ProtoReflect() protoreflect.Message {
+func (x *Fault) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_ironbird_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -554,40 +618,64 @@ func (x *CancelWorkflowRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelWorkflowRequest.ProtoReflect.Descriptor instead.
-func (*CancelWorkflowRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Fault.ProtoReflect.Descriptor instead.
+func (*Fault) Descriptor() ([]byte, []int) {
 	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *CancelWorkflowRequest) GetWorkflowId() string {
+func (x *Fault) GetType() string {
 	if x != nil {
-		return x.WorkflowId
+		return x.Type
 	}
 	return ""
 }
 
-type SignalWorkflowRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	WorkflowId    string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
-	SignalName    string                 `protobuf:"bytes,2,opt,name=signal_name,json=signalName,proto3" json:"signal_name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Fault) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
 }
 
-func (x *SignalWorkflowRequest) Reset() {
-	*x = SignalWorkflowRequest{}
-	mi := &file_server_proto_ironbird_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
+func (x *Fault) GetValidator() uint32 {
+	if x != nil {
+		return x.Validator
+	}
+	return 0
+}
 
-func (x *SignalWorkflowRequest) String() string {
+func (x *Fault) GetTimeout() string {
+	if x != nil {
+		return x.Timeout
+	}
+	return ""
+}
+
+type AdditionalChain struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Repo  string                 `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// Optional: defaults to the sha of the workflow.
+	Sha           string       `protobuf:"bytes,2,opt,name=sha,proto3" json:"sha,omitempty"`
+	IsEvmChain    bool         `protobuf:"varint,3,opt,name=isEvmChain,proto3" json:"isEvmChain,omitempty"`
+	ChainConfig   *ChainConfig `protobuf:"bytes,4,opt,name=chain_config,json=chainConfig,proto3" json:"chain_config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdditionalChain) Reset() {
+	*x = AdditionalChain{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdditionalChain) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignalWorkflowRequest) ProtoMessage() {}
+func (*AdditionalChain) ProtoMessage() {}
 
-func (x *SignalWorkflowRequest) ProtoReflect() protoreflect.Message {
+func (x *AdditionalChain) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_ironbird_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -599,47 +687,63 @@ func (x *SignalWorkflowRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignalWorkflowRequest.ProtoReflect.Descriptor instead.
-func (*SignalWorkflowRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdditionalChain.ProtoReflect.Descriptor instead.
+func (*AdditionalChain) Descriptor() ([]byte, []int) {
 	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *SignalWorkflowRequest) GetWorkflowId() string {
+func (x *AdditionalChain) GetRepo() string {
 	if x != nil {
-		return x.WorkflowId
+		return x.Repo
 	}
 	return ""
 }
 
-func (x *SignalWorkflowRequest) GetSignalName() string {
+func (x *AdditionalChain) GetSha() string {
 	if x != nil {
-		return x.SignalName
+		return x.Sha
 	}
 	return ""
 }
 
-type RunLoadTestRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	WorkflowId    string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
-	LoadTestSpec  string                 `protobuf:"bytes,2,opt,name=load_test_spec,json=loadTestSpec,proto3" json:"load_test_spec,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *AdditionalChain) GetIsEvmChain() bool {
+	if x != nil {
+		return x.IsEvmChain
+	}
+	return false
 }
 
-func (x *RunLoadTestRequest) Reset() {
-	*x = RunLoadTestRequest{}
+func (x *AdditionalChain) GetChainConfig() *ChainConfig {
+	if x != nil {
+		return x.ChainConfig
+	}
+	return nil
+}
+
+type Relayer struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional: Hermes image.
+	Image string `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	// Optional: send IBC transfers over every channel.
+	IbcTransferLoad *IBCTransferLoad `protobuf:"bytes,2,opt,name=ibc_transfer_load,json=ibcTransferLoad,proto3" json:"ibc_transfer_load,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Relayer) Reset() {
+	*x = Relayer{}
 	mi := &file_server_proto_ironbird_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RunLoadTestRequest) String() string {
+func (x *Relayer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RunLoadTestRequest) ProtoMessage() {}
+func (*Relayer) ProtoMessage() {}
 
-func (x *RunLoadTestRequest) ProtoReflect() protoreflect.Message {
+func (x *Relayer) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_ironbird_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -651,46 +755,48 @@ func (x *RunLoadTestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RunLoadTestRequest.ProtoReflect.Descriptor instead.
-func (*RunLoadTestRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Relayer.ProtoReflect.Descriptor instead.
+func (*Relayer) Descriptor() ([]byte, []int) {
 	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *RunLoadTestRequest) GetWorkflowId() string {
+func (x *Relayer) GetImage() string {
 	if x != nil {
-		return x.WorkflowId
+		return x.Image
 	}
 	return ""
 }
 
-func (x *RunLoadTestRequest) GetLoadTestSpec() string {
+func (x *Relayer) GetIbcTransferLoad() *IBCTransferLoad {
 	if x != nil {
-		return x.LoadTestSpec
+		return x.IbcTransferLoad
 	}
-	return ""
+	return nil
 }
 
-type WorkflowResponse struct {
+type IBCTransferLoad struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	WorkflowId    string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	Duration      string                 `protobuf:"bytes,1,opt,name=duration,proto3" json:"duration,omitempty"`
+	Amount        uint64                 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	MsgsPerTx     int32                  `protobuf:"varint,3,opt,name=msgs_per_tx,json=msgsPerTx,proto3" json:"msgs_per_tx,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WorkflowResponse) Reset() {
-	*x = WorkflowResponse{}
+func (x *IBCTransferLoad) Reset() {
+	*x = IBCTransferLoad{}
 	mi := &file_server_proto_ironbird_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkflowResponse) String() string {
+func (x *IBCTransferLoad) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkflowResponse) ProtoMessage() {}
+func (*IBCTransferLoad) ProtoMessage() {}
 
-func (x *WorkflowResponse) ProtoReflect() protoreflect.Message {
+func (x *IBCTransferLoad) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_ironbird_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -702,45 +808,61 @@ func (x *WorkflowResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkflowResponse.ProtoReflect.Descriptor instead.
-func (*WorkflowResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use IBCTransferLoad.ProtoReflect.Descriptor instead.
+func (*IBCTransferLoad) Descriptor() ([]byte, []int) {
 	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *WorkflowResponse) GetWorkflowId() string {
+func (x *IBCTransferLoad) GetDuration() string {
 	if x != nil {
-		return x.WorkflowId
+		return x.Duration
 	}
 	return ""
 }
 
-type Node struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Rpc           string                 `protobuf:"bytes,3,opt,name=rpc,proto3" json:"rpc,omitempty"`
-	Lcd           string                 `protobuf:"bytes,4,opt,name=lcd,proto3" json:"lcd,omitempty"`
-	Grpc          string                 `protobuf:"bytes,5,opt,name=grpc,proto3" json:"grpc,omitempty"`
-	Evmrpc        string                 `protobuf:"bytes,6,opt,name=evmrpc,proto3" json:"evmrpc,omitempty"`
-	Evmws         string                 `protobuf:"bytes,7,opt,name=evmws,proto3" json:"evmws,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *IBCTransferLoad) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
 }
 
-func (x *Node) Reset() {
-	*x = Node{}
+func (x *IBCTransferLoad) GetMsgsPerTx() int32 {
+	if x != nil {
+		return x.MsgsPerTx
+	}
+	return 0
+}
+
+type CustomGenesis struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// http(s) URL of the genesis, optionally gzipped.
+	GenesisUrl string `protobuf:"bytes,1,opt,name=genesis_url,json=genesisUrl,proto3" json:"genesis_url,omitempty"`
+	// Optional: expected sha256 digest of the genesis artifact.
+	GenesisSha256 string `protobuf:"bytes,2,opt,name=genesis_sha256,json=genesisSha256,proto3" json:"genesis_sha256,omitempty"`
+	// Optional: URL of a JSON array of priv_validator_key.json files
+	// assigned to the validators in order.
+	ValidatorKeysUrl string `protobuf:"bytes,3,opt,name=validator_keys_url,json=validatorKeysUrl,proto3" json:"validator_keys_url,omitempty"`
+	// Optional: expected sha256 digest of the validator keys artifact.
+	ValidatorKeysSha256 string `protobuf:"bytes,4,opt,name=validator_keys_sha256,json=validatorKeysSha256,proto3" json:"validator_keys_sha256,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CustomGenesis) Reset() {
+	*x = CustomGenesis{}
 	mi := &file_server_proto_ironbird_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Node) String() string {
+func (x *CustomGenesis) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Node) ProtoMessage() {}
+func (*CustomGenesis) ProtoMessage() {}
 
-func (x *Node) ProtoReflect() protoreflect.Message {
+func (x *CustomGenesis) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_ironbird_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -752,84 +874,66 @@ func (x *Node) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Node.ProtoReflect.Descriptor instead.
-func (*Node) Descriptor() ([]byte, []int) {
+// Deprecated: Use CustomGenesis.ProtoReflect.Descriptor instead.
+func (*CustomGenesis) Descriptor() ([]byte, []int) {
 	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Node) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Node) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-func (x *Node) GetRpc() string {
-	if x != nil {
-		return x.Rpc
-	}
-	return ""
-}
-
-func (x *Node) GetLcd() string {
+func (x *CustomGenesis) GetGenesisUrl() string {
 	if x != nil {
-		return x.Lcd
+		return x.GenesisUrl
 	}
 	return ""
 }
 
-func (x *Node) GetGrpc() string {
+func (x *CustomGenesis) GetGenesisSha256() string {
 	if x != nil {
-		return x.Grpc
+		return x.GenesisSha256
 	}
 	return ""
 }
 
-func (x *Node) GetEvmrpc() string {
+func (x *CustomGenesis) GetValidatorKeysUrl() string {
 	if x != nil {
-		return x.Evmrpc
+		return x.ValidatorKeysUrl
 	}
 	return ""
 }
 
-func (x *Node) GetEvmws() string {
+func (x *CustomGenesis) GetValidatorKeysSha256() string {
 	if x != nil {
-		return x.Evmws
+		return x.ValidatorKeysSha256
 	}
 	return ""
 }
 
-type WalletInfo struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	FaucetAddress  string                 `protobuf:"bytes,1,opt,name=faucet_address,json=faucetAddress,proto3" json:"faucet_address,omitempty"`
-	FaucetMnemonic string                 `protobuf:"bytes,2,opt,name=faucet_mnemonic,json=faucetMnemonic,proto3" json:"faucet_mnemonic,omitempty"`
-	UserAddresses  []string               `protobuf:"bytes,3,rep,name=user_addresses,json=userAddresses,proto3" json:"user_addresses,omitempty"`
-	UserMnemonics  []string               `protobuf:"bytes,4,rep,name=user_mnemonics,json=userMnemonics,proto3" json:"user_mnemonics,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type GenesisMigration struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ExportHeight uint64                 `protobuf:"varint,1,opt,name=export_height,json=exportHeight,proto3" json:"export_height,omitempty"`
+	// Optional: SHA to build the image the chain is restarted on.
+	Sha string `protobuf:"bytes,2,opt,name=sha,proto3" json:"sha,omitempty"`
+	// Optional: binary subcommand run against the exported genesis,
+	// e.g. ["genesis", "migrate", "v0.50"].
+	MigrationCommand     []string     `protobuf:"bytes,3,rep,name=migration_command,json=migrationCommand,proto3" json:"migration_command,omitempty"`
+	GenesisModifications []*GenesisKV `protobuf:"bytes,4,rep,name=genesis_modifications,json=genesisModifications,proto3" json:"genesis_modifications,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *WalletInfo) Reset() {
-	*x = WalletInfo{}
+func (x *GenesisMigration) Reset() {
+	*x = GenesisMigration{}
 	mi := &file_server_proto_ironbird_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WalletInfo) String() string {
+func (x *GenesisMigration) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WalletInfo) ProtoMessage() {}
+func (*GenesisMigration) ProtoMessage() {}
 
-func (x *WalletInfo) ProtoReflect() protoreflect.Message {
+func (x *GenesisMigration) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_ironbird_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -841,71 +945,61 @@ func (x *WalletInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WalletInfo.ProtoReflect.Descriptor instead.
-func (*WalletInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use GenesisMigration.ProtoReflect.Descriptor instead.
+func (*GenesisMigration) Descriptor() ([]byte, []int) {
 	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *WalletInfo) GetFaucetAddress() string {
+func (x *GenesisMigration) GetExportHeight() uint64 {
 	if x != nil {
-		return x.FaucetAddress
+		return x.ExportHeight
 	}
-	return ""
+	return 0
 }
 
-func (x *WalletInfo) GetFaucetMnemonic() string {
+func (x *GenesisMigration) GetSha() string {
 	if x != nil {
-		return x.FaucetMnemonic
+		return x.Sha
 	}
 	return ""
 }
 
-func (x *WalletInfo) GetUserAddresses() []string {
+func (x *GenesisMigration) GetMigrationCommand() []string {
 	if x != nil {
-		return x.UserAddresses
+		return x.MigrationCommand
 	}
 	return nil
 }
 
-func (x *WalletInfo) GetUserMnemonics() []string {
+func (x *GenesisMigration) GetGenesisModifications() []*GenesisKV {
 	if x != nil {
-		return x.UserMnemonics
+		return x.GenesisModifications
 	}
 	return nil
 }
 
-type Workflow struct {
+type GenesisKV struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	WorkflowId    string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	Nodes         []*Node                `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
-	Validators    []*Node                `protobuf:"bytes,4,rep,name=validators,proto3" json:"validators,omitempty"`
-	LoadBalancers []*Node                `protobuf:"bytes,5,rep,name=load_balancers,json=loadBalancers,proto3" json:"load_balancers,omitempty"`
-	Monitoring    map[string]string      `protobuf:"bytes,6,rep,name=monitoring,proto3" json:"monitoring,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Config        *CreateWorkflowRequest `protobuf:"bytes,7,opt,name=config,proto3" json:"config,omitempty"`
-	LoadTestSpec  string                 `protobuf:"bytes,17,opt,name=load_test_spec,json=loadTestSpec,proto3" json:"load_test_spec,omitempty"`
-	Wallets       *WalletInfo            `protobuf:"bytes,18,opt,name=wallets,proto3" json:"wallets,omitempty"`
-	Provider      string                 `protobuf:"bytes,19,opt,name=provider,proto3" json:"provider,omitempty"`
-	StartTime     string                 `protobuf:"bytes,20,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime       string                 `protobuf:"bytes,21,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Workflow) Reset() {
-	*x = Workflow{}
+func (x *GenesisKV) Reset() {
+	*x = GenesisKV{}
 	mi := &file_server_proto_ironbird_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Workflow) String() string {
+func (x *GenesisKV) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Workflow) ProtoMessage() {}
+func (*GenesisKV) ProtoMessage() {}
 
-func (x *Workflow) ProtoReflect() protoreflect.Message {
+func (x *GenesisKV) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_ironbird_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -917,124 +1011,2125 @@ func (x *Workflow) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Workflow.ProtoReflect.Descriptor instead.
-func (*Workflow) Descriptor() ([]byte, []int) {
+// Deprecated: Use GenesisKV.ProtoReflect.Descriptor instead.
+func (*GenesisKV) Descriptor() ([]byte, []int) {
 	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *Workflow) GetWorkflowId() string {
+func (x *GenesisKV) GetKey() string {
 	if x != nil {
-		return x.WorkflowId
+		return x.Key
 	}
 	return ""
 }
 
-func (x *Workflow) GetStatus() string {
+func (x *GenesisKV) GetValue() string {
 	if x != nil {
-		return x.Status
+		return x.Value
 	}
 	return ""
 }
 
-func (x *Workflow) GetNodes() []*Node {
+type RegionConfig struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	NumOfNodes      uint64                 `protobuf:"varint,2,opt,name=num_of_nodes,json=numOfNodes,proto3" json:"num_of_nodes,omitempty"`
+	NumOfValidators uint64                 `protobuf:"varint,3,opt,name=num_of_validators,json=numOfValidators,proto3" json:"num_of_validators,omitempty"`
+	// Optional: sha of the repo every validator and node of the region runs.
+	Sha string `protobuf:"bytes,4,opt,name=sha,proto3" json:"sha,omitempty"`
+	// Optional: JSON config overrides applied to every validator and node of the region.
+	CustomAppConfig       string `protobuf:"bytes,5,opt,name=custom_app_config,json=customAppConfig,proto3" json:"custom_app_config,omitempty"`
+	CustomConsensusConfig string `protobuf:"bytes,6,opt,name=custom_consensus_config,json=customConsensusConfig,proto3" json:"custom_consensus_config,omitempty"`
+	CustomClientConfig    string `protobuf:"bytes,7,opt,name=custom_client_config,json=customClientConfig,proto3" json:"custom_client_config,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *RegionConfig) Reset() {
+	*x = RegionConfig{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegionConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegionConfig) ProtoMessage() {}
+
+func (x *RegionConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_ironbird_proto_msgTypes[13]
 	if x != nil {
-		return x.Nodes
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Workflow) GetValidators() []*Node {
+// Deprecated: Use RegionConfig.ProtoReflect.Descriptor instead.
+func (*RegionConfig) Descriptor() ([]byte, []int) {
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RegionConfig) GetName() string {
 	if x != nil {
-		return x.Validators
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *Workflow) GetLoadBalancers() []*Node {
+func (x *RegionConfig) GetNumOfNodes() uint64 {
 	if x != nil {
-		return x.LoadBalancers
+		return x.NumOfNodes
 	}
-	return nil
+	return 0
 }
 
-func (x *Workflow) GetMonitoring() map[string]string {
+func (x *RegionConfig) GetNumOfValidators() uint64 {
 	if x != nil {
-		return x.Monitoring
+		return x.NumOfValidators
+	}
+	return 0
+}
+
+func (x *RegionConfig) GetSha() string {
+	if x != nil {
+		return x.Sha
+	}
+	return ""
+}
+
+func (x *RegionConfig) GetCustomAppConfig() string {
+	if x != nil {
+		return x.CustomAppConfig
+	}
+	return ""
+}
+
+func (x *RegionConfig) GetCustomConsensusConfig() string {
+	if x != nil {
+		return x.CustomConsensusConfig
+	}
+	return ""
+}
+
+func (x *RegionConfig) GetCustomClientConfig() string {
+	if x != nil {
+		return x.CustomClientConfig
+	}
+	return ""
+}
+
+// ConfigOverride applies JSON config overrides to every validator or full node, or only to the given indices.
+type ConfigOverride struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// role is either "validator" or "node".
+	Role                  string   `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Indices               []uint32 `protobuf:"varint,2,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	CustomAppConfig       string   `protobuf:"bytes,3,opt,name=custom_app_config,json=customAppConfig,proto3" json:"custom_app_config,omitempty"`
+	CustomConsensusConfig string   `protobuf:"bytes,4,opt,name=custom_consensus_config,json=customConsensusConfig,proto3" json:"custom_consensus_config,omitempty"`
+	CustomClientConfig    string   `protobuf:"bytes,5,opt,name=custom_client_config,json=customClientConfig,proto3" json:"custom_client_config,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ConfigOverride) Reset() {
+	*x = ConfigOverride{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigOverride) ProtoMessage() {}
+
+func (x *ConfigOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_ironbird_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigOverride.ProtoReflect.Descriptor instead.
+func (*ConfigOverride) Descriptor() ([]byte, []int) {
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ConfigOverride) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ConfigOverride) GetIndices() []uint32 {
+	if x != nil {
+		return x.Indices
+	}
+	return nil
+}
+
+func (x *ConfigOverride) GetCustomAppConfig() string {
+	if x != nil {
+		return x.CustomAppConfig
+	}
+	return ""
+}
+
+func (x *ConfigOverride) GetCustomConsensusConfig() string {
+	if x != nil {
+		return x.CustomConsensusConfig
+	}
+	return ""
+}
+
+func (x *ConfigOverride) GetCustomClientConfig() string {
+	if x != nil {
+		return x.CustomClientConfig
+	}
+	return ""
+}
+
+// ImageGroup runs a subset of the validators and nodes on a different sha of the repo.
+// Groups are assigned to validators and nodes in order.
+type ImageGroup struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Sha             string                 `protobuf:"bytes,1,opt,name=sha,proto3" json:"sha,omitempty"`
+	NumOfValidators uint64                 `protobuf:"varint,2,opt,name=num_of_validators,json=numOfValidators,proto3" json:"num_of_validators,omitempty"`
+	NumOfNodes      uint64                 `protobuf:"varint,3,opt,name=num_of_nodes,json=numOfNodes,proto3" json:"num_of_nodes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ImageGroup) Reset() {
+	*x = ImageGroup{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageGroup) ProtoMessage() {}
+
+func (x *ImageGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_ironbird_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageGroup.ProtoReflect.Descriptor instead.
+func (*ImageGroup) Descriptor() ([]byte, []int) {
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ImageGroup) GetSha() string {
+	if x != nil {
+		return x.Sha
+	}
+	return ""
+}
+
+func (x *ImageGroup) GetNumOfValidators() uint64 {
+	if x != nil {
+		return x.NumOfValidators
+	}
+	return 0
+}
+
+func (x *ImageGroup) GetNumOfNodes() uint64 {
+	if x != nil {
+		return x.NumOfNodes
+	}
+	return 0
+}
+
+type ChainConfig struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Name                  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	NumOfNodes            uint64                 `protobuf:"varint,2,opt,name=num_of_nodes,json=numOfNodes,proto3" json:"num_of_nodes,omitempty"`
+	NumOfValidators       uint64                 `protobuf:"varint,3,opt,name=num_of_validators,json=numOfValidators,proto3" json:"num_of_validators,omitempty"`
+	GenesisModifications  []*GenesisKV           `protobuf:"bytes,4,rep,name=genesis_modifications,json=genesisModifications,proto3" json:"genesis_modifications,omitempty"`
+	Image                 string                 `protobuf:"bytes,5,opt,name=image,proto3" json:"image,omitempty"`
+	CustomAppConfig       string                 `protobuf:"bytes,6,opt,name=custom_app_config,json=customAppConfig,proto3" json:"custom_app_config,omitempty"`
+	CustomConsensusConfig string                 `protobuf:"bytes,7,opt,name=custom_consensus_config,json=customConsensusConfig,proto3" json:"custom_consensus_config,omitempty"`
+	CustomClientConfig    string                 `protobuf:"bytes,8,opt,name=custom_client_config,json=customClientConfig,proto3" json:"custom_client_config,omitempty"`
+	SetSeedNode           bool                   `protobuf:"varint,9,opt,name=set_seed_node,json=setSeedNode,proto3" json:"set_seed_node,omitempty"`
+	SetPersistentPeers    bool                   `protobuf:"varint,10,opt,name=set_persistent_peers,json=setPersistentPeers,proto3" json:"set_persistent_peers,omitempty"`
+	RegionConfigs         []*RegionConfig        `protobuf:"bytes,11,rep,name=region_configs,json=regionConfigs,proto3" json:"region_configs,omitempty"`
+	Version               string                 `protobuf:"bytes,12,opt,name=version,proto3" json:"version,omitempty"`
+	ImageGroups           []*ImageGroup          `protobuf:"bytes,13,rep,name=image_groups,json=imageGroups,proto3" json:"image_groups,omitempty"`
+	ConfigOverrides       []*ConfigOverride      `protobuf:"bytes,14,rep,name=config_overrides,json=configOverrides,proto3" json:"config_overrides,omitempty"`
+	StakeDistribution     *StakeDistribution     `protobuf:"bytes,15,opt,name=stake_distribution,json=stakeDistribution,proto3" json:"stake_distribution,omitempty"`
+	// Optional: replaces set_seed_node and set_persistent_peers.
+	Topology *Topology `protobuf:"bytes,16,opt,name=topology,proto3" json:"topology,omitempty"`
+	// Optional: signs the votes of every validator in separate signer tasks.
+	RemoteSigner  *RemoteSigner `protobuf:"bytes,17,opt,name=remote_signer,json=remoteSigner,proto3" json:"remote_signer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChainConfig) Reset() {
+	*x = ChainConfig{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChainConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainConfig) ProtoMessage() {}
+
+func (x *ChainConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_ironbird_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainConfig.ProtoReflect.Descriptor instead.
+func (*ChainConfig) Descriptor() ([]byte, []int) {
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ChainConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChainConfig) GetNumOfNodes() uint64 {
+	if x != nil {
+		return x.NumOfNodes
+	}
+	return 0
+}
+
+func (x *ChainConfig) GetNumOfValidators() uint64 {
+	if x != nil {
+		return x.NumOfValidators
+	}
+	return 0
+}
+
+func (x *ChainConfig) GetGenesisModifications() []*GenesisKV {
+	if x != nil {
+		return x.GenesisModifications
+	}
+	return nil
+}
+
+func (x *ChainConfig) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *ChainConfig) GetCustomAppConfig() string {
+	if x != nil {
+		return x.CustomAppConfig
+	}
+	return ""
+}
+
+func (x *ChainConfig) GetCustomConsensusConfig() string {
+	if x != nil {
+		return x.CustomConsensusConfig
+	}
+	return ""
+}
+
+func (x *ChainConfig) GetCustomClientConfig() string {
+	if x != nil {
+		return x.CustomClientConfig
+	}
+	return ""
+}
+
+func (x *ChainConfig) GetSetSeedNode() bool {
+	if x != nil {
+		return x.SetSeedNode
+	}
+	return false
+}
+
+func (x *ChainConfig) GetSetPersistentPeers() bool {
+	if x != nil {
+		return x.SetPersistentPeers
+	}
+	return false
+}
+
+func (x *ChainConfig) GetRegionConfigs() []*RegionConfig {
+	if x != nil {
+		return x.RegionConfigs
+	}
+	return nil
+}
+
+func (x *ChainConfig) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ChainConfig) GetImageGroups() []*ImageGroup {
+	if x != nil {
+		return x.ImageGroups
+	}
+	return nil
+}
+
+func (x *ChainConfig) GetConfigOverrides() []*ConfigOverride {
+	if x != nil {
+		return x.ConfigOverrides
+	}
+	return nil
+}
+
+func (x *ChainConfig) GetStakeDistribution() *StakeDistribution {
+	if x != nil {
+		return x.StakeDistribution
+	}
+	return nil
+}
+
+func (x *ChainConfig) GetTopology() *Topology {
+	if x != nil {
+		return x.Topology
+	}
+	return nil
+}
+
+func (x *ChainConfig) GetRemoteSigner() *RemoteSigner {
+	if x != nil {
+		return x.RemoteSigner
+	}
+	return nil
+}
+
+// RemoteSigner moves the consensus key of every validator into Horcrux signer tasks. With more than one share the
+// key is threshold-split across the cosigners of the validator.
+type RemoteSigner struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// image defaults to the Horcrux image.
+	Image         string `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	Shares        uint32 `protobuf:"varint,2,opt,name=shares,proto3" json:"shares,omitempty"`
+	Threshold     uint32 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoteSigner) Reset() {
+	*x = RemoteSigner{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoteSigner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteSigner) ProtoMessage() {}
+
+func (x *RemoteSigner) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_ironbird_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteSigner.ProtoReflect.Descriptor instead.
+func (*RemoteSigner) Descriptor() ([]byte, []int) {
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RemoteSigner) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *RemoteSigner) GetShares() uint32 {
+	if x != nil {
+		return x.Shares
+	}
+	return 0
+}
+
+func (x *RemoteSigner) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+// Topology determines the persistent peers of every node. Nodes are referenced as validator-<index> or node-<index>.
+type Topology struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// type is one of "sentry", "ring", "k-regular" or "explicit".
+	Type                 string           `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	SentriesPerValidator uint32           `protobuf:"varint,2,opt,name=sentries_per_validator,json=sentriesPerValidator,proto3" json:"sentries_per_validator,omitempty"`
+	Degree               uint32           `protobuf:"varint,3,opt,name=degree,proto3" json:"degree,omitempty"`
+	Seed                 int64            `protobuf:"varint,4,opt,name=seed,proto3" json:"seed,omitempty"`
+	Peers                []*TopologyPeers `protobuf:"bytes,5,rep,name=peers,proto3" json:"peers,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Topology) Reset() {
+	*x = Topology{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Topology) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Topology) ProtoMessage() {}
+
+func (x *Topology) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_ironbird_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Topology.ProtoReflect.Descriptor instead.
+func (*Topology) Descriptor() ([]byte, []int) {
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Topology) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Topology) GetSentriesPerValidator() uint32 {
+	if x != nil {
+		return x.SentriesPerValidator
+	}
+	return 0
+}
+
+func (x *Topology) GetDegree() uint32 {
+	if x != nil {
+		return x.Degree
+	}
+	return 0
+}
+
+func (x *Topology) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *Topology) GetPeers() []*TopologyPeers {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+// TopologyPeers are the persistent peers of a node in an explicit topology.
+type TopologyPeers struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Node          string                 `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Peers         []string               `protobuf:"bytes,2,rep,name=peers,proto3" json:"peers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopologyPeers) Reset() {
+	*x = TopologyPeers{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopologyPeers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopologyPeers) ProtoMessage() {}
+
+func (x *TopologyPeers) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_ironbird_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopologyPeers.ProtoReflect.Descriptor instead.
+func (*TopologyPeers) Descriptor() ([]byte, []int) {
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TopologyPeers) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *TopologyPeers) GetPeers() []string {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+// StakeDistribution skews the genesis self-delegations of the validators.
+type StakeDistribution struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// type is one of "uniform", "explicit", "power-law", "zipf" or "top-n".
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// amounts are the unscaled self-delegations of the validators for explicit distributions.
+	Amounts  []uint64 `protobuf:"varint,2,rep,packed,name=amounts,proto3" json:"amounts,omitempty"`
+	Exponent float64  `protobuf:"fixed64,3,opt,name=exponent,proto3" json:"exponent,omitempty"`
+	TopN     uint32   `protobuf:"varint,4,opt,name=top_n,json=topN,proto3" json:"top_n,omitempty"`
+	// top_share is the percentage of the stake held by the top_n validators.
+	TopShare      float64 `protobuf:"fixed64,5,opt,name=top_share,json=topShare,proto3" json:"top_share,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StakeDistribution) Reset() {
+	*x = StakeDistribution{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StakeDistribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakeDistribution) ProtoMessage() {}
+
+func (x *StakeDistribution) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_ironbird_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakeDistribution.ProtoReflect.Descriptor instead.
+func (*StakeDistribution) Descriptor() ([]byte, []int) {
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *StakeDistribution) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *StakeDistribution) GetAmounts() []uint64 {
+	if x != nil {
+		return x.Amounts
+	}
+	return nil
+}
+
+func (x *StakeDistribution) GetExponent() float64 {
+	if x != nil {
+		return x.Exponent
+	}
+	return 0
+}
+
+func (x *StakeDistribution) GetTopN() uint32 {
+	if x != nil {
+		return x.TopN
+	}
+	return 0
+}
+
+func (x *StakeDistribution) GetTopShare() float64 {
+	if x != nil {
+		return x.TopShare
+	}
+	return 0
+}
+
+type GetWorkflowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkflowId    string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkflowRequest) Reset() {
+	*x = GetWorkflowRequest{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkflowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkflowRequest) ProtoMessage() {}
+
+func (x *GetWorkflowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_ironbird_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkflowRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkflowRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetWorkflowRequest) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+type ListWorkflowsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorkflowsRequest) Reset() {
+	*x = ListWorkflowsRequest{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorkflowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkflowsRequest) ProtoMessage() {}
+
+func (x *ListWorkflowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_ironbird_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkflowsRequest.ProtoReflect.Descriptor instead.
+func (*ListWorkflowsRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListWorkflowsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListWorkflowsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type CancelWorkflowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkflowId    string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelWorkflowRequest) Reset() {
+	*x = CancelWorkflowRequest{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelWorkflowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelWorkflowRequest) ProtoMessage() {}
+
+func (x *CancelWorkflowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_ironbird_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelWorkflowRequest.ProtoReflect.Descriptor instead.
+func (*CancelWorkflowRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CancelWorkflowRequest) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+// ForceTeardownRequest tears down the testnet of a workflow from its last saved provider state, terminating the
+// workflow if it is still running. The response holds the ID of the teardown workflow
+type ForceTeardownRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkflowId    string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceTeardownRequest) Reset() {
+	*x = ForceTeardownRequest{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceTeardownRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceTeardownRequest) ProtoMessage() {}
+
+func (x *ForceTeardownRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_ironbird_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceTeardownRequest.ProtoReflect.Descriptor instead.
+func (*ForceTeardownRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ForceTeardownRequest) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+type SignalWorkflowRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	WorkflowId string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	SignalName string                 `protobuf:"bytes,2,opt,name=signal_name,json=signalName,proto3" json:"signal_name,omitempty"`
+	// Optional: json encoded signal payload, e.g. {"sha": "...", "halt_height": 100}
+	// for the rolling_restart and halt_height_upgrade signals.
+	Payload       string `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignalWorkflowRequest) Reset() {
+	*x = SignalWorkflowRequest{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignalWorkflowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignalWorkflowRequest) ProtoMessage() {}
+
+func (x *SignalWorkflowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_ironbird_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignalWorkflowRequest.ProtoReflect.Descriptor instead.
+func (*SignalWorkflowRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SignalWorkflowRequest) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *SignalWorkflowRequest) GetSignalName() string {
+	if x != nil {
+		return x.SignalName
+	}
+	return ""
+}
+
+func (x *SignalWorkflowRequest) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+type RunLoadTestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkflowId    string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	LoadTestSpec  string                 `protobuf:"bytes,2,opt,name=load_test_spec,json=loadTestSpec,proto3" json:"load_test_spec,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunLoadTestRequest) Reset() {
+	*x = RunLoadTestRequest{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunLoadTestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunLoadTestRequest) ProtoMessage() {}
+
+func (x *RunLoadTestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_ironbird_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunLoadTestRequest.ProtoReflect.Descriptor instead.
+func (*RunLoadTestRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RunLoadTestRequest) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *RunLoadTestRequest) GetLoadTestSpec() string {
+	if x != nil {
+		return x.LoadTestSpec
+	}
+	return ""
+}
+
+type WorkflowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkflowId    string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkflowResponse) Reset() {
+	*x = WorkflowResponse{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkflowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkflowResponse) ProtoMessage() {}
+
+func (x *WorkflowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_ironbird_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkflowResponse.ProtoReflect.Descriptor instead.
+func (*WorkflowResponse) Descriptor() ([]byte, []int) {
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *WorkflowResponse) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+type Node struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Name    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Rpc     string                 `protobuf:"bytes,3,opt,name=rpc,proto3" json:"rpc,omitempty"`
+	Lcd     string                 `protobuf:"bytes,4,opt,name=lcd,proto3" json:"lcd,omitempty"`
+	Grpc    string                 `protobuf:"bytes,5,opt,name=grpc,proto3" json:"grpc,omitempty"`
+	Evmrpc  string                 `protobuf:"bytes,6,opt,name=evmrpc,proto3" json:"evmrpc,omitempty"`
+	Evmws   string                 `protobuf:"bytes,7,opt,name=evmws,proto3" json:"evmws,omitempty"`
+	// image the node runs, nodes of image groups run a different image than the chain
+	Image         string `protobuf:"bytes,8,opt,name=image,proto3" json:"image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Node) Reset() {
+	*x = Node{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Node) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Node) ProtoMessage() {}
+
+func (x *Node) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_ironbird_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Node.ProtoReflect.Descriptor instead.
+func (*Node) Descriptor() ([]byte, []int) {
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Node) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Node) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Node) GetRpc() string {
+	if x != nil {
+		return x.Rpc
+	}
+	return ""
+}
+
+func (x *Node) GetLcd() string {
+	if x != nil {
+		return x.Lcd
+	}
+	return ""
+}
+
+func (x *Node) GetGrpc() string {
+	if x != nil {
+		return x.Grpc
+	}
+	return ""
+}
+
+func (x *Node) GetEvmrpc() string {
+	if x != nil {
+		return x.Evmrpc
+	}
+	return ""
+}
+
+func (x *Node) GetEvmws() string {
+	if x != nil {
+		return x.Evmws
+	}
+	return ""
+}
+
+func (x *Node) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+type WalletInfo struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	FaucetAddress  string                 `protobuf:"bytes,1,opt,name=faucet_address,json=faucetAddress,proto3" json:"faucet_address,omitempty"`
+	FaucetMnemonic string                 `protobuf:"bytes,2,opt,name=faucet_mnemonic,json=faucetMnemonic,proto3" json:"faucet_mnemonic,omitempty"`
+	UserAddresses  []string               `protobuf:"bytes,3,rep,name=user_addresses,json=userAddresses,proto3" json:"user_addresses,omitempty"`
+	UserMnemonics  []string               `protobuf:"bytes,4,rep,name=user_mnemonics,json=userMnemonics,proto3" json:"user_mnemonics,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WalletInfo) Reset() {
+	*x = WalletInfo{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WalletInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletInfo) ProtoMessage() {}
+
+func (x *WalletInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_ironbird_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletInfo.ProtoReflect.Descriptor instead.
+func (*WalletInfo) Descriptor() ([]byte, []int) {
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *WalletInfo) GetFaucetAddress() string {
+	if x != nil {
+		return x.FaucetAddress
+	}
+	return ""
+}
+
+func (x *WalletInfo) GetFaucetMnemonic() string {
+	if x != nil {
+		return x.FaucetMnemonic
+	}
+	return ""
+}
+
+func (x *WalletInfo) GetUserAddresses() []string {
+	if x != nil {
+		return x.UserAddresses
+	}
+	return nil
+}
+
+func (x *WalletInfo) GetUserMnemonics() []string {
+	if x != nil {
+		return x.UserMnemonics
+	}
+	return nil
+}
+
+type Workflow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkflowId    string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Nodes         []*Node                `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Validators    []*Node                `protobuf:"bytes,4,rep,name=validators,proto3" json:"validators,omitempty"`
+	LoadBalancers []*Node                `protobuf:"bytes,5,rep,name=load_balancers,json=loadBalancers,proto3" json:"load_balancers,omitempty"`
+	Monitoring    map[string]string      `protobuf:"bytes,6,rep,name=monitoring,proto3" json:"monitoring,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Config        *CreateWorkflowRequest `protobuf:"bytes,7,opt,name=config,proto3" json:"config,omitempty"`
+	LoadTestSpec  string                 `protobuf:"bytes,17,opt,name=load_test_spec,json=loadTestSpec,proto3" json:"load_test_spec,omitempty"`
+	Wallets       *WalletInfo            `protobuf:"bytes,18,opt,name=wallets,proto3" json:"wallets,omitempty"`
+	Provider      string                 `protobuf:"bytes,19,opt,name=provider,proto3" json:"provider,omitempty"`
+	StartTime     string                 `protobuf:"bytes,20,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       string                 `protobuf:"bytes,21,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// live state queried from running workflows
+	Phase   string `protobuf:"bytes,22,opt,name=phase,proto3" json:"phase,omitempty"`
+	ChainId string `protobuf:"bytes,23,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// highest height reported by a node at the last health check of a long-running testnet
+	Height          uint64            `protobuf:"varint,24,opt,name=height,proto3" json:"height,omitempty"`
+	NodeHealth      []*NodeHealth     `protobuf:"bytes,25,rep,name=node_health,json=nodeHealth,proto3" json:"node_health,omitempty"`
+	HealthCheckedAt string            `protobuf:"bytes,26,opt,name=health_checked_at,json=healthCheckedAt,proto3" json:"health_checked_at,omitempty"`
+	LoadTest        *LoadTestProgress `protobuf:"bytes,27,opt,name=load_test,json=loadTest,proto3" json:"load_test,omitempty"`
+	// empty for long-running testnets, which run until cancelled
+	ExpectedEndTime string `protobuf:"bytes,28,opt,name=expected_end_time,json=expectedEndTime,proto3" json:"expected_end_time,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Workflow) Reset() {
+	*x = Workflow{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Workflow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Workflow) ProtoMessage() {}
+
+func (x *Workflow) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_ironbird_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Workflow.ProtoReflect.Descriptor instead.
+func (*Workflow) Descriptor() ([]byte, []int) {
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Workflow) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *Workflow) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Workflow) GetNodes() []*Node {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *Workflow) GetValidators() []*Node {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+func (x *Workflow) GetLoadBalancers() []*Node {
+	if x != nil {
+		return x.LoadBalancers
+	}
+	return nil
+}
+
+func (x *Workflow) GetMonitoring() map[string]string {
+	if x != nil {
+		return x.Monitoring
+	}
+	return nil
+}
+
+func (x *Workflow) GetConfig() *CreateWorkflowRequest {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *Workflow) GetLoadTestSpec() string {
+	if x != nil {
+		return x.LoadTestSpec
+	}
+	return ""
+}
+
+func (x *Workflow) GetWallets() *WalletInfo {
+	if x != nil {
+		return x.Wallets
+	}
+	return nil
+}
+
+func (x *Workflow) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Workflow) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *Workflow) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *Workflow) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *Workflow) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *Workflow) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Workflow) GetNodeHealth() []*NodeHealth {
+	if x != nil {
+		return x.NodeHealth
+	}
+	return nil
+}
+
+func (x *Workflow) GetHealthCheckedAt() string {
+	if x != nil {
+		return x.HealthCheckedAt
+	}
+	return ""
+}
+
+func (x *Workflow) GetLoadTest() *LoadTestProgress {
+	if x != nil {
+		return x.LoadTest
+	}
+	return nil
+}
+
+func (x *Workflow) GetExpectedEndTime() string {
+	if x != nil {
+		return x.ExpectedEndTime
+	}
+	return ""
+}
+
+type NodeHealth struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Name   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Height uint64                 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// empty if the node is healthy
+	Error         string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeHealth) Reset() {
+	*x = NodeHealth{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeHealth) ProtoMessage() {}
+
+func (x *NodeHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_ironbird_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeHealth.ProtoReflect.Descriptor instead.
+func (*NodeHealth) Descriptor() ([]byte, []int) {
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *NodeHealth) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NodeHealth) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *NodeHealth) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type LoadTestProgress struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StartTime string                 `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// empty while the load test is running
+	EndTime                string  `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Error                  string  `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	TotalTransactions      int64   `protobuf:"varint,4,opt,name=total_transactions,json=totalTransactions,proto3" json:"total_transactions,omitempty"`
+	IncludedTransactions   int64   `protobuf:"varint,5,opt,name=included_transactions,json=includedTransactions,proto3" json:"included_transactions,omitempty"`
+	SuccessfulTransactions int64   `protobuf:"varint,6,opt,name=successful_transactions,json=successfulTransactions,proto3" json:"successful_transactions,omitempty"`
+	FailedTransactions     int64   `protobuf:"varint,7,opt,name=failed_transactions,json=failedTransactions,proto3" json:"failed_transactions,omitempty"`
+	Tps                    float64 `protobuf:"fixed64,8,opt,name=tps,proto3" json:"tps,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *LoadTestProgress) Reset() {
+	*x = LoadTestProgress{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoadTestProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadTestProgress) ProtoMessage() {}
+
+func (x *LoadTestProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_ironbird_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadTestProgress.ProtoReflect.Descriptor instead.
+func (*LoadTestProgress) Descriptor() ([]byte, []int) {
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *LoadTestProgress) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *LoadTestProgress) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *LoadTestProgress) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *LoadTestProgress) GetTotalTransactions() int64 {
+	if x != nil {
+		return x.TotalTransactions
+	}
+	return 0
+}
+
+func (x *LoadTestProgress) GetIncludedTransactions() int64 {
+	if x != nil {
+		return x.IncludedTransactions
+	}
+	return 0
+}
+
+func (x *LoadTestProgress) GetSuccessfulTransactions() int64 {
+	if x != nil {
+		return x.SuccessfulTransactions
+	}
+	return 0
+}
+
+func (x *LoadTestProgress) GetFailedTransactions() int64 {
+	if x != nil {
+		return x.FailedTransactions
+	}
+	return 0
+}
+
+func (x *LoadTestProgress) GetTps() float64 {
+	if x != nil {
+		return x.Tps
+	}
+	return 0
+}
+
+type WorkflowSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkflowId    string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	StartTime     string                 `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	Repo          string                 `protobuf:"bytes,4,opt,name=repo,proto3" json:"repo,omitempty"`
+	Sha           string                 `protobuf:"bytes,5,opt,name=sha,proto3" json:"sha,omitempty"`
+	Provider      string                 `protobuf:"bytes,6,opt,name=provider,proto3" json:"provider,omitempty"`
+	TemplateId    string                 `protobuf:"bytes,7,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	RunName       string                 `protobuf:"bytes,8,opt,name=run_name,json=runName,proto3" json:"run_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkflowSummary) Reset() {
+	*x = WorkflowSummary{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkflowSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkflowSummary) ProtoMessage() {}
+
+func (x *WorkflowSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_ironbird_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkflowSummary.ProtoReflect.Descriptor instead.
+func (*WorkflowSummary) Descriptor() ([]byte, []int) {
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *WorkflowSummary) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *WorkflowSummary) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WorkflowSummary) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *WorkflowSummary) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *WorkflowSummary) GetSha() string {
+	if x != nil {
+		return x.Sha
+	}
+	return ""
+}
+
+func (x *WorkflowSummary) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *WorkflowSummary) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *WorkflowSummary) GetRunName() string {
+	if x != nil {
+		return x.RunName
+	}
+	return ""
+}
+
+type UpdateWorkflowDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkflowId    string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	LoadBalancers []*Node                `protobuf:"bytes,2,rep,name=load_balancers,json=loadBalancers,proto3" json:"load_balancers,omitempty"`
+	Monitoring    map[string]string      `protobuf:"bytes,3,rep,name=monitoring,proto3" json:"monitoring,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Nodes         []*Node                `protobuf:"bytes,4,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Validators    []*Node                `protobuf:"bytes,5,rep,name=validators,proto3" json:"validators,omitempty"`
+	Wallets       *WalletInfo            `protobuf:"bytes,6,opt,name=wallets,proto3" json:"wallets,omitempty"`
+	Provider      string                 `protobuf:"bytes,7,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWorkflowDataRequest) Reset() {
+	*x = UpdateWorkflowDataRequest{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWorkflowDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorkflowDataRequest) ProtoMessage() {}
+
+func (x *UpdateWorkflowDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_ironbird_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorkflowDataRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWorkflowDataRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UpdateWorkflowDataRequest) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *UpdateWorkflowDataRequest) GetLoadBalancers() []*Node {
+	if x != nil {
+		return x.LoadBalancers
+	}
+	return nil
+}
+
+func (x *UpdateWorkflowDataRequest) GetMonitoring() map[string]string {
+	if x != nil {
+		return x.Monitoring
+	}
+	return nil
+}
+
+func (x *UpdateWorkflowDataRequest) GetNodes() []*Node {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *UpdateWorkflowDataRequest) GetValidators() []*Node {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+func (x *UpdateWorkflowDataRequest) GetWallets() *WalletInfo {
+	if x != nil {
+		return x.Wallets
+	}
+	return nil
+}
+
+func (x *UpdateWorkflowDataRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+// AppendBuildLogsRequest is sent by workers while they build an image of a workflow
+type AppendBuildLogsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	WorkflowId string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	// image tag of the build
+	Build         string `protobuf:"bytes,2,opt,name=build,proto3" json:"build,omitempty"`
+	Content       string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppendBuildLogsRequest) Reset() {
+	*x = AppendBuildLogsRequest{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppendBuildLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppendBuildLogsRequest) ProtoMessage() {}
+
+func (x *AppendBuildLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_ironbird_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppendBuildLogsRequest.ProtoReflect.Descriptor instead.
+func (*AppendBuildLogsRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AppendBuildLogsRequest) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *AppendBuildLogsRequest) GetBuild() string {
+	if x != nil {
+		return x.Build
+	}
+	return ""
+}
+
+func (x *AppendBuildLogsRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type AppendBuildLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppendBuildLogsResponse) Reset() {
+	*x = AppendBuildLogsResponse{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppendBuildLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppendBuildLogsResponse) ProtoMessage() {}
+
+func (x *AppendBuildLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_ironbird_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppendBuildLogsResponse.ProtoReflect.Descriptor instead.
+func (*AppendBuildLogsResponse) Descriptor() ([]byte, []int) {
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{36}
+}
+
+type StreamBuildLogsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	WorkflowId string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	// keep the stream open and send new chunks until the workflow finishes
+	Follow bool `protobuf:"varint,2,opt,name=follow,proto3" json:"follow,omitempty"`
+	// only send chunks with a greater id, used to resume a stream
+	AfterId       int64 `protobuf:"varint,3,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamBuildLogsRequest) Reset() {
+	*x = StreamBuildLogsRequest{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamBuildLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamBuildLogsRequest) ProtoMessage() {}
+
+func (x *StreamBuildLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_ironbird_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamBuildLogsRequest.ProtoReflect.Descriptor instead.
+func (*StreamBuildLogsRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *StreamBuildLogsRequest) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *StreamBuildLogsRequest) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
+
+func (x *StreamBuildLogsRequest) GetAfterId() int64 {
+	if x != nil {
+		return x.AfterId
+	}
+	return 0
+}
+
+type BuildLogChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WorkflowId    string                 `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	Build         string                 `protobuf:"bytes,3,opt,name=build,proto3" json:"build,omitempty"`
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildLogChunk) Reset() {
+	*x = BuildLogChunk{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildLogChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildLogChunk) ProtoMessage() {}
+
+func (x *BuildLogChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_ironbird_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildLogChunk.ProtoReflect.Descriptor instead.
+func (*BuildLogChunk) Descriptor() ([]byte, []int) {
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *BuildLogChunk) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BuildLogChunk) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *BuildLogChunk) GetBuild() string {
+	if x != nil {
+		return x.Build
+	}
+	return ""
+}
+
+func (x *BuildLogChunk) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *BuildLogChunk) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// SaveProviderStateRequest is sent by workers after every activity that changed the provider of a workflow
+type SaveProviderStateRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	WorkflowId string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunnerType string                 `protobuf:"bytes,2,opt,name=runner_type,json=runnerType,proto3" json:"runner_type,omitempty"`
+	// compressed provider state, empty once the provider was torn down
+	ProviderState []byte `protobuf:"bytes,3,opt,name=provider_state,json=providerState,proto3" json:"provider_state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveProviderStateRequest) Reset() {
+	*x = SaveProviderStateRequest{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveProviderStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveProviderStateRequest) ProtoMessage() {}
+
+func (x *SaveProviderStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_ironbird_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Workflow) GetConfig() *CreateWorkflowRequest {
+// Deprecated: Use SaveProviderStateRequest.ProtoReflect.Descriptor instead.
+func (*SaveProviderStateRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SaveProviderStateRequest) GetWorkflowId() string {
 	if x != nil {
-		return x.Config
+		return x.WorkflowId
 	}
-	return nil
+	return ""
 }
 
-func (x *Workflow) GetLoadTestSpec() string {
+func (x *SaveProviderStateRequest) GetRunnerType() string {
 	if x != nil {
-		return x.LoadTestSpec
+		return x.RunnerType
 	}
 	return ""
 }
 
-func (x *Workflow) GetWallets() *WalletInfo {
+func (x *SaveProviderStateRequest) GetProviderState() []byte {
 	if x != nil {
-		return x.Wallets
+		return x.ProviderState
 	}
 	return nil
 }
 
-func (x *Workflow) GetProvider() string {
-	if x != nil {
-		return x.Provider
-	}
-	return ""
+type SaveProviderStateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Workflow) GetStartTime() string {
-	if x != nil {
-		return x.StartTime
-	}
-	return ""
+func (x *SaveProviderStateResponse) Reset() {
+	*x = SaveProviderStateResponse{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Workflow) GetEndTime() string {
+func (x *SaveProviderStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveProviderStateResponse) ProtoMessage() {}
+
+func (x *SaveProviderStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_ironbird_proto_msgTypes[40]
 	if x != nil {
-		return x.EndTime
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type WorkflowSummary struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	WorkflowId    string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	StartTime     string                 `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	Repo          string                 `protobuf:"bytes,4,opt,name=repo,proto3" json:"repo,omitempty"`
-	Sha           string                 `protobuf:"bytes,5,opt,name=sha,proto3" json:"sha,omitempty"`
-	Provider      string                 `protobuf:"bytes,6,opt,name=provider,proto3" json:"provider,omitempty"`
-	TemplateId    string                 `protobuf:"bytes,7,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
-	RunName       string                 `protobuf:"bytes,8,opt,name=run_name,json=runName,proto3" json:"run_name,omitempty"`
+// Deprecated: Use SaveProviderStateResponse.ProtoReflect.Descriptor instead.
+func (*SaveProviderStateResponse) Descriptor() ([]byte, []int) {
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{40}
+}
+
+// UploadWorkflowArtifactRequest is sent by workers with files collected from a workflow's testnet
+type UploadWorkflowArtifactRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	WorkflowId string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	// path like name of the artifact, e.g. coverage.out or race/validator-0/report.1
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Content       []byte `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WorkflowSummary) Reset() {
-	*x = WorkflowSummary{}
-	mi := &file_server_proto_ironbird_proto_msgTypes[13]
+func (x *UploadWorkflowArtifactRequest) Reset() {
+	*x = UploadWorkflowArtifactRequest{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkflowSummary) String() string {
+func (x *UploadWorkflowArtifactRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkflowSummary) ProtoMessage() {}
+func (*UploadWorkflowArtifactRequest) ProtoMessage() {}
 
-func (x *WorkflowSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_ironbird_proto_msgTypes[13]
+func (x *UploadWorkflowArtifactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_ironbird_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1045,95 +3140,90 @@ func (x *WorkflowSummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkflowSummary.ProtoReflect.Descriptor instead.
-func (*WorkflowSummary) Descriptor() ([]byte, []int) {
-	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use UploadWorkflowArtifactRequest.ProtoReflect.Descriptor instead.
+func (*UploadWorkflowArtifactRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *WorkflowSummary) GetWorkflowId() string {
+func (x *UploadWorkflowArtifactRequest) GetWorkflowId() string {
 	if x != nil {
 		return x.WorkflowId
 	}
 	return ""
 }
 
-func (x *WorkflowSummary) GetStatus() string {
+func (x *UploadWorkflowArtifactRequest) GetName() string {
 	if x != nil {
-		return x.Status
+		return x.Name
 	}
 	return ""
 }
 
-func (x *WorkflowSummary) GetStartTime() string {
+func (x *UploadWorkflowArtifactRequest) GetContent() []byte {
 	if x != nil {
-		return x.StartTime
+		return x.Content
 	}
-	return ""
+	return nil
 }
 
-func (x *WorkflowSummary) GetRepo() string {
-	if x != nil {
-		return x.Repo
-	}
-	return ""
+type UploadWorkflowArtifactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WorkflowSummary) GetSha() string {
-	if x != nil {
-		return x.Sha
-	}
-	return ""
+func (x *UploadWorkflowArtifactResponse) Reset() {
+	*x = UploadWorkflowArtifactResponse{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkflowSummary) GetProvider() string {
-	if x != nil {
-		return x.Provider
-	}
-	return ""
+func (x *UploadWorkflowArtifactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *WorkflowSummary) GetTemplateId() string {
+func (*UploadWorkflowArtifactResponse) ProtoMessage() {}
+
+func (x *UploadWorkflowArtifactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_ironbird_proto_msgTypes[42]
 	if x != nil {
-		return x.TemplateId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *WorkflowSummary) GetRunName() string {
-	if x != nil {
-		return x.RunName
-	}
-	return ""
+// Deprecated: Use UploadWorkflowArtifactResponse.ProtoReflect.Descriptor instead.
+func (*UploadWorkflowArtifactResponse) Descriptor() ([]byte, []int) {
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{42}
 }
 
-type UpdateWorkflowDataRequest struct {
+type GetWorkflowArtifactsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	WorkflowId    string                 `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
-	LoadBalancers []*Node                `protobuf:"bytes,2,rep,name=load_balancers,json=loadBalancers,proto3" json:"load_balancers,omitempty"`
-	Monitoring    map[string]string      `protobuf:"bytes,3,rep,name=monitoring,proto3" json:"monitoring,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Nodes         []*Node                `protobuf:"bytes,4,rep,name=nodes,proto3" json:"nodes,omitempty"`
-	Validators    []*Node                `protobuf:"bytes,5,rep,name=validators,proto3" json:"validators,omitempty"`
-	Wallets       *WalletInfo            `protobuf:"bytes,6,opt,name=wallets,proto3" json:"wallets,omitempty"`
-	Provider      string                 `protobuf:"bytes,7,opt,name=provider,proto3" json:"provider,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateWorkflowDataRequest) Reset() {
-	*x = UpdateWorkflowDataRequest{}
-	mi := &file_server_proto_ironbird_proto_msgTypes[14]
+func (x *GetWorkflowArtifactsRequest) Reset() {
+	*x = GetWorkflowArtifactsRequest{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateWorkflowDataRequest) String() string {
+func (x *GetWorkflowArtifactsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateWorkflowDataRequest) ProtoMessage() {}
+func (*GetWorkflowArtifactsRequest) ProtoMessage() {}
 
-func (x *UpdateWorkflowDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_ironbird_proto_msgTypes[14]
+func (x *GetWorkflowArtifactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_ironbird_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1144,58 +3234,120 @@ func (x *UpdateWorkflowDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateWorkflowDataRequest.ProtoReflect.Descriptor instead.
-func (*UpdateWorkflowDataRequest) Descriptor() ([]byte, []int) {
-	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GetWorkflowArtifactsRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkflowArtifactsRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *UpdateWorkflowDataRequest) GetWorkflowId() string {
+func (x *GetWorkflowArtifactsRequest) GetWorkflowId() string {
 	if x != nil {
 		return x.WorkflowId
 	}
 	return ""
 }
 
-func (x *UpdateWorkflowDataRequest) GetLoadBalancers() []*Node {
+type WorkflowArtifact struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkflowArtifact) Reset() {
+	*x = WorkflowArtifact{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkflowArtifact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkflowArtifact) ProtoMessage() {}
+
+func (x *WorkflowArtifact) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_ironbird_proto_msgTypes[44]
 	if x != nil {
-		return x.LoadBalancers
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateWorkflowDataRequest) GetMonitoring() map[string]string {
+// Deprecated: Use WorkflowArtifact.ProtoReflect.Descriptor instead.
+func (*WorkflowArtifact) Descriptor() ([]byte, []int) {
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *WorkflowArtifact) GetName() string {
 	if x != nil {
-		return x.Monitoring
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateWorkflowDataRequest) GetNodes() []*Node {
+func (x *WorkflowArtifact) GetContent() []byte {
 	if x != nil {
-		return x.Nodes
+		return x.Content
 	}
 	return nil
 }
 
-func (x *UpdateWorkflowDataRequest) GetValidators() []*Node {
+func (x *WorkflowArtifact) GetCreatedAt() string {
 	if x != nil {
-		return x.Validators
+		return x.CreatedAt
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateWorkflowDataRequest) GetWallets() *WalletInfo {
+type WorkflowArtifactsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Artifacts     []*WorkflowArtifact    `protobuf:"bytes,1,rep,name=artifacts,proto3" json:"artifacts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkflowArtifactsResponse) Reset() {
+	*x = WorkflowArtifactsResponse{}
+	mi := &file_server_proto_ironbird_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkflowArtifactsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkflowArtifactsResponse) ProtoMessage() {}
+
+func (x *WorkflowArtifactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_ironbird_proto_msgTypes[45]
 	if x != nil {
-		return x.Wallets
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateWorkflowDataRequest) GetProvider() string {
+// Deprecated: Use WorkflowArtifactsResponse.ProtoReflect.Descriptor instead.
+func (*WorkflowArtifactsResponse) Descriptor() ([]byte, []int) {
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *WorkflowArtifactsResponse) GetArtifacts() []*WorkflowArtifact {
 	if x != nil {
-		return x.Provider
+		return x.Artifacts
 	}
-	return ""
+	return nil
 }
 
 type WorkflowListResponse struct {
@@ -1209,7 +3361,7 @@ type WorkflowListResponse struct {
 
 func (x *WorkflowListResponse) Reset() {
 	*x = WorkflowListResponse{}
-	mi := &file_server_proto_ironbird_proto_msgTypes[15]
+	mi := &file_server_proto_ironbird_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1221,7 +3373,7 @@ func (x *WorkflowListResponse) String() string {
 func (*WorkflowListResponse) ProtoMessage() {}
 
 func (x *WorkflowListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_ironbird_proto_msgTypes[15]
+	mi := &file_server_proto_ironbird_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1234,7 +3386,7 @@ func (x *WorkflowListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkflowListResponse.ProtoReflect.Descriptor instead.
 func (*WorkflowListResponse) Descriptor() ([]byte, []int) {
-	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{15}
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *WorkflowListResponse) GetWorkflows() []*WorkflowSummary {
@@ -1271,7 +3423,7 @@ type WorkflowTemplate struct {
 
 func (x *WorkflowTemplate) Reset() {
 	*x = WorkflowTemplate{}
-	mi := &file_server_proto_ironbird_proto_msgTypes[16]
+	mi := &file_server_proto_ironbird_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1283,7 +3435,7 @@ func (x *WorkflowTemplate) String() string {
 func (*WorkflowTemplate) ProtoMessage() {}
 
 func (x *WorkflowTemplate) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_ironbird_proto_msgTypes[16]
+	mi := &file_server_proto_ironbird_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1296,7 +3448,7 @@ func (x *WorkflowTemplate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkflowTemplate.ProtoReflect.Descriptor instead.
 func (*WorkflowTemplate) Descriptor() ([]byte, []int) {
-	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{16}
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *WorkflowTemplate) GetId() string {
@@ -1345,7 +3497,7 @@ type CreateWorkflowTemplateRequest struct {
 
 func (x *CreateWorkflowTemplateRequest) Reset() {
 	*x = CreateWorkflowTemplateRequest{}
-	mi := &file_server_proto_ironbird_proto_msgTypes[17]
+	mi := &file_server_proto_ironbird_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1357,7 +3509,7 @@ func (x *CreateWorkflowTemplateRequest) String() string {
 func (*CreateWorkflowTemplateRequest) ProtoMessage() {}
 
 func (x *CreateWorkflowTemplateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_ironbird_proto_msgTypes[17]
+	mi := &file_server_proto_ironbird_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1370,7 +3522,7 @@ func (x *CreateWorkflowTemplateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateWorkflowTemplateRequest.ProtoReflect.Descriptor instead.
 func (*CreateWorkflowTemplateRequest) Descriptor() ([]byte, []int) {
-	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{17}
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *CreateWorkflowTemplateRequest) GetId() string {
@@ -1403,7 +3555,7 @@ type GetWorkflowTemplateRequest struct {
 
 func (x *GetWorkflowTemplateRequest) Reset() {
 	*x = GetWorkflowTemplateRequest{}
-	mi := &file_server_proto_ironbird_proto_msgTypes[18]
+	mi := &file_server_proto_ironbird_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1415,7 +3567,7 @@ func (x *GetWorkflowTemplateRequest) String() string {
 func (*GetWorkflowTemplateRequest) ProtoMessage() {}
 
 func (x *GetWorkflowTemplateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_ironbird_proto_msgTypes[18]
+	mi := &file_server_proto_ironbird_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1428,7 +3580,7 @@ func (x *GetWorkflowTemplateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWorkflowTemplateRequest.ProtoReflect.Descriptor instead.
 func (*GetWorkflowTemplateRequest) Descriptor() ([]byte, []int) {
-	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{18}
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *GetWorkflowTemplateRequest) GetId() string {
@@ -1448,7 +3600,7 @@ type ListWorkflowTemplatesRequest struct {
 
 func (x *ListWorkflowTemplatesRequest) Reset() {
 	*x = ListWorkflowTemplatesRequest{}
-	mi := &file_server_proto_ironbird_proto_msgTypes[19]
+	mi := &file_server_proto_ironbird_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1460,7 +3612,7 @@ func (x *ListWorkflowTemplatesRequest) String() string {
 func (*ListWorkflowTemplatesRequest) ProtoMessage() {}
 
 func (x *ListWorkflowTemplatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_ironbird_proto_msgTypes[19]
+	mi := &file_server_proto_ironbird_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1473,7 +3625,7 @@ func (x *ListWorkflowTemplatesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListWorkflowTemplatesRequest.ProtoReflect.Descriptor instead.
 func (*ListWorkflowTemplatesRequest) Descriptor() ([]byte, []int) {
-	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{19}
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *ListWorkflowTemplatesRequest) GetLimit() int32 {
@@ -1501,7 +3653,7 @@ type UpdateWorkflowTemplateRequest struct {
 
 func (x *UpdateWorkflowTemplateRequest) Reset() {
 	*x = UpdateWorkflowTemplateRequest{}
-	mi := &file_server_proto_ironbird_proto_msgTypes[20]
+	mi := &file_server_proto_ironbird_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1513,7 +3665,7 @@ func (x *UpdateWorkflowTemplateRequest) String() string {
 func (*UpdateWorkflowTemplateRequest) ProtoMessage() {}
 
 func (x *UpdateWorkflowTemplateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_ironbird_proto_msgTypes[20]
+	mi := &file_server_proto_ironbird_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1526,7 +3678,7 @@ func (x *UpdateWorkflowTemplateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateWorkflowTemplateRequest.ProtoReflect.Descriptor instead.
 func (*UpdateWorkflowTemplateRequest) Descriptor() ([]byte, []int) {
-	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{20}
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *UpdateWorkflowTemplateRequest) GetId() string {
@@ -1559,7 +3711,7 @@ type DeleteWorkflowTemplateRequest struct {
 
 func (x *DeleteWorkflowTemplateRequest) Reset() {
 	*x = DeleteWorkflowTemplateRequest{}
-	mi := &file_server_proto_ironbird_proto_msgTypes[21]
+	mi := &file_server_proto_ironbird_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1571,7 +3723,7 @@ func (x *DeleteWorkflowTemplateRequest) String() string {
 func (*DeleteWorkflowTemplateRequest) ProtoMessage() {}
 
 func (x *DeleteWorkflowTemplateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_ironbird_proto_msgTypes[21]
+	mi := &file_server_proto_ironbird_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1584,7 +3736,7 @@ func (x *DeleteWorkflowTemplateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteWorkflowTemplateRequest.ProtoReflect.Descriptor instead.
 func (*DeleteWorkflowTemplateRequest) Descriptor() ([]byte, []int) {
-	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{21}
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *DeleteWorkflowTemplateRequest) GetId() string {
@@ -1603,7 +3755,7 @@ type WorkflowTemplateResponse struct {
 
 func (x *WorkflowTemplateResponse) Reset() {
 	*x = WorkflowTemplateResponse{}
-	mi := &file_server_proto_ironbird_proto_msgTypes[22]
+	mi := &file_server_proto_ironbird_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1615,7 +3767,7 @@ func (x *WorkflowTemplateResponse) String() string {
 func (*WorkflowTemplateResponse) ProtoMessage() {}
 
 func (x *WorkflowTemplateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_ironbird_proto_msgTypes[22]
+	mi := &file_server_proto_ironbird_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1628,7 +3780,7 @@ func (x *WorkflowTemplateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkflowTemplateResponse.ProtoReflect.Descriptor instead.
 func (*WorkflowTemplateResponse) Descriptor() ([]byte, []int) {
-	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{22}
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *WorkflowTemplateResponse) GetId() string {
@@ -1650,7 +3802,7 @@ type WorkflowTemplateSummary struct {
 
 func (x *WorkflowTemplateSummary) Reset() {
 	*x = WorkflowTemplateSummary{}
-	mi := &file_server_proto_ironbird_proto_msgTypes[23]
+	mi := &file_server_proto_ironbird_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1662,7 +3814,7 @@ func (x *WorkflowTemplateSummary) String() string {
 func (*WorkflowTemplateSummary) ProtoMessage() {}
 
 func (x *WorkflowTemplateSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_ironbird_proto_msgTypes[23]
+	mi := &file_server_proto_ironbird_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1675,7 +3827,7 @@ func (x *WorkflowTemplateSummary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkflowTemplateSummary.ProtoReflect.Descriptor instead.
 func (*WorkflowTemplateSummary) Descriptor() ([]byte, []int) {
-	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{23}
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *WorkflowTemplateSummary) GetId() string {
@@ -1716,7 +3868,7 @@ type WorkflowTemplateListResponse struct {
 
 func (x *WorkflowTemplateListResponse) Reset() {
 	*x = WorkflowTemplateListResponse{}
-	mi := &file_server_proto_ironbird_proto_msgTypes[24]
+	mi := &file_server_proto_ironbird_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1728,7 +3880,7 @@ func (x *WorkflowTemplateListResponse) String() string {
 func (*WorkflowTemplateListResponse) ProtoMessage() {}
 
 func (x *WorkflowTemplateListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_ironbird_proto_msgTypes[24]
+	mi := &file_server_proto_ironbird_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1741,7 +3893,7 @@ func (x *WorkflowTemplateListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkflowTemplateListResponse.ProtoReflect.Descriptor instead.
 func (*WorkflowTemplateListResponse) Descriptor() ([]byte, []int) {
-	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{24}
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *WorkflowTemplateListResponse) GetTemplates() []*WorkflowTemplateSummary {
@@ -1769,7 +3921,7 @@ type ExecuteWorkflowTemplateRequest struct {
 
 func (x *ExecuteWorkflowTemplateRequest) Reset() {
 	*x = ExecuteWorkflowTemplateRequest{}
-	mi := &file_server_proto_ironbird_proto_msgTypes[25]
+	mi := &file_server_proto_ironbird_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1781,7 +3933,7 @@ func (x *ExecuteWorkflowTemplateRequest) String() string {
 func (*ExecuteWorkflowTemplateRequest) ProtoMessage() {}
 
 func (x *ExecuteWorkflowTemplateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_ironbird_proto_msgTypes[25]
+	mi := &file_server_proto_ironbird_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1794,7 +3946,7 @@ func (x *ExecuteWorkflowTemplateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecuteWorkflowTemplateRequest.ProtoReflect.Descriptor instead.
 func (*ExecuteWorkflowTemplateRequest) Descriptor() ([]byte, []int) {
-	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{25}
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *ExecuteWorkflowTemplateRequest) GetId() string {
@@ -1836,7 +3988,7 @@ type TemplateRun struct {
 
 func (x *TemplateRun) Reset() {
 	*x = TemplateRun{}
-	mi := &file_server_proto_ironbird_proto_msgTypes[26]
+	mi := &file_server_proto_ironbird_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1848,7 +4000,7 @@ func (x *TemplateRun) String() string {
 func (*TemplateRun) ProtoMessage() {}
 
 func (x *TemplateRun) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_ironbird_proto_msgTypes[26]
+	mi := &file_server_proto_ironbird_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1861,7 +4013,7 @@ func (x *TemplateRun) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TemplateRun.ProtoReflect.Descriptor instead.
 func (*TemplateRun) Descriptor() ([]byte, []int) {
-	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{26}
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *TemplateRun) GetRunId() string {
@@ -1945,7 +4097,7 @@ type GetTemplateRunHistoryRequest struct {
 
 func (x *GetTemplateRunHistoryRequest) Reset() {
 	*x = GetTemplateRunHistoryRequest{}
-	mi := &file_server_proto_ironbird_proto_msgTypes[27]
+	mi := &file_server_proto_ironbird_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1957,7 +4109,7 @@ func (x *GetTemplateRunHistoryRequest) String() string {
 func (*GetTemplateRunHistoryRequest) ProtoMessage() {}
 
 func (x *GetTemplateRunHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_ironbird_proto_msgTypes[27]
+	mi := &file_server_proto_ironbird_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1970,7 +4122,7 @@ func (x *GetTemplateRunHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTemplateRunHistoryRequest.ProtoReflect.Descriptor instead.
 func (*GetTemplateRunHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{27}
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *GetTemplateRunHistoryRequest) GetId() string {
@@ -2004,7 +4156,7 @@ type TemplateRunHistoryResponse struct {
 
 func (x *TemplateRunHistoryResponse) Reset() {
 	*x = TemplateRunHistoryResponse{}
-	mi := &file_server_proto_ironbird_proto_msgTypes[28]
+	mi := &file_server_proto_ironbird_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2016,7 +4168,7 @@ func (x *TemplateRunHistoryResponse) String() string {
 func (*TemplateRunHistoryResponse) ProtoMessage() {}
 
 func (x *TemplateRunHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_server_proto_ironbird_proto_msgTypes[28]
+	mi := &file_server_proto_ironbird_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2029,7 +4181,7 @@ func (x *TemplateRunHistoryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TemplateRunHistoryResponse.ProtoReflect.Descriptor instead.
 func (*TemplateRunHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{28}
+	return file_server_proto_ironbird_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *TemplateRunHistoryResponse) GetRuns() []*TemplateRun {
@@ -2050,7 +4202,8 @@ var File_server_proto_ironbird_proto protoreflect.FileDescriptor
 
 const file_server_proto_ironbird_proto_rawDesc = "" +
 	"\n" +
-	"\x1bserver/proto/ironbird.proto\x12\rskip.ironbird\"\xe1\x05\n" +
+	"\x1bserver/proto/ironbird.proto\x12\rskip.ironbird\"\x85\n" +
+	"\n" +
 	"\x15CreateWorkflowRequest\x12\x12\n" +
 	"\x04repo\x18\x01 \x01(\tR\x04repo\x12\x10\n" +
 	"\x03sha\x18\x02 \x01(\tR\x03sha\x12\x1e\n" +
@@ -2071,18 +4224,95 @@ const file_server_proto_ironbird_proto_rawDesc = "" +
 	"\rbase_mnemonic\x18\r \x01(\tR\fbaseMnemonic\x12$\n" +
 	"\x0ecosmos_sdk_sha\x18\x0e \x01(\tR\fcosmosSdkSha\x12!\n" +
 	"\fcometbft_sha\x18\x0f \x01(\tR\vcometbftSha\x12a\n" +
-	"\x0fprovider_config\x18\x10 \x03(\v28.skip.ironbird.CreateWorkflowRequest.ProviderConfigEntryR\x0eproviderConfig\x1aA\n" +
+	"\x0fprovider_config\x18\x10 \x03(\v28.skip.ironbird.CreateWorkflowRequest.ProviderConfigEntryR\x0eproviderConfig\x12L\n" +
+	"\x11genesis_migration\x18\x11 \x01(\v2\x1f.skip.ironbird.GenesisMigrationR\x10genesisMigration\x12C\n" +
+	"\x0ecustom_genesis\x18\x12 \x01(\v2\x1c.skip.ironbird.CustomGenesisR\rcustomGenesis\x12K\n" +
+	"\x11additional_chains\x18\x13 \x03(\v2\x1e.skip.ironbird.AdditionalChainR\x10additionalChains\x120\n" +
+	"\arelayer\x18\x14 \x01(\v2\x16.skip.ironbird.RelayerR\arelayer\x12,\n" +
+	"\x06faults\x18\x15 \x03(\v2\x14.skip.ironbird.FaultR\x06faults\x12C\n" +
+	"\x0fgo_mod_replaces\x18\x16 \x03(\v2\x1b.skip.ironbird.GoModReplaceR\rgoModReplaces\x122\n" +
+	"\x06source\x18\x17 \x01(\v2\x1a.skip.ironbird.ChainSourceR\x06source\x12#\n" +
+	"\rbuild_variant\x18\x18 \x01(\tR\fbuildVariant\x12D\n" +
+	"\rload_balancer\x18\x19 \x01(\v2\x1f.skip.ironbird.LoadBalancerSpecR\floadBalancer\x1aA\n" +
 	"\x13ProviderConfigEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"3\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa2\x01\n" +
+	"\x13LoadBalancerOptions\x12!\n" +
+	"\fhealth_check\x18\x01 \x01(\bR\vhealthCheck\x12\x16\n" +
+	"\x06policy\x18\x02 \x01(\tR\x06policy\x12\x1d\n" +
+	"\n" +
+	"rate_limit\x18\x03 \x01(\x05R\trateLimit\x121\n" +
+	"\x15max_request_body_size\x18\x04 \x01(\tR\x12maxRequestBodySize\"z\n" +
+	"\x19LoadBalancerDomainOptions\x12\x1f\n" +
+	"\vdomain_type\x18\x01 \x01(\tR\n" +
+	"domainType\x12<\n" +
+	"\aoptions\x18\x02 \x01(\v2\".skip.ironbird.LoadBalancerOptionsR\aoptions\"\xd0\x01\n" +
+	"\x10LoadBalancerSpec\x12<\n" +
+	"\aoptions\x18\x01 \x01(\v2\".skip.ironbird.LoadBalancerOptionsR\aoptions\x12O\n" +
+	"\x0edomain_options\x18\x02 \x03(\v2(.skip.ironbird.LoadBalancerDomainOptionsR\rdomainOptions\x12-\n" +
+	"\x12exclude_validators\x18\x03 \x01(\bR\x11excludeValidators\"q\n" +
+	"\vChainSource\x12\x17\n" +
+	"\agit_url\x18\x01 \x01(\tR\x06gitUrl\x12\x19\n" +
+	"\bgit_auth\x18\x02 \x01(\tR\agitAuth\x12\x14\n" +
+	"\x05patch\x18\x03 \x01(\fR\x05patch\x12\x18\n" +
+	"\atarball\x18\x04 \x01(\fR\atarball\"H\n" +
+	"\fGoModReplace\x12\x16\n" +
+	"\x06module\x18\x01 \x01(\tR\x06module\x12 \n" +
+	"\vreplacement\x18\x02 \x01(\tR\vreplacement\"i\n" +
+	"\x05Fault\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x14\n" +
+	"\x05after\x18\x02 \x01(\tR\x05after\x12\x1c\n" +
+	"\tvalidator\x18\x03 \x01(\rR\tvalidator\x12\x18\n" +
+	"\atimeout\x18\x04 \x01(\tR\atimeout\"\x96\x01\n" +
+	"\x0fAdditionalChain\x12\x12\n" +
+	"\x04repo\x18\x01 \x01(\tR\x04repo\x12\x10\n" +
+	"\x03sha\x18\x02 \x01(\tR\x03sha\x12\x1e\n" +
+	"\n" +
+	"isEvmChain\x18\x03 \x01(\bR\n" +
+	"isEvmChain\x12=\n" +
+	"\fchain_config\x18\x04 \x01(\v2\x1a.skip.ironbird.ChainConfigR\vchainConfig\"k\n" +
+	"\aRelayer\x12\x14\n" +
+	"\x05image\x18\x01 \x01(\tR\x05image\x12J\n" +
+	"\x11ibc_transfer_load\x18\x02 \x01(\v2\x1e.skip.ironbird.IBCTransferLoadR\x0fibcTransferLoad\"e\n" +
+	"\x0fIBCTransferLoad\x12\x1a\n" +
+	"\bduration\x18\x01 \x01(\tR\bduration\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x04R\x06amount\x12\x1e\n" +
+	"\vmsgs_per_tx\x18\x03 \x01(\x05R\tmsgsPerTx\"\xb9\x01\n" +
+	"\rCustomGenesis\x12\x1f\n" +
+	"\vgenesis_url\x18\x01 \x01(\tR\n" +
+	"genesisUrl\x12%\n" +
+	"\x0egenesis_sha256\x18\x02 \x01(\tR\rgenesisSha256\x12,\n" +
+	"\x12validator_keys_url\x18\x03 \x01(\tR\x10validatorKeysUrl\x122\n" +
+	"\x15validator_keys_sha256\x18\x04 \x01(\tR\x13validatorKeysSha256\"\xc5\x01\n" +
+	"\x10GenesisMigration\x12#\n" +
+	"\rexport_height\x18\x01 \x01(\x04R\fexportHeight\x12\x10\n" +
+	"\x03sha\x18\x02 \x01(\tR\x03sha\x12+\n" +
+	"\x11migration_command\x18\x03 \x03(\tR\x10migrationCommand\x12M\n" +
+	"\x15genesis_modifications\x18\x04 \x03(\v2\x18.skip.ironbird.GenesisKVR\x14genesisModifications\"3\n" +
 	"\tGenesisKV\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value\"p\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"\x98\x02\n" +
 	"\fRegionConfig\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\fnum_of_nodes\x18\x02 \x01(\x04R\n" +
 	"numOfNodes\x12*\n" +
-	"\x11num_of_validators\x18\x03 \x01(\x04R\x0fnumOfValidators\"\x9e\x04\n" +
+	"\x11num_of_validators\x18\x03 \x01(\x04R\x0fnumOfValidators\x12\x10\n" +
+	"\x03sha\x18\x04 \x01(\tR\x03sha\x12*\n" +
+	"\x11custom_app_config\x18\x05 \x01(\tR\x0fcustomAppConfig\x126\n" +
+	"\x17custom_consensus_config\x18\x06 \x01(\tR\x15customConsensusConfig\x120\n" +
+	"\x14custom_client_config\x18\a \x01(\tR\x12customClientConfig\"\xd4\x01\n" +
+	"\x0eConfigOverride\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\x12\x18\n" +
+	"\aindices\x18\x02 \x03(\rR\aindices\x12*\n" +
+	"\x11custom_app_config\x18\x03 \x01(\tR\x0fcustomAppConfig\x126\n" +
+	"\x17custom_consensus_config\x18\x04 \x01(\tR\x15customConsensusConfig\x120\n" +
+	"\x14custom_client_config\x18\x05 \x01(\tR\x12customClientConfig\"l\n" +
+	"\n" +
+	"ImageGroup\x12\x10\n" +
+	"\x03sha\x18\x01 \x01(\tR\x03sha\x12*\n" +
+	"\x11num_of_validators\x18\x02 \x01(\x04R\x0fnumOfValidators\x12 \n" +
+	"\fnum_of_nodes\x18\x03 \x01(\x04R\n" +
+	"numOfNodes\"\xee\x06\n" +
 	"\vChainConfig\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\fnum_of_nodes\x18\x02 \x01(\x04R\n" +
@@ -2097,7 +4327,31 @@ const file_server_proto_ironbird_proto_rawDesc = "" +
 	"\x14set_persistent_peers\x18\n" +
 	" \x01(\bR\x12setPersistentPeers\x12B\n" +
 	"\x0eregion_configs\x18\v \x03(\v2\x1b.skip.ironbird.RegionConfigR\rregionConfigs\x12\x18\n" +
-	"\aversion\x18\f \x01(\tR\aversion\"5\n" +
+	"\aversion\x18\f \x01(\tR\aversion\x12<\n" +
+	"\fimage_groups\x18\r \x03(\v2\x19.skip.ironbird.ImageGroupR\vimageGroups\x12H\n" +
+	"\x10config_overrides\x18\x0e \x03(\v2\x1d.skip.ironbird.ConfigOverrideR\x0fconfigOverrides\x12O\n" +
+	"\x12stake_distribution\x18\x0f \x01(\v2 .skip.ironbird.StakeDistributionR\x11stakeDistribution\x123\n" +
+	"\btopology\x18\x10 \x01(\v2\x17.skip.ironbird.TopologyR\btopology\x12@\n" +
+	"\rremote_signer\x18\x11 \x01(\v2\x1b.skip.ironbird.RemoteSignerR\fremoteSigner\"Z\n" +
+	"\fRemoteSigner\x12\x14\n" +
+	"\x05image\x18\x01 \x01(\tR\x05image\x12\x16\n" +
+	"\x06shares\x18\x02 \x01(\rR\x06shares\x12\x1c\n" +
+	"\tthreshold\x18\x03 \x01(\rR\tthreshold\"\xb4\x01\n" +
+	"\bTopology\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x124\n" +
+	"\x16sentries_per_validator\x18\x02 \x01(\rR\x14sentriesPerValidator\x12\x16\n" +
+	"\x06degree\x18\x03 \x01(\rR\x06degree\x12\x12\n" +
+	"\x04seed\x18\x04 \x01(\x03R\x04seed\x122\n" +
+	"\x05peers\x18\x05 \x03(\v2\x1c.skip.ironbird.TopologyPeersR\x05peers\"9\n" +
+	"\rTopologyPeers\x12\x12\n" +
+	"\x04node\x18\x01 \x01(\tR\x04node\x12\x14\n" +
+	"\x05peers\x18\x02 \x03(\tR\x05peers\"\x8f\x01\n" +
+	"\x11StakeDistribution\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x18\n" +
+	"\aamounts\x18\x02 \x03(\x04R\aamounts\x12\x1a\n" +
+	"\bexponent\x18\x03 \x01(\x01R\bexponent\x12\x13\n" +
+	"\x05top_n\x18\x04 \x01(\rR\x04topN\x12\x1b\n" +
+	"\ttop_share\x18\x05 \x01(\x01R\btopShare\"5\n" +
 	"\x12GetWorkflowRequest\x12\x1f\n" +
 	"\vworkflow_id\x18\x01 \x01(\tR\n" +
 	"workflowId\"D\n" +
@@ -2106,19 +4360,23 @@ const file_server_proto_ironbird_proto_rawDesc = "" +
 	"\x06offset\x18\x02 \x01(\x05R\x06offset\"8\n" +
 	"\x15CancelWorkflowRequest\x12\x1f\n" +
 	"\vworkflow_id\x18\x01 \x01(\tR\n" +
-	"workflowId\"Y\n" +
+	"workflowId\"7\n" +
+	"\x14ForceTeardownRequest\x12\x1f\n" +
+	"\vworkflow_id\x18\x01 \x01(\tR\n" +
+	"workflowId\"s\n" +
 	"\x15SignalWorkflowRequest\x12\x1f\n" +
 	"\vworkflow_id\x18\x01 \x01(\tR\n" +
 	"workflowId\x12\x1f\n" +
 	"\vsignal_name\x18\x02 \x01(\tR\n" +
-	"signalName\"[\n" +
+	"signalName\x12\x18\n" +
+	"\apayload\x18\x03 \x01(\tR\apayload\"[\n" +
 	"\x12RunLoadTestRequest\x12\x1f\n" +
 	"\vworkflow_id\x18\x01 \x01(\tR\n" +
 	"workflowId\x12$\n" +
 	"\x0eload_test_spec\x18\x02 \x01(\tR\floadTestSpec\"3\n" +
 	"\x10WorkflowResponse\x12\x1f\n" +
 	"\vworkflow_id\x18\x01 \x01(\tR\n" +
-	"workflowId\"\x9a\x01\n" +
+	"workflowId\"\xb0\x01\n" +
 	"\x04Node\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
 	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x10\n" +
@@ -2126,13 +4384,14 @@ const file_server_proto_ironbird_proto_rawDesc = "" +
 	"\x03lcd\x18\x04 \x01(\tR\x03lcd\x12\x12\n" +
 	"\x04grpc\x18\x05 \x01(\tR\x04grpc\x12\x16\n" +
 	"\x06evmrpc\x18\x06 \x01(\tR\x06evmrpc\x12\x14\n" +
-	"\x05evmws\x18\a \x01(\tR\x05evmws\"\xaa\x01\n" +
+	"\x05evmws\x18\a \x01(\tR\x05evmws\x12\x14\n" +
+	"\x05image\x18\b \x01(\tR\x05image\"\xaa\x01\n" +
 	"\n" +
 	"WalletInfo\x12%\n" +
 	"\x0efaucet_address\x18\x01 \x01(\tR\rfaucetAddress\x12'\n" +
 	"\x0ffaucet_mnemonic\x18\x02 \x01(\tR\x0efaucetMnemonic\x12%\n" +
 	"\x0euser_addresses\x18\x03 \x03(\tR\ruserAddresses\x12%\n" +
-	"\x0euser_mnemonics\x18\x04 \x03(\tR\ruserMnemonics\"\xd6\x04\n" +
+	"\x0euser_mnemonics\x18\x04 \x03(\tR\ruserMnemonics\"\xf1\x06\n" +
 	"\bWorkflow\x12\x1f\n" +
 	"\vworkflow_id\x18\x01 \x01(\tR\n" +
 	"workflowId\x12\x16\n" +
@@ -2151,10 +4410,33 @@ const file_server_proto_ironbird_proto_rawDesc = "" +
 	"\bprovider\x18\x13 \x01(\tR\bprovider\x12\x1d\n" +
 	"\n" +
 	"start_time\x18\x14 \x01(\tR\tstartTime\x12\x19\n" +
-	"\bend_time\x18\x15 \x01(\tR\aendTime\x1a=\n" +
+	"\bend_time\x18\x15 \x01(\tR\aendTime\x12\x14\n" +
+	"\x05phase\x18\x16 \x01(\tR\x05phase\x12\x19\n" +
+	"\bchain_id\x18\x17 \x01(\tR\achainId\x12\x16\n" +
+	"\x06height\x18\x18 \x01(\x04R\x06height\x12:\n" +
+	"\vnode_health\x18\x19 \x03(\v2\x19.skip.ironbird.NodeHealthR\n" +
+	"nodeHealth\x12*\n" +
+	"\x11health_checked_at\x18\x1a \x01(\tR\x0fhealthCheckedAt\x12<\n" +
+	"\tload_test\x18\x1b \x01(\v2\x1f.skip.ironbird.LoadTestProgressR\bloadTest\x12*\n" +
+	"\x11expected_end_time\x18\x1c \x01(\tR\x0fexpectedEndTime\x1a=\n" +
 	"\x0fMonitoringEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe7\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"N\n" +
+	"\n" +
+	"NodeHealth\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06height\x18\x02 \x01(\x04R\x06height\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\xc2\x02\n" +
+	"\x10LoadTestProgress\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x02 \x01(\tR\aendTime\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12-\n" +
+	"\x12total_transactions\x18\x04 \x01(\x03R\x11totalTransactions\x123\n" +
+	"\x15included_transactions\x18\x05 \x01(\x03R\x14includedTransactions\x127\n" +
+	"\x17successful_transactions\x18\x06 \x01(\x03R\x16successfulTransactions\x12/\n" +
+	"\x13failed_transactions\x18\a \x01(\x03R\x12failedTransactions\x12\x10\n" +
+	"\x03tps\x18\b \x01(\x01R\x03tps\"\xe7\x01\n" +
 	"\x0fWorkflowSummary\x12\x1f\n" +
 	"\vworkflow_id\x18\x01 \x01(\tR\n" +
 	"workflowId\x12\x16\n" +
@@ -2182,7 +4464,49 @@ const file_server_proto_ironbird_proto_rawDesc = "" +
 	"\bprovider\x18\a \x01(\tR\bprovider\x1a=\n" +
 	"\x0fMonitoringEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x91\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"i\n" +
+	"\x16AppendBuildLogsRequest\x12\x1f\n" +
+	"\vworkflow_id\x18\x01 \x01(\tR\n" +
+	"workflowId\x12\x14\n" +
+	"\x05build\x18\x02 \x01(\tR\x05build\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\"\x19\n" +
+	"\x17AppendBuildLogsResponse\"l\n" +
+	"\x16StreamBuildLogsRequest\x12\x1f\n" +
+	"\vworkflow_id\x18\x01 \x01(\tR\n" +
+	"workflowId\x12\x16\n" +
+	"\x06follow\x18\x02 \x01(\bR\x06follow\x12\x19\n" +
+	"\bafter_id\x18\x03 \x01(\x03R\aafterId\"\x8f\x01\n" +
+	"\rBuildLogChunk\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
+	"\vworkflow_id\x18\x02 \x01(\tR\n" +
+	"workflowId\x12\x14\n" +
+	"\x05build\x18\x03 \x01(\tR\x05build\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\"\x83\x01\n" +
+	"\x18SaveProviderStateRequest\x12\x1f\n" +
+	"\vworkflow_id\x18\x01 \x01(\tR\n" +
+	"workflowId\x12\x1f\n" +
+	"\vrunner_type\x18\x02 \x01(\tR\n" +
+	"runnerType\x12%\n" +
+	"\x0eprovider_state\x18\x03 \x01(\fR\rproviderState\"\x1b\n" +
+	"\x19SaveProviderStateResponse\"n\n" +
+	"\x1dUploadWorkflowArtifactRequest\x12\x1f\n" +
+	"\vworkflow_id\x18\x01 \x01(\tR\n" +
+	"workflowId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\" \n" +
+	"\x1eUploadWorkflowArtifactResponse\">\n" +
+	"\x1bGetWorkflowArtifactsRequest\x12\x1f\n" +
+	"\vworkflow_id\x18\x01 \x01(\tR\n" +
+	"workflowId\"_\n" +
+	"\x10WorkflowArtifact\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\"Z\n" +
+	"\x19WorkflowArtifactsResponse\x12=\n" +
+	"\tartifacts\x18\x01 \x03(\v2\x1f.skip.ironbird.WorkflowArtifactR\tartifacts\"\x91\x01\n" +
 	"\x14WorkflowListResponse\x12<\n" +
 	"\tworkflows\x18\x01 \x03(\v2\x1e.skip.ironbird.WorkflowSummaryR\tworkflows\x12%\n" +
 	"\x0ereturned_count\x18\x02 \x01(\x05R\rreturnedCount\x12\x14\n" +
@@ -2249,15 +4573,21 @@ const file_server_proto_ironbird_proto_rawDesc = "" +
 	"\x06offset\x18\x03 \x01(\x05R\x06offset\"s\n" +
 	"\x1aTemplateRunHistoryResponse\x12.\n" +
 	"\x04runs\x18\x01 \x03(\v2\x1a.skip.ironbird.TemplateRunR\x04runs\x12%\n" +
-	"\x0ereturned_count\x18\x02 \x01(\x05R\rreturnedCount2\x97\v\n" +
+	"\x0ereturned_count\x18\x02 \x01(\x05R\rreturnedCount2\x83\x10\n" +
 	"\x0fIronbirdService\x12Y\n" +
 	"\x0eCreateWorkflow\x12$.skip.ironbird.CreateWorkflowRequest\x1a\x1f.skip.ironbird.WorkflowResponse\"\x00\x12K\n" +
 	"\vGetWorkflow\x12!.skip.ironbird.GetWorkflowRequest\x1a\x17.skip.ironbird.Workflow\"\x00\x12[\n" +
 	"\rListWorkflows\x12#.skip.ironbird.ListWorkflowsRequest\x1a#.skip.ironbird.WorkflowListResponse\"\x00\x12Y\n" +
 	"\x0eCancelWorkflow\x12$.skip.ironbird.CancelWorkflowRequest\x1a\x1f.skip.ironbird.WorkflowResponse\"\x00\x12Y\n" +
-	"\x0eSignalWorkflow\x12$.skip.ironbird.SignalWorkflowRequest\x1a\x1f.skip.ironbird.WorkflowResponse\"\x00\x12S\n" +
+	"\x0eSignalWorkflow\x12$.skip.ironbird.SignalWorkflowRequest\x1a\x1f.skip.ironbird.WorkflowResponse\"\x00\x12W\n" +
+	"\rForceTeardown\x12#.skip.ironbird.ForceTeardownRequest\x1a\x1f.skip.ironbird.WorkflowResponse\"\x00\x12S\n" +
 	"\vRunLoadTest\x12!.skip.ironbird.RunLoadTestRequest\x1a\x1f.skip.ironbird.WorkflowResponse\"\x00\x12a\n" +
-	"\x12UpdateWorkflowData\x12(.skip.ironbird.UpdateWorkflowDataRequest\x1a\x1f.skip.ironbird.WorkflowResponse\"\x00\x12q\n" +
+	"\x12UpdateWorkflowData\x12(.skip.ironbird.UpdateWorkflowDataRequest\x1a\x1f.skip.ironbird.WorkflowResponse\"\x00\x12b\n" +
+	"\x0fAppendBuildLogs\x12%.skip.ironbird.AppendBuildLogsRequest\x1a&.skip.ironbird.AppendBuildLogsResponse\"\x00\x12Z\n" +
+	"\x0fStreamBuildLogs\x12%.skip.ironbird.StreamBuildLogsRequest\x1a\x1c.skip.ironbird.BuildLogChunk\"\x000\x01\x12h\n" +
+	"\x11SaveProviderState\x12'.skip.ironbird.SaveProviderStateRequest\x1a(.skip.ironbird.SaveProviderStateResponse\"\x00\x12w\n" +
+	"\x16UploadWorkflowArtifact\x12,.skip.ironbird.UploadWorkflowArtifactRequest\x1a-.skip.ironbird.UploadWorkflowArtifactResponse\"\x00\x12n\n" +
+	"\x14GetWorkflowArtifacts\x12*.skip.ironbird.GetWorkflowArtifactsRequest\x1a(.skip.ironbird.WorkflowArtifactsResponse\"\x00\x12q\n" +
 	"\x16CreateWorkflowTemplate\x12,.skip.ironbird.CreateWorkflowTemplateRequest\x1a'.skip.ironbird.WorkflowTemplateResponse\"\x00\x12c\n" +
 	"\x13GetWorkflowTemplate\x12).skip.ironbird.GetWorkflowTemplateRequest\x1a\x1f.skip.ironbird.WorkflowTemplate\"\x00\x12s\n" +
 	"\x15ListWorkflowTemplates\x12+.skip.ironbird.ListWorkflowTemplatesRequest\x1a+.skip.ironbird.WorkflowTemplateListResponse\"\x00\x12q\n" +
@@ -2278,98 +4608,164 @@ func file_server_proto_ironbird_proto_rawDescGZIP() []byte {
 	return file_server_proto_ironbird_proto_rawDescData
 }
 
-var file_server_proto_ironbird_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
+var file_server_proto_ironbird_proto_msgTypes = make([]protoimpl.MessageInfo, 64)
 var file_server_proto_ironbird_proto_goTypes = []any{
 	(*CreateWorkflowRequest)(nil),          // 0: skip.ironbird.CreateWorkflowRequest
-	(*GenesisKV)(nil),                      // 1: skip.ironbird.GenesisKV
-	(*RegionConfig)(nil),                   // 2: skip.ironbird.RegionConfig
-	(*ChainConfig)(nil),                    // 3: skip.ironbird.ChainConfig
-	(*GetWorkflowRequest)(nil),             // 4: skip.ironbird.GetWorkflowRequest
-	(*ListWorkflowsRequest)(nil),           // 5: skip.ironbird.ListWorkflowsRequest
-	(*CancelWorkflowRequest)(nil),          // 6: skip.ironbird.CancelWorkflowRequest
-	(*SignalWorkflowRequest)(nil),          // 7: skip.ironbird.SignalWorkflowRequest
-	(*RunLoadTestRequest)(nil),             // 8: skip.ironbird.RunLoadTestRequest
-	(*WorkflowResponse)(nil),               // 9: skip.ironbird.WorkflowResponse
-	(*Node)(nil),                           // 10: skip.ironbird.Node
-	(*WalletInfo)(nil),                     // 11: skip.ironbird.WalletInfo
-	(*Workflow)(nil),                       // 12: skip.ironbird.Workflow
-	(*WorkflowSummary)(nil),                // 13: skip.ironbird.WorkflowSummary
-	(*UpdateWorkflowDataRequest)(nil),      // 14: skip.ironbird.UpdateWorkflowDataRequest
-	(*WorkflowListResponse)(nil),           // 15: skip.ironbird.WorkflowListResponse
-	(*WorkflowTemplate)(nil),               // 16: skip.ironbird.WorkflowTemplate
-	(*CreateWorkflowTemplateRequest)(nil),  // 17: skip.ironbird.CreateWorkflowTemplateRequest
-	(*GetWorkflowTemplateRequest)(nil),     // 18: skip.ironbird.GetWorkflowTemplateRequest
-	(*ListWorkflowTemplatesRequest)(nil),   // 19: skip.ironbird.ListWorkflowTemplatesRequest
-	(*UpdateWorkflowTemplateRequest)(nil),  // 20: skip.ironbird.UpdateWorkflowTemplateRequest
-	(*DeleteWorkflowTemplateRequest)(nil),  // 21: skip.ironbird.DeleteWorkflowTemplateRequest
-	(*WorkflowTemplateResponse)(nil),       // 22: skip.ironbird.WorkflowTemplateResponse
-	(*WorkflowTemplateSummary)(nil),        // 23: skip.ironbird.WorkflowTemplateSummary
-	(*WorkflowTemplateListResponse)(nil),   // 24: skip.ironbird.WorkflowTemplateListResponse
-	(*ExecuteWorkflowTemplateRequest)(nil), // 25: skip.ironbird.ExecuteWorkflowTemplateRequest
-	(*TemplateRun)(nil),                    // 26: skip.ironbird.TemplateRun
-	(*GetTemplateRunHistoryRequest)(nil),   // 27: skip.ironbird.GetTemplateRunHistoryRequest
-	(*TemplateRunHistoryResponse)(nil),     // 28: skip.ironbird.TemplateRunHistoryResponse
-	nil,                                    // 29: skip.ironbird.CreateWorkflowRequest.ProviderConfigEntry
-	nil,                                    // 30: skip.ironbird.Workflow.MonitoringEntry
-	nil,                                    // 31: skip.ironbird.UpdateWorkflowDataRequest.MonitoringEntry
-	nil,                                    // 32: skip.ironbird.TemplateRun.MonitoringLinksEntry
+	(*LoadBalancerOptions)(nil),            // 1: skip.ironbird.LoadBalancerOptions
+	(*LoadBalancerDomainOptions)(nil),      // 2: skip.ironbird.LoadBalancerDomainOptions
+	(*LoadBalancerSpec)(nil),               // 3: skip.ironbird.LoadBalancerSpec
+	(*ChainSource)(nil),                    // 4: skip.ironbird.ChainSource
+	(*GoModReplace)(nil),                   // 5: skip.ironbird.GoModReplace
+	(*Fault)(nil),                          // 6: skip.ironbird.Fault
+	(*AdditionalChain)(nil),                // 7: skip.ironbird.AdditionalChain
+	(*Relayer)(nil),                        // 8: skip.ironbird.Relayer
+	(*IBCTransferLoad)(nil),                // 9: skip.ironbird.IBCTransferLoad
+	(*CustomGenesis)(nil),                  // 10: skip.ironbird.CustomGenesis
+	(*GenesisMigration)(nil),               // 11: skip.ironbird.GenesisMigration
+	(*GenesisKV)(nil),                      // 12: skip.ironbird.GenesisKV
+	(*RegionConfig)(nil),                   // 13: skip.ironbird.RegionConfig
+	(*ConfigOverride)(nil),                 // 14: skip.ironbird.ConfigOverride
+	(*ImageGroup)(nil),                     // 15: skip.ironbird.ImageGroup
+	(*ChainConfig)(nil),                    // 16: skip.ironbird.ChainConfig
+	(*RemoteSigner)(nil),                   // 17: skip.ironbird.RemoteSigner
+	(*Topology)(nil),                       // 18: skip.ironbird.Topology
+	(*TopologyPeers)(nil),                  // 19: skip.ironbird.TopologyPeers
+	(*StakeDistribution)(nil),              // 20: skip.ironbird.StakeDistribution
+	(*GetWorkflowRequest)(nil),             // 21: skip.ironbird.GetWorkflowRequest
+	(*ListWorkflowsRequest)(nil),           // 22: skip.ironbird.ListWorkflowsRequest
+	(*CancelWorkflowRequest)(nil),          // 23: skip.ironbird.CancelWorkflowRequest
+	(*ForceTeardownRequest)(nil),           // 24: skip.ironbird.ForceTeardownRequest
+	(*SignalWorkflowRequest)(nil),          // 25: skip.ironbird.SignalWorkflowRequest
+	(*RunLoadTestRequest)(nil),             // 26: skip.ironbird.RunLoadTestRequest
+	(*WorkflowResponse)(nil),               // 27: skip.ironbird.WorkflowResponse
+	(*Node)(nil),                           // 28: skip.ironbird.Node
+	(*WalletInfo)(nil),                     // 29: skip.ironbird.WalletInfo
+	(*Workflow)(nil),                       // 30: skip.ironbird.Workflow
+	(*NodeHealth)(nil),                     // 31: skip.ironbird.NodeHealth
+	(*LoadTestProgress)(nil),               // 32: skip.ironbird.LoadTestProgress
+	(*WorkflowSummary)(nil),                // 33: skip.ironbird.WorkflowSummary
+	(*UpdateWorkflowDataRequest)(nil),      // 34: skip.ironbird.UpdateWorkflowDataRequest
+	(*AppendBuildLogsRequest)(nil),         // 35: skip.ironbird.AppendBuildLogsRequest
+	(*AppendBuildLogsResponse)(nil),        // 36: skip.ironbird.AppendBuildLogsResponse
+	(*StreamBuildLogsRequest)(nil),         // 37: skip.ironbird.StreamBuildLogsRequest
+	(*BuildLogChunk)(nil),                  // 38: skip.ironbird.BuildLogChunk
+	(*SaveProviderStateRequest)(nil),       // 39: skip.ironbird.SaveProviderStateRequest
+	(*SaveProviderStateResponse)(nil),      // 40: skip.ironbird.SaveProviderStateResponse
+	(*UploadWorkflowArtifactRequest)(nil),  // 41: skip.ironbird.UploadWorkflowArtifactRequest
+	(*UploadWorkflowArtifactResponse)(nil), // 42: skip.ironbird.UploadWorkflowArtifactResponse
+	(*GetWorkflowArtifactsRequest)(nil),    // 43: skip.ironbird.GetWorkflowArtifactsRequest
+	(*WorkflowArtifact)(nil),               // 44: skip.ironbird.WorkflowArtifact
+	(*WorkflowArtifactsResponse)(nil),      // 45: skip.ironbird.WorkflowArtifactsResponse
+	(*WorkflowListResponse)(nil),           // 46: skip.ironbird.WorkflowListResponse
+	(*WorkflowTemplate)(nil),               // 47: skip.ironbird.WorkflowTemplate
+	(*CreateWorkflowTemplateRequest)(nil),  // 48: skip.ironbird.CreateWorkflowTemplateRequest
+	(*GetWorkflowTemplateRequest)(nil),     // 49: skip.ironbird.GetWorkflowTemplateRequest
+	(*ListWorkflowTemplatesRequest)(nil),   // 50: skip.ironbird.ListWorkflowTemplatesRequest
+	(*UpdateWorkflowTemplateRequest)(nil),  // 51: skip.ironbird.UpdateWorkflowTemplateRequest
+	(*DeleteWorkflowTemplateRequest)(nil),  // 52: skip.ironbird.DeleteWorkflowTemplateRequest
+	(*WorkflowTemplateResponse)(nil),       // 53: skip.ironbird.WorkflowTemplateResponse
+	(*WorkflowTemplateSummary)(nil),        // 54: skip.ironbird.WorkflowTemplateSummary
+	(*WorkflowTemplateListResponse)(nil),   // 55: skip.ironbird.WorkflowTemplateListResponse
+	(*ExecuteWorkflowTemplateRequest)(nil), // 56: skip.ironbird.ExecuteWorkflowTemplateRequest
+	(*TemplateRun)(nil),                    // 57: skip.ironbird.TemplateRun
+	(*GetTemplateRunHistoryRequest)(nil),   // 58: skip.ironbird.GetTemplateRunHistoryRequest
+	(*TemplateRunHistoryResponse)(nil),     // 59: skip.ironbird.TemplateRunHistoryResponse
+	nil,                                    // 60: skip.ironbird.CreateWorkflowRequest.ProviderConfigEntry
+	nil,                                    // 61: skip.ironbird.Workflow.MonitoringEntry
+	nil,                                    // 62: skip.ironbird.UpdateWorkflowDataRequest.MonitoringEntry
+	nil,                                    // 63: skip.ironbird.TemplateRun.MonitoringLinksEntry
 }
 var file_server_proto_ironbird_proto_depIdxs = []int32{
-	3,  // 0: skip.ironbird.CreateWorkflowRequest.chain_config:type_name -> skip.ironbird.ChainConfig
-	29, // 1: skip.ironbird.CreateWorkflowRequest.provider_config:type_name -> skip.ironbird.CreateWorkflowRequest.ProviderConfigEntry
-	1,  // 2: skip.ironbird.ChainConfig.genesis_modifications:type_name -> skip.ironbird.GenesisKV
-	2,  // 3: skip.ironbird.ChainConfig.region_configs:type_name -> skip.ironbird.RegionConfig
-	10, // 4: skip.ironbird.Workflow.nodes:type_name -> skip.ironbird.Node
-	10, // 5: skip.ironbird.Workflow.validators:type_name -> skip.ironbird.Node
-	10, // 6: skip.ironbird.Workflow.load_balancers:type_name -> skip.ironbird.Node
-	30, // 7: skip.ironbird.Workflow.monitoring:type_name -> skip.ironbird.Workflow.MonitoringEntry
-	0,  // 8: skip.ironbird.Workflow.config:type_name -> skip.ironbird.CreateWorkflowRequest
-	11, // 9: skip.ironbird.Workflow.wallets:type_name -> skip.ironbird.WalletInfo
-	10, // 10: skip.ironbird.UpdateWorkflowDataRequest.load_balancers:type_name -> skip.ironbird.Node
-	31, // 11: skip.ironbird.UpdateWorkflowDataRequest.monitoring:type_name -> skip.ironbird.UpdateWorkflowDataRequest.MonitoringEntry
-	10, // 12: skip.ironbird.UpdateWorkflowDataRequest.nodes:type_name -> skip.ironbird.Node
-	10, // 13: skip.ironbird.UpdateWorkflowDataRequest.validators:type_name -> skip.ironbird.Node
-	11, // 14: skip.ironbird.UpdateWorkflowDataRequest.wallets:type_name -> skip.ironbird.WalletInfo
-	13, // 15: skip.ironbird.WorkflowListResponse.workflows:type_name -> skip.ironbird.WorkflowSummary
-	0,  // 16: skip.ironbird.WorkflowTemplate.template_config:type_name -> skip.ironbird.CreateWorkflowRequest
-	0,  // 17: skip.ironbird.CreateWorkflowTemplateRequest.template_config:type_name -> skip.ironbird.CreateWorkflowRequest
-	0,  // 18: skip.ironbird.UpdateWorkflowTemplateRequest.template_config:type_name -> skip.ironbird.CreateWorkflowRequest
-	23, // 19: skip.ironbird.WorkflowTemplateListResponse.templates:type_name -> skip.ironbird.WorkflowTemplateSummary
-	32, // 20: skip.ironbird.TemplateRun.monitoring_links:type_name -> skip.ironbird.TemplateRun.MonitoringLinksEntry
-	26, // 21: skip.ironbird.TemplateRunHistoryResponse.runs:type_name -> skip.ironbird.TemplateRun
-	0,  // 22: skip.ironbird.IronbirdService.CreateWorkflow:input_type -> skip.ironbird.CreateWorkflowRequest
-	4,  // 23: skip.ironbird.IronbirdService.GetWorkflow:input_type -> skip.ironbird.GetWorkflowRequest
-	5,  // 24: skip.ironbird.IronbirdService.ListWorkflows:input_type -> skip.ironbird.ListWorkflowsRequest
-	6,  // 25: skip.ironbird.IronbirdService.CancelWorkflow:input_type -> skip.ironbird.CancelWorkflowRequest
-	7,  // 26: skip.ironbird.IronbirdService.SignalWorkflow:input_type -> skip.ironbird.SignalWorkflowRequest
-	8,  // 27: skip.ironbird.IronbirdService.RunLoadTest:input_type -> skip.ironbird.RunLoadTestRequest
-	14, // 28: skip.ironbird.IronbirdService.UpdateWorkflowData:input_type -> skip.ironbird.UpdateWorkflowDataRequest
-	17, // 29: skip.ironbird.IronbirdService.CreateWorkflowTemplate:input_type -> skip.ironbird.CreateWorkflowTemplateRequest
-	18, // 30: skip.ironbird.IronbirdService.GetWorkflowTemplate:input_type -> skip.ironbird.GetWorkflowTemplateRequest
-	19, // 31: skip.ironbird.IronbirdService.ListWorkflowTemplates:input_type -> skip.ironbird.ListWorkflowTemplatesRequest
-	20, // 32: skip.ironbird.IronbirdService.UpdateWorkflowTemplate:input_type -> skip.ironbird.UpdateWorkflowTemplateRequest
-	21, // 33: skip.ironbird.IronbirdService.DeleteWorkflowTemplate:input_type -> skip.ironbird.DeleteWorkflowTemplateRequest
-	25, // 34: skip.ironbird.IronbirdService.ExecuteWorkflowTemplate:input_type -> skip.ironbird.ExecuteWorkflowTemplateRequest
-	27, // 35: skip.ironbird.IronbirdService.GetTemplateRunHistory:input_type -> skip.ironbird.GetTemplateRunHistoryRequest
-	9,  // 36: skip.ironbird.IronbirdService.CreateWorkflow:output_type -> skip.ironbird.WorkflowResponse
-	12, // 37: skip.ironbird.IronbirdService.GetWorkflow:output_type -> skip.ironbird.Workflow
-	15, // 38: skip.ironbird.IronbirdService.ListWorkflows:output_type -> skip.ironbird.WorkflowListResponse
-	9,  // 39: skip.ironbird.IronbirdService.CancelWorkflow:output_type -> skip.ironbird.WorkflowResponse
-	9,  // 40: skip.ironbird.IronbirdService.SignalWorkflow:output_type -> skip.ironbird.WorkflowResponse
-	9,  // 41: skip.ironbird.IronbirdService.RunLoadTest:output_type -> skip.ironbird.WorkflowResponse
-	9,  // 42: skip.ironbird.IronbirdService.UpdateWorkflowData:output_type -> skip.ironbird.WorkflowResponse
-	22, // 43: skip.ironbird.IronbirdService.CreateWorkflowTemplate:output_type -> skip.ironbird.WorkflowTemplateResponse
-	16, // 44: skip.ironbird.IronbirdService.GetWorkflowTemplate:output_type -> skip.ironbird.WorkflowTemplate
-	24, // 45: skip.ironbird.IronbirdService.ListWorkflowTemplates:output_type -> skip.ironbird.WorkflowTemplateListResponse
-	22, // 46: skip.ironbird.IronbirdService.UpdateWorkflowTemplate:output_type -> skip.ironbird.WorkflowTemplateResponse
-	22, // 47: skip.ironbird.IronbirdService.DeleteWorkflowTemplate:output_type -> skip.ironbird.WorkflowTemplateResponse
-	9,  // 48: skip.ironbird.IronbirdService.ExecuteWorkflowTemplate:output_type -> skip.ironbird.WorkflowResponse
-	28, // 49: skip.ironbird.IronbirdService.GetTemplateRunHistory:output_type -> skip.ironbird.TemplateRunHistoryResponse
-	36, // [36:50] is the sub-list for method output_type
-	22, // [22:36] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
+	16, // 0: skip.ironbird.CreateWorkflowRequest.chain_config:type_name -> skip.ironbird.ChainConfig
+	60, // 1: skip.ironbird.CreateWorkflowRequest.provider_config:type_name -> skip.ironbird.CreateWorkflowRequest.ProviderConfigEntry
+	11, // 2: skip.ironbird.CreateWorkflowRequest.genesis_migration:type_name -> skip.ironbird.GenesisMigration
+	10, // 3: skip.ironbird.CreateWorkflowRequest.custom_genesis:type_name -> skip.ironbird.CustomGenesis
+	7,  // 4: skip.ironbird.CreateWorkflowRequest.additional_chains:type_name -> skip.ironbird.AdditionalChain
+	8,  // 5: skip.ironbird.CreateWorkflowRequest.relayer:type_name -> skip.ironbird.Relayer
+	6,  // 6: skip.ironbird.CreateWorkflowRequest.faults:type_name -> skip.ironbird.Fault
+	5,  // 7: skip.ironbird.CreateWorkflowRequest.go_mod_replaces:type_name -> skip.ironbird.GoModReplace
+	4,  // 8: skip.ironbird.CreateWorkflowRequest.source:type_name -> skip.ironbird.ChainSource
+	3,  // 9: skip.ironbird.CreateWorkflowRequest.load_balancer:type_name -> skip.ironbird.LoadBalancerSpec
+	1,  // 10: skip.ironbird.LoadBalancerDomainOptions.options:type_name -> skip.ironbird.LoadBalancerOptions
+	1,  // 11: skip.ironbird.LoadBalancerSpec.options:type_name -> skip.ironbird.LoadBalancerOptions
+	2,  // 12: skip.ironbird.LoadBalancerSpec.domain_options:type_name -> skip.ironbird.LoadBalancerDomainOptions
+	16, // 13: skip.ironbird.AdditionalChain.chain_config:type_name -> skip.ironbird.ChainConfig
+	9,  // 14: skip.ironbird.Relayer.ibc_transfer_load:type_name -> skip.ironbird.IBCTransferLoad
+	12, // 15: skip.ironbird.GenesisMigration.genesis_modifications:type_name -> skip.ironbird.GenesisKV
+	12, // 16: skip.ironbird.ChainConfig.genesis_modifications:type_name -> skip.ironbird.GenesisKV
+	13, // 17: skip.ironbird.ChainConfig.region_configs:type_name -> skip.ironbird.RegionConfig
+	15, // 18: skip.ironbird.ChainConfig.image_groups:type_name -> skip.ironbird.ImageGroup
+	14, // 19: skip.ironbird.ChainConfig.config_overrides:type_name -> skip.ironbird.ConfigOverride
+	20, // 20: skip.ironbird.ChainConfig.stake_distribution:type_name -> skip.ironbird.StakeDistribution
+	18, // 21: skip.ironbird.ChainConfig.topology:type_name -> skip.ironbird.Topology
+	17, // 22: skip.ironbird.ChainConfig.remote_signer:type_name -> skip.ironbird.RemoteSigner
+	19, // 23: skip.ironbird.Topology.peers:type_name -> skip.ironbird.TopologyPeers
+	28, // 24: skip.ironbird.Workflow.nodes:type_name -> skip.ironbird.Node
+	28, // 25: skip.ironbird.Workflow.validators:type_name -> skip.ironbird.Node
+	28, // 26: skip.ironbird.Workflow.load_balancers:type_name -> skip.ironbird.Node
+	61, // 27: skip.ironbird.Workflow.monitoring:type_name -> skip.ironbird.Workflow.MonitoringEntry
+	0,  // 28: skip.ironbird.Workflow.config:type_name -> skip.ironbird.CreateWorkflowRequest
+	29, // 29: skip.ironbird.Workflow.wallets:type_name -> skip.ironbird.WalletInfo
+	31, // 30: skip.ironbird.Workflow.node_health:type_name -> skip.ironbird.NodeHealth
+	32, // 31: skip.ironbird.Workflow.load_test:type_name -> skip.ironbird.LoadTestProgress
+	28, // 32: skip.ironbird.UpdateWorkflowDataRequest.load_balancers:type_name -> skip.ironbird.Node
+	62, // 33: skip.ironbird.UpdateWorkflowDataRequest.monitoring:type_name -> skip.ironbird.UpdateWorkflowDataRequest.MonitoringEntry
+	28, // 34: skip.ironbird.UpdateWorkflowDataRequest.nodes:type_name -> skip.ironbird.Node
+	28, // 35: skip.ironbird.UpdateWorkflowDataRequest.validators:type_name -> skip.ironbird.Node
+	29, // 36: skip.ironbird.UpdateWorkflowDataRequest.wallets:type_name -> skip.ironbird.WalletInfo
+	44, // 37: skip.ironbird.WorkflowArtifactsResponse.artifacts:type_name -> skip.ironbird.WorkflowArtifact
+	33, // 38: skip.ironbird.WorkflowListResponse.workflows:type_name -> skip.ironbird.WorkflowSummary
+	0,  // 39: skip.ironbird.WorkflowTemplate.template_config:type_name -> skip.ironbird.CreateWorkflowRequest
+	0,  // 40: skip.ironbird.CreateWorkflowTemplateRequest.template_config:type_name -> skip.ironbird.CreateWorkflowRequest
+	0,  // 41: skip.ironbird.UpdateWorkflowTemplateRequest.template_config:type_name -> skip.ironbird.CreateWorkflowRequest
+	54, // 42: skip.ironbird.WorkflowTemplateListResponse.templates:type_name -> skip.ironbird.WorkflowTemplateSummary
+	63, // 43: skip.ironbird.TemplateRun.monitoring_links:type_name -> skip.ironbird.TemplateRun.MonitoringLinksEntry
+	57, // 44: skip.ironbird.TemplateRunHistoryResponse.runs:type_name -> skip.ironbird.TemplateRun
+	0,  // 45: skip.ironbird.IronbirdService.CreateWorkflow:input_type -> skip.ironbird.CreateWorkflowRequest
+	21, // 46: skip.ironbird.IronbirdService.GetWorkflow:input_type -> skip.ironbird.GetWorkflowRequest
+	22, // 47: skip.ironbird.IronbirdService.ListWorkflows:input_type -> skip.ironbird.ListWorkflowsRequest
+	23, // 48: skip.ironbird.IronbirdService.CancelWorkflow:input_type -> skip.ironbird.CancelWorkflowRequest
+	25, // 49: skip.ironbird.IronbirdService.SignalWorkflow:input_type -> skip.ironbird.SignalWorkflowRequest
+	24, // 50: skip.ironbird.IronbirdService.ForceTeardown:input_type -> skip.ironbird.ForceTeardownRequest
+	26, // 51: skip.ironbird.IronbirdService.RunLoadTest:input_type -> skip.ironbird.RunLoadTestRequest
+	34, // 52: skip.ironbird.IronbirdService.UpdateWorkflowData:input_type -> skip.ironbird.UpdateWorkflowDataRequest
+	35, // 53: skip.ironbird.IronbirdService.AppendBuildLogs:input_type -> skip.ironbird.AppendBuildLogsRequest
+	37, // 54: skip.ironbird.IronbirdService.StreamBuildLogs:input_type -> skip.ironbird.StreamBuildLogsRequest
+	39, // 55: skip.ironbird.IronbirdService.SaveProviderState:input_type -> skip.ironbird.SaveProviderStateRequest
+	41, // 56: skip.ironbird.IronbirdService.UploadWorkflowArtifact:input_type -> skip.ironbird.UploadWorkflowArtifactRequest
+	43, // 57: skip.ironbird.IronbirdService.GetWorkflowArtifacts:input_type -> skip.ironbird.GetWorkflowArtifactsRequest
+	48, // 58: skip.ironbird.IronbirdService.CreateWorkflowTemplate:input_type -> skip.ironbird.CreateWorkflowTemplateRequest
+	49, // 59: skip.ironbird.IronbirdService.GetWorkflowTemplate:input_type -> skip.ironbird.GetWorkflowTemplateRequest
+	50, // 60: skip.ironbird.IronbirdService.ListWorkflowTemplates:input_type -> skip.ironbird.ListWorkflowTemplatesRequest
+	51, // 61: skip.ironbird.IronbirdService.UpdateWorkflowTemplate:input_type -> skip.ironbird.UpdateWorkflowTemplateRequest
+	52, // 62: skip.ironbird.IronbirdService.DeleteWorkflowTemplate:input_type -> skip.ironbird.DeleteWorkflowTemplateRequest
+	56, // 63: skip.ironbird.IronbirdService.ExecuteWorkflowTemplate:input_type -> skip.ironbird.ExecuteWorkflowTemplateRequest
+	58, // 64: skip.ironbird.IronbirdService.GetTemplateRunHistory:input_type -> skip.ironbird.GetTemplateRunHistoryRequest
+	27, // 65: skip.ironbird.IronbirdService.CreateWorkflow:output_type -> skip.ironbird.WorkflowResponse
+	30, // 66: skip.ironbird.IronbirdService.GetWorkflow:output_type -> skip.ironbird.Workflow
+	46, // 67: skip.ironbird.IronbirdService.ListWorkflows:output_type -> skip.ironbird.WorkflowListResponse
+	27, // 68: skip.ironbird.IronbirdService.CancelWorkflow:output_type -> skip.ironbird.WorkflowResponse
+	27, // 69: skip.ironbird.IronbirdService.SignalWorkflow:output_type -> skip.ironbird.WorkflowResponse
+	27, // 70: skip.ironbird.IronbirdService.ForceTeardown:output_type -> skip.ironbird.WorkflowResponse
+	27, // 71: skip.ironbird.IronbirdService.RunLoadTest:output_type -> skip.ironbird.WorkflowResponse
+	27, // 72: skip.ironbird.IronbirdService.UpdateWorkflowData:output_type -> skip.ironbird.WorkflowResponse
+	36, // 73: skip.ironbird.IronbirdService.AppendBuildLogs:output_type -> skip.ironbird.AppendBuildLogsResponse
+	38, // 74: skip.ironbird.IronbirdService.StreamBuildLogs:output_type -> skip.ironbird.BuildLogChunk
+	40, // 75: skip.ironbird.IronbirdService.SaveProviderState:output_type -> skip.ironbird.SaveProviderStateResponse
+	42, // 76: skip.ironbird.IronbirdService.UploadWorkflowArtifact:output_type -> skip.ironbird.UploadWorkflowArtifactResponse
+	45, // 77: skip.ironbird.IronbirdService.GetWorkflowArtifacts:output_type -> skip.ironbird.WorkflowArtifactsResponse
+	53, // 78: skip.ironbird.IronbirdService.CreateWorkflowTemplate:output_type -> skip.ironbird.WorkflowTemplateResponse
+	47, // 79: skip.ironbird.IronbirdService.GetWorkflowTemplate:output_type -> skip.ironbird.WorkflowTemplate
+	55, // 80: skip.ironbird.IronbirdService.ListWorkflowTemplates:output_type -> skip.ironbird.WorkflowTemplateListResponse
+	53, // 81: skip.ironbird.IronbirdService.UpdateWorkflowTemplate:output_type -> skip.ironbird.WorkflowTemplateResponse
+	53, // 82: skip.ironbird.IronbirdService.DeleteWorkflowTemplate:output_type -> skip.ironbird.WorkflowTemplateResponse
+	27, // 83: skip.ironbird.IronbirdService.ExecuteWorkflowTemplate:output_type -> skip.ironbird.WorkflowResponse
+	59, // 84: skip.ironbird.IronbirdService.GetTemplateRunHistory:output_type -> skip.ironbird.TemplateRunHistoryResponse
+	65, // [65:85] is the sub-list for method output_type
+	45, // [45:65] is the sub-list for method input_type
+	45, // [45:45] is the sub-list for extension type_name
+	45, // [45:45] is the sub-list for extension extendee
+	0,  // [0:45] is the sub-list for field type_name
 }
 
 func init() { file_server_proto_ironbird_proto_init() }
@@ -2383,7 +4779,7 @@ func file_server_proto_ironbird_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_server_proto_ironbird_proto_rawDesc), len(file_server_proto_ironbird_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   33,
+			NumMessages:   64,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -24,8 +24,14 @@ const (
 	IronbirdService_ListWorkflows_FullMethodName           = "/skip.ironbird.IronbirdService/ListWorkflows"
 	IronbirdService_CancelWorkflow_FullMethodName          = "/skip.ironbird.IronbirdService/CancelWorkflow"
 	IronbirdService_SignalWorkflow_FullMethodName          = "/skip.ironbird.IronbirdService/SignalWorkflow"
+	IronbirdService_ForceTeardown_FullMethodName           = "/skip.ironbird.IronbirdService/ForceTeardown"
 	IronbirdService_RunLoadTest_FullMethodName             = "/skip.ironbird.IronbirdService/RunLoadTest"
 	IronbirdService_UpdateWorkflowData_FullMethodName      = "/skip.ironbird.IronbirdService/UpdateWorkflowData"
+	IronbirdService_AppendBuildLogs_FullMethodName         = "/skip.ironbird.IronbirdService/AppendBuildLogs"
+	IronbirdService_StreamBuildLogs_FullMethodName         = "/skip.ironbird.IronbirdService/StreamBuildLogs"
+	IronbirdService_SaveProviderState_FullMethodName       = "/skip.ironbird.IronbirdService/SaveProviderState"
+	IronbirdService_UploadWorkflowArtifact_FullMethodName  = "/skip.ironbird.IronbirdService/UploadWorkflowArtifact"
+	IronbirdService_GetWorkflowArtifacts_FullMethodName    = "/skip.ironbird.IronbirdService/GetWorkflowArtifacts"
 	IronbirdService_CreateWorkflowTemplate_FullMethodName  = "/skip.ironbird.IronbirdService/CreateWorkflowTemplate"
 	IronbirdService_GetWorkflowTemplate_FullMethodName     = "/skip.ironbird.IronbirdService/GetWorkflowTemplate"
 	IronbirdService_ListWorkflowTemplates_FullMethodName   = "/skip.ironbird.IronbirdService/ListWorkflowTemplates"
@@ -44,8 +50,14 @@ type IronbirdServiceClient interface {
 	ListWorkflows(ctx context.Context, in *ListWorkflowsRequest, opts ...grpc.CallOption) (*WorkflowListResponse, error)
 	CancelWorkflow(ctx context.Context, in *CancelWorkflowRequest, opts ...grpc.CallOption) (*WorkflowResponse, error)
 	SignalWorkflow(ctx context.Context, in *SignalWorkflowRequest, opts ...grpc.CallOption) (*WorkflowResponse, error)
+	ForceTeardown(ctx context.Context, in *ForceTeardownRequest, opts ...grpc.CallOption) (*WorkflowResponse, error)
 	RunLoadTest(ctx context.Context, in *RunLoadTestRequest, opts ...grpc.CallOption) (*WorkflowResponse, error)
 	UpdateWorkflowData(ctx context.Context, in *UpdateWorkflowDataRequest, opts ...grpc.CallOption) (*WorkflowResponse, error)
+	AppendBuildLogs(ctx context.Context, in *AppendBuildLogsRequest, opts ...grpc.CallOption) (*AppendBuildLogsResponse, error)
+	StreamBuildLogs(ctx context.Context, in *StreamBuildLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BuildLogChunk], error)
+	SaveProviderState(ctx context.Context, in *SaveProviderStateRequest, opts ...grpc.CallOption) (*SaveProviderStateResponse, error)
+	UploadWorkflowArtifact(ctx context.Context, in *UploadWorkflowArtifactRequest, opts ...grpc.CallOption) (*UploadWorkflowArtifactResponse, error)
+	GetWorkflowArtifacts(ctx context.Context, in *GetWorkflowArtifactsRequest, opts ...grpc.CallOption) (*WorkflowArtifactsResponse, error)
 	CreateWorkflowTemplate(ctx context.Context, in *CreateWorkflowTemplateRequest, opts ...grpc.CallOption) (*WorkflowTemplateResponse, error)
 	GetWorkflowTemplate(ctx context.Context, in *GetWorkflowTemplateRequest, opts ...grpc.CallOption) (*WorkflowTemplate, error)
 	ListWorkflowTemplates(ctx context.Context, in *ListWorkflowTemplatesRequest, opts ...grpc.CallOption) (*WorkflowTemplateListResponse, error)
@@ -113,6 +125,16 @@ func (c *ironbirdServiceClient) SignalWorkflow(ctx context.Context, in *SignalWo
 	return out, nil
 }
 
+func (c *ironbirdServiceClient) ForceTeardown(ctx context.Context, in *ForceTeardownRequest, opts ...grpc.CallOption) (*WorkflowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WorkflowResponse)
+	err := c.cc.Invoke(ctx, IronbirdService_ForceTeardown_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ironbirdServiceClient) RunLoadTest(ctx context.Context, in *RunLoadTestRequest, opts ...grpc.CallOption) (*WorkflowResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(WorkflowResponse)
@@ -133,6 +155,65 @@ func (c *ironbirdServiceClient) UpdateWorkflowData(ctx context.Context, in *Upda
 	return out, nil
 }
 
+func (c *ironbirdServiceClient) AppendBuildLogs(ctx context.Context, in *AppendBuildLogsRequest, opts ...grpc.CallOption) (*AppendBuildLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AppendBuildLogsResponse)
+	err := c.cc.Invoke(ctx, IronbirdService_AppendBuildLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ironbirdServiceClient) StreamBuildLogs(ctx context.Context, in *StreamBuildLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BuildLogChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &IronbirdService_ServiceDesc.Streams[0], IronbirdService_StreamBuildLogs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamBuildLogsRequest, BuildLogChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type IronbirdService_StreamBuildLogsClient = grpc.ServerStreamingClient[BuildLogChunk]
+
+func (c *ironbirdServiceClient) SaveProviderState(ctx context.Context, in *SaveProviderStateRequest, opts ...grpc.CallOption) (*SaveProviderStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveProviderStateResponse)
+	err := c.cc.Invoke(ctx, IronbirdService_SaveProviderState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ironbirdServiceClient) UploadWorkflowArtifact(ctx context.Context, in *UploadWorkflowArtifactRequest, opts ...grpc.CallOption) (*UploadWorkflowArtifactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadWorkflowArtifactResponse)
+	err := c.cc.Invoke(ctx, IronbirdService_UploadWorkflowArtifact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ironbirdServiceClient) GetWorkflowArtifacts(ctx context.Context, in *GetWorkflowArtifactsRequest, opts ...grpc.CallOption) (*WorkflowArtifactsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WorkflowArtifactsResponse)
+	err := c.cc.Invoke(ctx, IronbirdService_GetWorkflowArtifacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ironbirdServiceClient) CreateWorkflowTemplate(ctx context.Context, in *CreateWorkflowTemplateRequest, opts ...grpc.CallOption) (*WorkflowTemplateResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(WorkflowTemplateResponse)
@@ -212,8 +293,14 @@ type IronbirdServiceServer interface {
 	ListWorkflows(context.Context, *ListWorkflowsRequest) (*WorkflowListResponse, error)
 	CancelWorkflow(context.Context, *CancelWorkflowRequest) (*WorkflowResponse, error)
 	SignalWorkflow(context.Context, *SignalWorkflowRequest) (*WorkflowResponse, error)
+	ForceTeardown(context.Context, *ForceTeardownRequest) (*WorkflowResponse, error)
 	RunLoadTest(context.Context, *RunLoadTestRequest) (*WorkflowResponse, error)
 	UpdateWorkflowData(context.Context, *UpdateWorkflowDataRequest) (*WorkflowResponse, error)
+	AppendBuildLogs(context.Context, *AppendBuildLogsRequest) (*AppendBuildLogsResponse, error)
+	StreamBuildLogs(*StreamBuildLogsRequest, grpc.ServerStreamingServer[BuildLogChunk]) error
+	SaveProviderState(context.Context, *SaveProviderStateRequest) (*SaveProviderStateResponse, error)
+	UploadWorkflowArtifact(context.Context, *UploadWorkflowArtifactRequest) (*UploadWorkflowArtifactResponse, error)
+	GetWorkflowArtifacts(context.Context, *GetWorkflowArtifactsRequest) (*WorkflowArtifactsResponse, error)
 	CreateWorkflowTemplate(context.Context, *CreateWorkflowTemplateRequest) (*WorkflowTemplateResponse, error)
 	GetWorkflowTemplate(context.Context, *GetWorkflowTemplateRequest) (*WorkflowTemplate, error)
 	ListWorkflowTemplates(context.Context, *ListWorkflowTemplatesRequest) (*WorkflowTemplateListResponse, error)
@@ -246,12 +333,30 @@ func (UnimplementedIronbirdServiceServer) CancelWorkflow(context.Context, *Cance
 func (UnimplementedIronbirdServiceServer) SignalWorkflow(context.Context, *SignalWorkflowRequest) (*WorkflowResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignalWorkflow not implemented")
 }
+func (UnimplementedIronbirdServiceServer) ForceTeardown(context.Context, *ForceTeardownRequest) (*WorkflowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceTeardown not implemented")
+}
 func (UnimplementedIronbirdServiceServer) RunLoadTest(context.Context, *RunLoadTestRequest) (*WorkflowResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RunLoadTest not implemented")
 }
 func (UnimplementedIronbirdServiceServer) UpdateWorkflowData(context.Context, *UpdateWorkflowDataRequest) (*WorkflowResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkflowData not implemented")
 }
+func (UnimplementedIronbirdServiceServer) AppendBuildLogs(context.Context, *AppendBuildLogsRequest) (*AppendBuildLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppendBuildLogs not implemented")
+}
+func (UnimplementedIronbirdServiceServer) StreamBuildLogs(*StreamBuildLogsRequest, grpc.ServerStreamingServer[BuildLogChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBuildLogs not implemented")
+}
+func (UnimplementedIronbirdServiceServer) SaveProviderState(context.Context, *SaveProviderStateRequest) (*SaveProviderStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveProviderState not implemented")
+}
+func (UnimplementedIronbirdServiceServer) UploadWorkflowArtifact(context.Context, *UploadWorkflowArtifactRequest) (*UploadWorkflowArtifactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadWorkflowArtifact not implemented")
+}
+func (UnimplementedIronbirdServiceServer) GetWorkflowArtifacts(context.Context, *GetWorkflowArtifactsRequest) (*WorkflowArtifactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowArtifacts not implemented")
+}
 func (UnimplementedIronbirdServiceServer) CreateWorkflowTemplate(context.Context, *CreateWorkflowTemplateRequest) (*WorkflowTemplateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateWorkflowTemplate not implemented")
 }
@@ -384,6 +489,24 @@ func _IronbirdService_SignalWorkflow_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IronbirdService_ForceTeardown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceTeardownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IronbirdServiceServer).ForceTeardown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IronbirdService_ForceTeardown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IronbirdServiceServer).ForceTeardown(ctx, req.(*ForceTeardownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IronbirdService_RunLoadTest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RunLoadTestRequest)
 	if err := dec(in); err != nil {
@@ -420,6 +543,89 @@ func _IronbirdService_UpdateWorkflowData_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IronbirdService_AppendBuildLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppendBuildLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IronbirdServiceServer).AppendBuildLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IronbirdService_AppendBuildLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IronbirdServiceServer).AppendBuildLogs(ctx, req.(*AppendBuildLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IronbirdService_StreamBuildLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamBuildLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IronbirdServiceServer).StreamBuildLogs(m, &grpc.GenericServerStream[StreamBuildLogsRequest, BuildLogChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type IronbirdService_StreamBuildLogsServer = grpc.ServerStreamingServer[BuildLogChunk]
+
+func _IronbirdService_SaveProviderState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveProviderStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IronbirdServiceServer).SaveProviderState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IronbirdService_SaveProviderState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IronbirdServiceServer).SaveProviderState(ctx, req.(*SaveProviderStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IronbirdService_UploadWorkflowArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadWorkflowArtifactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IronbirdServiceServer).UploadWorkflowArtifact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IronbirdService_UploadWorkflowArtifact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IronbirdServiceServer).UploadWorkflowArtifact(ctx, req.(*UploadWorkflowArtifactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IronbirdService_GetWorkflowArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowArtifactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IronbirdServiceServer).GetWorkflowArtifacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IronbirdService_GetWorkflowArtifacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IronbirdServiceServer).GetWorkflowArtifacts(ctx, req.(*GetWorkflowArtifactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IronbirdService_CreateWorkflowTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateWorkflowTemplateRequest)
 	if err := dec(in); err != nil {
@@ -573,6 +779,10 @@ var IronbirdService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SignalWorkflow",
 			Handler:    _IronbirdService_SignalWorkflow_Handler,
 		},
+		{
+			MethodName: "ForceTeardown",
+			Handler:    _IronbirdService_ForceTeardown_Handler,
+		},
 		{
 			MethodName: "RunLoadTest",
 			Handler:    _IronbirdService_RunLoadTest_Handler,
@@ -581,6 +791,22 @@ var IronbirdService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateWorkflowData",
 			Handler:    _IronbirdService_UpdateWorkflowData_Handler,
 		},
+		{
+			MethodName: "AppendBuildLogs",
+			Handler:    _IronbirdService_AppendBuildLogs_Handler,
+		},
+		{
+			MethodName: "SaveProviderState",
+			Handler:    _IronbirdService_SaveProviderState_Handler,
+		},
+		{
+			MethodName: "UploadWorkflowArtifact",
+			Handler:    _IronbirdService_UploadWorkflowArtifact_Handler,
+		},
+		{
+			MethodName: "GetWorkflowArtifacts",
+			Handler:    _IronbirdService_GetWorkflowArtifacts_Handler,
+		},
 		{
 			MethodName: "CreateWorkflowTemplate",
 			Handler:    _IronbirdService_CreateWorkflowTemplate_Handler,
@@ -610,6 +836,12 @@ var IronbirdService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _IronbirdService_GetTemplateRunHistory_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamBuildLogs",
+			Handler:       _IronbirdService_StreamBuildLogs_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "server/proto/ironbird.proto",
 }
@@ -3,7 +3,10 @@ package workflow
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"time"
 
 	"github.com/skip-mev/ironbird/messages"
@@ -14,11 +17,13 @@ import (
 	cosmostypes "github.com/skip-mev/catalyst/chains/cosmos/types"
 	ethtypes "github.com/skip-mev/catalyst/chains/ethereum/types"
 	catalysttypes "github.com/skip-mev/catalyst/chains/types"
+	"github.com/skip-mev/ironbird/petri/core/apps"
 	petritypes "github.com/skip-mev/ironbird/petri/core/types"
 	"github.com/skip-mev/ironbird/petri/cosmos/chain"
 	"github.com/skip-mev/ironbird/server/db"
 	pb "github.com/skip-mev/ironbird/server/proto"
 	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 	temporalclient "go.temporal.io/sdk/client"
 	"go.uber.org/zap"
 )
@@ -60,6 +65,9 @@ func (s *Service) CreateWorkflow(ctx context.Context, req *pb.CreateWorkflowRequ
 		SHA:                    req.Sha,
 		CosmosSdkSha:           req.CosmosSdkSha,
 		CometBFTSha:            req.CometbftSha,
+		GoModReplaces:          convertProtoGoModReplaces(req.GoModReplaces),
+		Source:                 convertProtoChainSource(req.Source),
+		BuildVariant:           messages.BuildVariant(req.BuildVariant),
 		IsEvmChain:             req.IsEvmChain,
 		RunnerType:             messages.RunnerType(req.RunnerType),
 		LongRunningTestnet:     req.LongRunningTestnet,
@@ -69,76 +77,22 @@ func (s *Service) CreateWorkflow(ctx context.Context, req *pb.CreateWorkflowRequ
 		BaseMnemonic:           req.BaseMnemonic,
 		CatalystVersion:        req.CatalystVersion,
 		ProviderSpecificConfig: req.ProviderConfig,
+		GenesisMigration:       convertProtoGenesisMigration(req.GenesisMigration),
+		CustomGenesis:          convertProtoCustomGenesis(req.CustomGenesis),
+		AdditionalChains:       s.convertProtoAdditionalChains(req.AdditionalChains),
+		Relayer:                convertProtoRelayer(req.Relayer),
+		LoadBalancer:           convertProtoLoadBalancerSpec(req.LoadBalancer),
+		Faults:                 convertProtoFaults(req.Faults),
 	}
 
 	if req.ChainConfig != nil {
-		chainConfig := types.ChainsConfig{
-			Name:                  req.ChainConfig.Name,
-			Image:                 req.ChainConfig.Image,
-			Version:               req.ChainConfig.Version,
-			NumOfNodes:            req.ChainConfig.NumOfNodes,
-			NumOfValidators:       req.ChainConfig.NumOfValidators,
-			SetSeedNode:           req.ChainConfig.SetSeedNode,
-			SetPersistentPeers:    req.ChainConfig.SetPersistentPeers,
-			CustomAppConfig:       s.parseJSONConfig(req.ChainConfig.CustomAppConfig, "custom_app_config"),
-			CustomConsensusConfig: s.parseJSONConfig(req.ChainConfig.CustomConsensusConfig, "custom_consensus_config"),
-			CustomClientConfig:    s.parseJSONConfig(req.ChainConfig.CustomClientConfig, "custom_client_config"),
-		}
-
-		if req.ChainConfig.RegionConfigs != nil {
-			for _, rc := range req.ChainConfig.RegionConfigs {
-				chainConfig.RegionConfigs = append(chainConfig.RegionConfigs, petritypes.RegionConfig{
-					Name:          rc.Name,
-					NumNodes:      int(rc.NumOfNodes),
-					NumValidators: int(rc.NumOfValidators),
-				})
-			}
-		}
-
-		if !chainConfig.SetSeedNode && !chainConfig.SetPersistentPeers {
-			return nil, fmt.Errorf("at least one of SetSeedNode or SetPersistentPeers must be set to true")
-		}
-
-		if req.ChainConfig.GenesisModifications != nil {
-			for _, gm := range req.ChainConfig.GenesisModifications {
-				if isNumericString(gm.Value) {
-					// Keep numeric strings as strings to avoid precision issues in genesis
-					chainConfig.GenesisModifications = append(
-						chainConfig.GenesisModifications,
-						chain.GenesisKV{
-							Key:   gm.Key,
-							Value: gm.Value,
-						},
-					)
-				} else {
-					var jsonValue interface{}
-					if err := json.Unmarshal([]byte(gm.Value), &jsonValue); err == nil {
-						chainConfig.GenesisModifications = append(
-							chainConfig.GenesisModifications,
-							chain.GenesisKV{
-								Key:   gm.Key,
-								Value: jsonValue,
-							},
-						)
-					} else {
-						chainConfig.GenesisModifications = append(
-							chainConfig.GenesisModifications,
-							chain.GenesisKV{
-								Key:   gm.Key,
-								Value: gm.Value,
-							},
-						)
-					}
-				}
-			}
-
-			s.logger.Info("Processed genesis modifications",
-				zap.Int("count", len(chainConfig.GenesisModifications)))
-		} else {
-			s.logger.Info("No genesis modifications provided")
+		if !req.ChainConfig.SetSeedNode && !req.ChainConfig.SetPersistentPeers && req.ChainConfig.Topology == nil {
+			return nil, fmt.Errorf("at least one of SetSeedNode, SetPersistentPeers or Topology must be set")
 		}
 
-		workflowReq.ChainConfig = chainConfig
+		workflowReq.ChainConfig = s.convertProtoChainConfig(req.ChainConfig)
+		s.logger.Info("Processed genesis modifications",
+			zap.Int("count", len(workflowReq.ChainConfig.GenesisModifications)))
 	}
 
 	if len(req.EncodedLoadTestSpec) != 0 {
@@ -236,6 +190,15 @@ func (s *Service) GetWorkflow(ctx context.Context, req *pb.GetWorkflowRequest) (
 	response.Validators = workflow.Validators
 	response.LoadBalancers = workflow.LoadBalancers
 
+	// the workflow's own state takes precedence, pushes of its data to the database may have failed
+	if desc.WorkflowExecutionInfo.Status == enums.WORKFLOW_EXECUTION_STATUS_RUNNING {
+		if testnetStatus, err := s.queryTestnetStatus(ctx, req.WorkflowId); err != nil {
+			s.logger.Warn("failed to query testnet status", zap.Error(err), zap.String("workflowID", req.WorkflowId))
+		} else {
+			setTestnetStatusOnProto(response, testnetStatus)
+		}
+	}
+
 	if workflow.Wallets != nil {
 		response.Wallets = workflow.Wallets
 	}
@@ -255,57 +218,16 @@ func (s *Service) GetWorkflow(ctx context.Context, req *pb.GetWorkflowRequest) (
 		}
 	}
 
-	chainConfig := &pb.ChainConfig{
-		Name:                  workflow.Config.ChainConfig.Name,
-		Image:                 workflow.Config.ChainConfig.Image,
-		Version:               workflow.Config.ChainConfig.Version,
-		NumOfNodes:            workflow.Config.ChainConfig.NumOfNodes,
-		NumOfValidators:       workflow.Config.ChainConfig.NumOfValidators,
-		SetSeedNode:           workflow.Config.ChainConfig.SetSeedNode,
-		SetPersistentPeers:    workflow.Config.ChainConfig.SetPersistentPeers,
-		CustomAppConfig:       marshalJSONConfig(workflow.Config.ChainConfig.CustomAppConfig),
-		CustomConsensusConfig: marshalJSONConfig(workflow.Config.ChainConfig.CustomConsensusConfig),
-		CustomClientConfig:    marshalJSONConfig(workflow.Config.ChainConfig.CustomClientConfig),
-	}
-
-	for _, rc := range workflow.Config.ChainConfig.RegionConfigs {
-		chainConfig.RegionConfigs = append(chainConfig.RegionConfigs, &pb.RegionConfig{
-			Name:            rc.Name,
-			NumOfNodes:      uint64(rc.NumNodes),
-			NumOfValidators: uint64(rc.NumValidators),
-		})
-	}
-
-	if workflow.Config.ChainConfig.GenesisModifications != nil {
-		for _, gm := range workflow.Config.ChainConfig.GenesisModifications {
-			var valueStr string
-			switch v := gm.Value.(type) {
-			case string:
-				valueStr = v
-			default:
-				valueBytes, err := json.Marshal(gm.Value)
-				if err != nil {
-					s.logger.Warn("Failed to marshal genesis value", zap.String("key", gm.Key), zap.Error(err))
-					continue
-				}
-				valueStr = string(valueBytes)
-			}
-
-			chainConfig.GenesisModifications = append(
-				chainConfig.GenesisModifications,
-				&pb.GenesisKV{
-					Key:   gm.Key,
-					Value: valueStr,
-				},
-			)
-		}
-	}
+	chainConfig := convertChainConfigToProto(workflow.Config.ChainConfig)
 
 	response.Config = &pb.CreateWorkflowRequest{
 		Repo:               workflow.Config.Repo,
 		Sha:                workflow.Config.SHA,
 		CosmosSdkSha:       workflow.Config.CosmosSdkSha,
 		CometbftSha:        workflow.Config.CometBFTSha,
+		GoModReplaces:      convertGoModReplacesToProto(workflow.Config.GoModReplaces),
+		Source:             convertChainSourceToProto(workflow.Config.Source),
+		BuildVariant:       string(workflow.Config.BuildVariant),
 		IsEvmChain:         workflow.Config.IsEvmChain,
 		RunnerType:         string(workflow.Config.RunnerType),
 		LongRunningTestnet: workflow.Config.LongRunningTestnet,
@@ -316,6 +238,12 @@ func (s *Service) GetWorkflow(ctx context.Context, req *pb.GetWorkflowRequest) (
 		CatalystVersion:    workflow.Config.CatalystVersion,
 		ChainConfig:        chainConfig,
 		ProviderConfig:     workflow.Config.ProviderSpecificConfig,
+		GenesisMigration:   convertGenesisMigrationToProto(workflow.Config.GenesisMigration),
+		CustomGenesis:      convertCustomGenesisToProto(workflow.Config.CustomGenesis),
+		AdditionalChains:   convertAdditionalChainsToProto(workflow.Config.AdditionalChains),
+		Relayer:            convertRelayerToProto(workflow.Config.Relayer),
+		LoadBalancer:       convertLoadBalancerSpecToProto(workflow.Config.LoadBalancer),
+		Faults:             convertFaultsToProto(workflow.Config.Faults),
 	}
 
 	if workflow.Config.EthereumLoadTestSpec != nil {
@@ -405,7 +333,15 @@ func (s *Service) CancelWorkflow(ctx context.Context, req *pb.CancelWorkflowRequ
 func (s *Service) SignalWorkflow(ctx context.Context, req *pb.SignalWorkflowRequest) (*pb.WorkflowResponse, error) {
 	s.logger.Info("SignalWorkflow request received", zap.String("workflowID", req.WorkflowId), zap.String("signalName", req.SignalName))
 
-	err := s.temporalClient.SignalWorkflow(ctx, req.WorkflowId, "", req.SignalName, nil)
+	var payload interface{}
+	if req.Payload != "" {
+		if !json.Valid([]byte(req.Payload)) {
+			return nil, fmt.Errorf("signal payload must be valid json")
+		}
+		payload = json.RawMessage(req.Payload)
+	}
+
+	err := s.temporalClient.SignalWorkflow(ctx, req.WorkflowId, "", req.SignalName, payload)
 	if err != nil {
 		s.logger.Error("failed to signal workflow", zap.Error(err), zap.String("workflowID", req.WorkflowId), zap.String("signalName", req.SignalName))
 		return nil, fmt.Errorf("failed to signal workflow: %w", err)
@@ -416,6 +352,55 @@ func (s *Service) SignalWorkflow(ctx context.Context, req *pb.SignalWorkflowRequ
 	}, nil
 }
 
+// ForceTeardown tears down the testnet of a workflow from the provider state it last saved. The workflow is terminated
+// first if it is still running, the teardown runs in a separate workflow whose ID is returned
+func (s *Service) ForceTeardown(ctx context.Context, req *pb.ForceTeardownRequest) (*pb.WorkflowResponse, error) {
+	s.logger.Info("ForceTeardown request received", zap.String("workflowID", req.WorkflowId))
+
+	providerState, err := s.db.GetProviderState(req.WorkflowId)
+	if err != nil {
+		s.logger.Error("failed to get provider state", zap.Error(err), zap.String("workflowID", req.WorkflowId))
+		return nil, fmt.Errorf("failed to get provider state: %w", err)
+	}
+
+	if len(providerState.State) == 0 {
+		return nil, fmt.Errorf("testnet of workflow %s was already torn down", req.WorkflowId)
+	}
+
+	// the workflow must not use its testnet while it is torn down, workflows that already closed can't be terminated
+	err = s.temporalClient.TerminateWorkflow(ctx, req.WorkflowId, "", "force teardown")
+	var notFound *serviceerror.NotFound
+	if err != nil && !errors.As(err, &notFound) {
+		s.logger.Error("failed to terminate workflow", zap.Error(err), zap.String("workflowID", req.WorkflowId))
+		return nil, fmt.Errorf("failed to terminate workflow: %w", err)
+	}
+
+	// a teardown that is still running is returned instead of starting another one
+	options := temporalclient.StartWorkflowOptions{
+		ID:                  req.WorkflowId + "-teardown",
+		TaskQueue:           messages.TaskQueue,
+		WorkflowTaskTimeout: 30 * time.Second,
+	}
+
+	workflowRun, err := s.temporalClient.ExecuteWorkflow(ctx, options, testnet.TeardownWorkflow,
+		messages.TeardownWorkflowRequest{
+			WorkflowID:    req.WorkflowId,
+			RunnerType:    providerState.RunnerType,
+			ProviderState: providerState.State,
+		})
+	if err != nil {
+		s.logger.Error("failed to start teardown workflow", zap.Error(err), zap.String("workflowID", req.WorkflowId))
+		return nil, fmt.Errorf("failed to start teardown workflow: %w", err)
+	}
+
+	s.logger.Info("teardown workflow started", zap.String("workflowID", req.WorkflowId),
+		zap.String("teardownWorkflowID", workflowRun.GetID()))
+
+	return &pb.WorkflowResponse{
+		WorkflowId: workflowRun.GetID(),
+	}, nil
+}
+
 func (s *Service) RunLoadTest(ctx context.Context, req *pb.RunLoadTestRequest) (*pb.WorkflowResponse, error) {
 	s.logger.Info("RunLoadTest request received", zap.String("workflowID", req.WorkflowId))
 
@@ -474,6 +459,137 @@ func (s *Service) UpdateWorkflowData(ctx context.Context, req *pb.UpdateWorkflow
 	}, nil
 }
 
+const (
+	// buildLogsPollInterval is how often a followed build log stream checks for new chunks
+	buildLogsPollInterval = time.Second
+	buildLogsPageSize     = 500
+)
+
+func (s *Service) AppendBuildLogs(ctx context.Context, req *pb.AppendBuildLogsRequest) (*pb.AppendBuildLogsResponse, error) {
+	if req.WorkflowId == "" || req.Build == "" {
+		return nil, fmt.Errorf("workflow id and build are required")
+	}
+
+	if err := s.db.AppendBuildLogs(&db.BuildLogChunk{
+		WorkflowID: req.WorkflowId,
+		Build:      req.Build,
+		Content:    req.Content,
+	}); err != nil {
+		s.logger.Error("Failed to append build logs", zap.String("workflowID", req.WorkflowId), zap.Error(err))
+		return nil, err
+	}
+
+	return &pb.AppendBuildLogsResponse{}, nil
+}
+
+// StreamBuildLogs sends the stored build logs of a workflow. With follow, the stream stays open and sends new chunks
+// as workers append them, until the workflow reaches a terminal status and every chunk has been sent
+func (s *Service) StreamBuildLogs(req *pb.StreamBuildLogsRequest, stream pb.IronbirdService_StreamBuildLogsServer) error {
+	s.logger.Info("StreamBuildLogs request received", zap.String("workflowID", req.WorkflowId), zap.Bool("follow", req.Follow))
+
+	if _, err := s.db.GetWorkflow(req.WorkflowId); err != nil {
+		return fmt.Errorf("failed to get workflow: %w", err)
+	}
+
+	ticker := time.NewTicker(buildLogsPollInterval)
+	defer ticker.Stop()
+
+	afterID := req.AfterId
+	for {
+		// the status is read before the chunks, so that chunks appended right before the workflow finished are sent
+		workflow, err := s.db.GetWorkflow(req.WorkflowId)
+		if err != nil {
+			return fmt.Errorf("failed to get workflow: %w", err)
+		}
+
+		chunks, err := s.db.ListBuildLogs(req.WorkflowId, afterID, buildLogsPageSize)
+		if err != nil {
+			return err
+		}
+
+		for _, chunk := range chunks {
+			if err := stream.Send(&pb.BuildLogChunk{
+				Id:         chunk.ID,
+				WorkflowId: chunk.WorkflowID,
+				Build:      chunk.Build,
+				Content:    chunk.Content,
+				CreatedAt:  chunk.CreatedAt.Format(time.RFC3339),
+			}); err != nil {
+				return err
+			}
+			afterID = chunk.ID
+		}
+
+		if len(chunks) == buildLogsPageSize {
+			continue
+		}
+
+		if !req.Follow || isWorkflowTerminal(workflow.Status) {
+			return nil
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Service) SaveProviderState(ctx context.Context, req *pb.SaveProviderStateRequest) (*pb.SaveProviderStateResponse, error) {
+	if req.WorkflowId == "" || req.RunnerType == "" {
+		return nil, fmt.Errorf("workflow id and runner type are required")
+	}
+
+	if err := s.db.SaveProviderState(&db.ProviderState{
+		WorkflowID: req.WorkflowId,
+		RunnerType: messages.RunnerType(req.RunnerType),
+		State:      req.ProviderState,
+	}); err != nil {
+		s.logger.Error("Failed to save provider state", zap.String("workflowID", req.WorkflowId), zap.Error(err))
+		return nil, err
+	}
+
+	return &pb.SaveProviderStateResponse{}, nil
+}
+
+func (s *Service) UploadWorkflowArtifact(ctx context.Context, req *pb.UploadWorkflowArtifactRequest) (*pb.UploadWorkflowArtifactResponse, error) {
+	if req.WorkflowId == "" || req.Name == "" {
+		return nil, fmt.Errorf("workflow id and name are required")
+	}
+
+	if err := s.db.SaveWorkflowArtifact(&db.WorkflowArtifact{
+		WorkflowID: req.WorkflowId,
+		Name:       req.Name,
+		Content:    req.Content,
+	}); err != nil {
+		s.logger.Error("Failed to save workflow artifact", zap.String("workflowID", req.WorkflowId),
+			zap.String("name", req.Name), zap.Error(err))
+		return nil, err
+	}
+
+	return &pb.UploadWorkflowArtifactResponse{}, nil
+}
+
+func (s *Service) GetWorkflowArtifacts(ctx context.Context, req *pb.GetWorkflowArtifactsRequest) (*pb.WorkflowArtifactsResponse, error) {
+	artifacts, err := s.db.ListWorkflowArtifacts(req.WorkflowId)
+	if err != nil {
+		s.logger.Error("Failed to list workflow artifacts", zap.String("workflowID", req.WorkflowId), zap.Error(err))
+		return nil, err
+	}
+
+	resp := &pb.WorkflowArtifactsResponse{}
+	for _, artifact := range artifacts {
+		resp.Artifacts = append(resp.Artifacts, &pb.WorkflowArtifact{
+			Name:      artifact.Name,
+			Content:   artifact.Content,
+			CreatedAt: artifact.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return resp, nil
+}
+
 func (s *Service) UpdateWorkflowStatuses() {
 	workflows, err := s.db.ListWorkflows(1000, 0)
 	if err != nil {
@@ -550,11 +666,73 @@ func convertProtoNodes(protoNodes []*pb.Node) []pb.Node {
 			Rpc:     protoNodes[i].Rpc,
 			Lcd:     protoNodes[i].Lcd,
 			Grpc:    protoNodes[i].Grpc,
+			Image:   protoNodes[i].Image,
 		})
 	}
 	return result
 }
 
+// queryTestnetStatus queries the live status of a running testnet workflow
+func (s *Service) queryTestnetStatus(ctx context.Context, workflowID string) (messages.TestnetStatus, error) {
+	var status messages.TestnetStatus
+	value, err := s.temporalClient.QueryWorkflow(ctx, workflowID, "", messages.TestnetStatusQuery)
+	if err != nil {
+		return status, err
+	}
+
+	if err := value.Get(&status); err != nil {
+		return status, fmt.Errorf("failed to decode testnet status: %w", err)
+	}
+
+	return status, nil
+}
+
+// setTestnetStatusOnProto merges the live status of a testnet workflow into its proto. Nodes are only replaced once
+// the workflow launched them
+func setTestnetStatusOnProto(workflow *pb.Workflow, status messages.TestnetStatus) {
+	workflow.Phase = string(status.Phase)
+	workflow.ChainId = status.ChainID
+
+	if len(status.Nodes) != 0 || len(status.Validators) != 0 {
+		workflow.Nodes = status.Nodes
+		workflow.Validators = status.Validators
+	}
+
+	if status.Health != nil {
+		workflow.Height = status.Health.Height
+		workflow.HealthCheckedAt = status.HealthCheckedAt.Format(time.RFC3339)
+		for _, node := range status.Health.Nodes {
+			workflow.NodeHealth = append(workflow.NodeHealth, &pb.NodeHealth{
+				Name:   node.Name,
+				Height: node.Height,
+				Error:  node.Error,
+			})
+		}
+	}
+
+	if status.LoadTest != nil {
+		loadTest := &pb.LoadTestProgress{
+			StartTime: status.LoadTest.StartedAt.Format(time.RFC3339),
+			Error:     status.LoadTest.Error,
+		}
+		if !status.LoadTest.CompletedAt.IsZero() {
+			loadTest.EndTime = status.LoadTest.CompletedAt.Format(time.RFC3339)
+		}
+		if overall := status.LoadTest.Overall; overall != nil {
+			loadTest.TotalTransactions = int64(overall.TotalTransactions)
+			loadTest.IncludedTransactions = int64(overall.TotalIncludedTransactions)
+			loadTest.SuccessfulTransactions = int64(overall.SuccessfulTransactions)
+			loadTest.FailedTransactions = int64(overall.FailedTransactions)
+			loadTest.Tps = overall.TPS
+		}
+		workflow.LoadTest = loadTest
+	}
+
+	if !status.ExpectedEnd.IsZero() {
+		workflow.ExpectedEndTime = status.ExpectedEnd.Format(time.RFC3339)
+	}
+}
+
 func isWorkflowTerminal(status enums.WorkflowExecutionStatus) bool {
 	return status == enums.WORKFLOW_EXECUTION_STATUS_COMPLETED ||
 		status == enums.WORKFLOW_EXECUTION_STATUS_FAILED ||
@@ -588,6 +766,566 @@ func marshalJSONConfig(config map[string]interface{}) string {
 	return ""
 }
 
+func parseGenesisKV(gm *pb.GenesisKV) chain.GenesisKV {
+	if isNumericString(gm.Value) {
+		// Keep numeric strings as strings to avoid precision issues in genesis
+		return chain.GenesisKV{Key: gm.Key, Value: gm.Value}
+	}
+
+	var jsonValue interface{}
+	if err := json.Unmarshal([]byte(gm.Value), &jsonValue); err == nil {
+		return chain.GenesisKV{Key: gm.Key, Value: jsonValue}
+	}
+
+	return chain.GenesisKV{Key: gm.Key, Value: gm.Value}
+}
+
+func marshalGenesisKV(gm chain.GenesisKV) *pb.GenesisKV {
+	value := ""
+	if str, ok := gm.Value.(string); ok {
+		value = str
+	} else if gm.Value != nil {
+		if bytes, err := json.Marshal(gm.Value); err == nil {
+			value = string(bytes)
+		}
+	}
+
+	return &pb.GenesisKV{Key: gm.Key, Value: value}
+}
+
+func convertProtoGenesisMigration(gm *pb.GenesisMigration) *messages.GenesisMigrationSpec {
+	if gm == nil {
+		return nil
+	}
+
+	spec := &messages.GenesisMigrationSpec{
+		ExportHeight:     gm.ExportHeight,
+		SHA:              gm.Sha,
+		MigrationCommand: gm.MigrationCommand,
+	}
+
+	for _, kv := range gm.GenesisModifications {
+		spec.GenesisModifications = append(spec.GenesisModifications, parseGenesisKV(kv))
+	}
+
+	return spec
+}
+
+func convertGenesisMigrationToProto(spec *messages.GenesisMigrationSpec) *pb.GenesisMigration {
+	if spec == nil {
+		return nil
+	}
+
+	gm := &pb.GenesisMigration{
+		ExportHeight:     spec.ExportHeight,
+		Sha:              spec.SHA,
+		MigrationCommand: spec.MigrationCommand,
+	}
+
+	for _, kv := range spec.GenesisModifications {
+		gm.GenesisModifications = append(gm.GenesisModifications, marshalGenesisKV(kv))
+	}
+
+	return gm
+}
+
+func convertProtoCustomGenesis(cg *pb.CustomGenesis) *messages.CustomGenesisSpec {
+	if cg == nil {
+		return nil
+	}
+
+	return &messages.CustomGenesisSpec{
+		GenesisURL:          cg.GenesisUrl,
+		GenesisSHA256:       cg.GenesisSha256,
+		ValidatorKeysURL:    cg.ValidatorKeysUrl,
+		ValidatorKeysSHA256: cg.ValidatorKeysSha256,
+	}
+}
+
+func convertCustomGenesisToProto(spec *messages.CustomGenesisSpec) *pb.CustomGenesis {
+	if spec == nil {
+		return nil
+	}
+
+	return &pb.CustomGenesis{
+		GenesisUrl:          spec.GenesisURL,
+		GenesisSha256:       spec.GenesisSHA256,
+		ValidatorKeysUrl:    spec.ValidatorKeysURL,
+		ValidatorKeysSha256: spec.ValidatorKeysSHA256,
+	}
+}
+
+// convertProtoImageGroups collects the image groups of a chain config, a region sha is an image group of the region
+func convertProtoImageGroups(cc *pb.ChainConfig) []types.ImageGroup {
+	var groups []types.ImageGroup
+
+	for _, rc := range cc.RegionConfigs {
+		if rc.Sha != "" {
+			groups = append(groups, types.ImageGroup{SHA: rc.Sha, Region: rc.Name})
+		}
+	}
+
+	for _, g := range cc.ImageGroups {
+		groups = append(groups, types.ImageGroup{
+			SHA:             g.Sha,
+			NumOfValidators: g.NumOfValidators,
+			NumOfNodes:      g.NumOfNodes,
+		})
+	}
+
+	return groups
+}
+
+func setImageGroupsOnProto(chainConfig *pb.ChainConfig, groups []types.ImageGroup) {
+	for _, g := range groups {
+		if g.Region == "" {
+			chainConfig.ImageGroups = append(chainConfig.ImageGroups, &pb.ImageGroup{
+				Sha:             g.SHA,
+				NumOfValidators: g.NumOfValidators,
+				NumOfNodes:      g.NumOfNodes,
+			})
+			continue
+		}
+
+		for _, rc := range chainConfig.RegionConfigs {
+			if rc.Name == g.Region {
+				rc.Sha = g.SHA
+			}
+		}
+	}
+}
+
+func (s *Service) convertProtoConfigOverride(appConfig, consensusConfig, clientConfig string) petritypes.ConfigOverride {
+	return petritypes.ConfigOverride{
+		CustomAppConfig:       s.parseJSONConfig(appConfig, "custom_app_config"),
+		CustomConsensusConfig: s.parseJSONConfig(consensusConfig, "custom_consensus_config"),
+		CustomClientConfig:    s.parseJSONConfig(clientConfig, "custom_client_config"),
+	}
+}
+
+// convertProtoConfigOverrides converts the config overrides of a chain config, an override with indices is
+// converted into one override per index
+func (s *Service) convertProtoConfigOverrides(cc *pb.ChainConfig) []petritypes.NodeConfigOverride {
+	var overrides []petritypes.NodeConfigOverride
+
+	for _, o := range cc.ConfigOverrides {
+		override := petritypes.NodeConfigOverride{
+			Role:           petritypes.NodeRole(o.Role),
+			ConfigOverride: s.convertProtoConfigOverride(o.CustomAppConfig, o.CustomConsensusConfig, o.CustomClientConfig),
+		}
+
+		if len(o.Indices) == 0 {
+			overrides = append(overrides, override)
+			continue
+		}
+
+		for _, index := range o.Indices {
+			i := int(index)
+			override.Index = &i
+			overrides = append(overrides, override)
+		}
+	}
+
+	return overrides
+}
+
+func setConfigOverridesOnProto(chainConfig *pb.ChainConfig, overrides []petritypes.NodeConfigOverride) {
+	for _, o := range overrides {
+		override := &pb.ConfigOverride{
+			Role:                  string(o.Role),
+			CustomAppConfig:       marshalJSONConfig(o.CustomAppConfig),
+			CustomConsensusConfig: marshalJSONConfig(o.CustomConsensusConfig),
+			CustomClientConfig:    marshalJSONConfig(o.CustomClientConfig),
+		}
+
+		if o.Index != nil {
+			override.Indices = []uint32{uint32(*o.Index)}
+		}
+
+		chainConfig.ConfigOverrides = append(chainConfig.ConfigOverrides, override)
+	}
+}
+
+func convertProtoStakeDistribution(d *pb.StakeDistribution) *petritypes.StakeDistribution {
+	if d == nil {
+		return nil
+	}
+
+	return &petritypes.StakeDistribution{
+		Type:     petritypes.StakeDistributionType(d.Type),
+		Amounts:  d.Amounts,
+		Exponent: d.Exponent,
+		TopN:     int(d.TopN),
+		TopShare: d.TopShare,
+	}
+}
+
+func convertStakeDistributionToProto(d *petritypes.StakeDistribution) *pb.StakeDistribution {
+	if d == nil {
+		return nil
+	}
+
+	return &pb.StakeDistribution{
+		Type:     string(d.Type),
+		Amounts:  d.Amounts,
+		Exponent: d.Exponent,
+		TopN:     uint32(d.TopN),
+		TopShare: d.TopShare,
+	}
+}
+
+func convertProtoTopology(t *pb.Topology) *petritypes.Topology {
+	if t == nil {
+		return nil
+	}
+
+	topology := &petritypes.Topology{
+		Type:                 petritypes.TopologyType(t.Type),
+		SentriesPerValidator: int(t.SentriesPerValidator),
+		Degree:               int(t.Degree),
+		Seed:                 t.Seed,
+	}
+
+	for _, p := range t.Peers {
+		if topology.Peers == nil {
+			topology.Peers = make(map[string][]string, len(t.Peers))
+		}
+		topology.Peers[p.Node] = p.Peers
+	}
+
+	return topology
+}
+
+func convertTopologyToProto(t *petritypes.Topology) *pb.Topology {
+	if t == nil {
+		return nil
+	}
+
+	topology := &pb.Topology{
+		Type:                 string(t.Type),
+		SentriesPerValidator: uint32(t.SentriesPerValidator),
+		Degree:               uint32(t.Degree),
+		Seed:                 t.Seed,
+	}
+
+	for _, node := range slices.Sorted(maps.Keys(t.Peers)) {
+		topology.Peers = append(topology.Peers, &pb.TopologyPeers{Node: node, Peers: t.Peers[node]})
+	}
+
+	return topology
+}
+
+func convertProtoRemoteSigner(s *pb.RemoteSigner) *petritypes.RemoteSigner {
+	if s == nil {
+		return nil
+	}
+
+	return &petritypes.RemoteSigner{
+		Image:     s.Image,
+		Shares:    int(s.Shares),
+		Threshold: int(s.Threshold),
+	}
+}
+
+func convertRemoteSignerToProto(s *petritypes.RemoteSigner) *pb.RemoteSigner {
+	if s == nil {
+		return nil
+	}
+
+	return &pb.RemoteSigner{
+		Image:     s.Image,
+		Shares:    uint32(s.Shares),
+		Threshold: uint32(s.Threshold),
+	}
+}
+
+func (s *Service) convertProtoChainConfig(cc *pb.ChainConfig) types.ChainsConfig {
+	chainConfig := types.ChainsConfig{
+		Name:                  cc.Name,
+		Image:                 cc.Image,
+		Version:               cc.Version,
+		NumOfNodes:            cc.NumOfNodes,
+		NumOfValidators:       cc.NumOfValidators,
+		SetSeedNode:           cc.SetSeedNode,
+		SetPersistentPeers:    cc.SetPersistentPeers,
+		CustomAppConfig:       s.parseJSONConfig(cc.CustomAppConfig, "custom_app_config"),
+		CustomConsensusConfig: s.parseJSONConfig(cc.CustomConsensusConfig, "custom_consensus_config"),
+		CustomClientConfig:    s.parseJSONConfig(cc.CustomClientConfig, "custom_client_config"),
+	}
+
+	for _, rc := range cc.RegionConfigs {
+		chainConfig.RegionConfigs = append(chainConfig.RegionConfigs, petritypes.RegionConfig{
+			Name:           rc.Name,
+			NumNodes:       int(rc.NumOfNodes),
+			NumValidators:  int(rc.NumOfValidators),
+			ConfigOverride: s.convertProtoConfigOverride(rc.CustomAppConfig, rc.CustomConsensusConfig, rc.CustomClientConfig),
+		})
+	}
+
+	for _, gm := range cc.GenesisModifications {
+		chainConfig.GenesisModifications = append(chainConfig.GenesisModifications, parseGenesisKV(gm))
+	}
+
+	chainConfig.ImageGroups = convertProtoImageGroups(cc)
+
+	chainConfig.ConfigOverrides = s.convertProtoConfigOverrides(cc)
+	chainConfig.StakeDistribution = convertProtoStakeDistribution(cc.StakeDistribution)
+	chainConfig.Topology = convertProtoTopology(cc.Topology)
+	chainConfig.RemoteSigner = convertProtoRemoteSigner(cc.RemoteSigner)
+
+	return chainConfig
+}
+
+func convertChainConfigToProto(cc types.ChainsConfig) *pb.ChainConfig {
+	chainConfig := &pb.ChainConfig{
+		Name:                  cc.Name,
+		Image:                 cc.Image,
+		Version:               cc.Version,
+		NumOfNodes:            cc.NumOfNodes,
+		NumOfValidators:       cc.NumOfValidators,
+		SetSeedNode:           cc.SetSeedNode,
+		SetPersistentPeers:    cc.SetPersistentPeers,
+		CustomAppConfig:       marshalJSONConfig(cc.CustomAppConfig),
+		CustomConsensusConfig: marshalJSONConfig(cc.CustomConsensusConfig),
+		CustomClientConfig:    marshalJSONConfig(cc.CustomClientConfig),
+	}
+
+	for _, rc := range cc.RegionConfigs {
+		chainConfig.RegionConfigs = append(chainConfig.RegionConfigs, &pb.RegionConfig{
+			Name:                  rc.Name,
+			NumOfNodes:            uint64(rc.NumNodes),
+			NumOfValidators:       uint64(rc.NumValidators),
+			CustomAppConfig:       marshalJSONConfig(rc.CustomAppConfig),
+			CustomConsensusConfig: marshalJSONConfig(rc.CustomConsensusConfig),
+			CustomClientConfig:    marshalJSONConfig(rc.CustomClientConfig),
+		})
+	}
+
+	for _, gm := range cc.GenesisModifications {
+		chainConfig.GenesisModifications = append(chainConfig.GenesisModifications, marshalGenesisKV(gm))
+	}
+
+	setImageGroupsOnProto(chainConfig, cc.ImageGroups)
+	setConfigOverridesOnProto(chainConfig, cc.ConfigOverrides)
+	chainConfig.StakeDistribution = convertStakeDistributionToProto(cc.StakeDistribution)
+	chainConfig.Topology = convertTopologyToProto(cc.Topology)
+	chainConfig.RemoteSigner = convertRemoteSignerToProto(cc.RemoteSigner)
+
+	return chainConfig
+}
+
+func (s *Service) convertProtoAdditionalChains(chains []*pb.AdditionalChain) []messages.AdditionalChain {
+	var additionalChains []messages.AdditionalChain
+
+	for _, c := range chains {
+		additionalChain := messages.AdditionalChain{
+			Repo:       c.Repo,
+			SHA:        c.Sha,
+			IsEvmChain: c.IsEvmChain,
+		}
+
+		if c.ChainConfig != nil {
+			additionalChain.ChainConfig = s.convertProtoChainConfig(c.ChainConfig)
+		}
+
+		additionalChains = append(additionalChains, additionalChain)
+	}
+
+	return additionalChains
+}
+
+func convertAdditionalChainsToProto(chains []messages.AdditionalChain) []*pb.AdditionalChain {
+	var additionalChains []*pb.AdditionalChain
+
+	for _, c := range chains {
+		additionalChains = append(additionalChains, &pb.AdditionalChain{
+			Repo:        c.Repo,
+			Sha:         c.SHA,
+			IsEvmChain:  c.IsEvmChain,
+			ChainConfig: convertChainConfigToProto(c.ChainConfig),
+		})
+	}
+
+	return additionalChains
+}
+
+func convertProtoRelayer(r *pb.Relayer) *messages.RelayerSpec {
+	if r == nil {
+		return nil
+	}
+
+	spec := &messages.RelayerSpec{Image: r.Image}
+
+	if r.IbcTransferLoad != nil {
+		spec.IBCTransferLoad = &messages.IBCTransferLoadSpec{
+			Duration:  r.IbcTransferLoad.Duration,
+			Amount:    r.IbcTransferLoad.Amount,
+			MsgsPerTx: int(r.IbcTransferLoad.MsgsPerTx),
+		}
+	}
+
+	return spec
+}
+
+func convertRelayerToProto(spec *messages.RelayerSpec) *pb.Relayer {
+	if spec == nil {
+		return nil
+	}
+
+	r := &pb.Relayer{Image: spec.Image}
+
+	if spec.IBCTransferLoad != nil {
+		r.IbcTransferLoad = &pb.IBCTransferLoad{
+			Duration:  spec.IBCTransferLoad.Duration,
+			Amount:    spec.IBCTransferLoad.Amount,
+			MsgsPerTx: int32(spec.IBCTransferLoad.MsgsPerTx),
+		}
+	}
+
+	return r
+}
+
+func convertProtoLoadBalancerOptions(o *pb.LoadBalancerOptions) apps.LoadBalancerOptions {
+	if o == nil {
+		return apps.LoadBalancerOptions{}
+	}
+
+	return apps.LoadBalancerOptions{
+		HealthCheck:        o.HealthCheck,
+		Policy:             o.Policy,
+		RateLimit:          int(o.RateLimit),
+		MaxRequestBodySize: o.MaxRequestBodySize,
+	}
+}
+
+func convertLoadBalancerOptionsToProto(o apps.LoadBalancerOptions) *pb.LoadBalancerOptions {
+	return &pb.LoadBalancerOptions{
+		HealthCheck:        o.HealthCheck,
+		Policy:             o.Policy,
+		RateLimit:          int32(o.RateLimit),
+		MaxRequestBodySize: o.MaxRequestBodySize,
+	}
+}
+
+func convertProtoLoadBalancerSpec(lb *pb.LoadBalancerSpec) *messages.LoadBalancerSpec {
+	if lb == nil {
+		return nil
+	}
+
+	spec := &messages.LoadBalancerSpec{
+		Options:           convertProtoLoadBalancerOptions(lb.Options),
+		ExcludeValidators: lb.ExcludeValidators,
+	}
+
+	for _, domain := range lb.DomainOptions {
+		if spec.DomainOptions == nil {
+			spec.DomainOptions = make(map[string]apps.LoadBalancerOptions, len(lb.DomainOptions))
+		}
+		spec.DomainOptions[domain.DomainType] = convertProtoLoadBalancerOptions(domain.Options)
+	}
+
+	return spec
+}
+
+func convertLoadBalancerSpecToProto(spec *messages.LoadBalancerSpec) *pb.LoadBalancerSpec {
+	if spec == nil {
+		return nil
+	}
+
+	lb := &pb.LoadBalancerSpec{
+		Options:           convertLoadBalancerOptionsToProto(spec.Options),
+		ExcludeValidators: spec.ExcludeValidators,
+	}
+
+	for _, domainType := range slices.Sorted(maps.Keys(spec.DomainOptions)) {
+		lb.DomainOptions = append(lb.DomainOptions, &pb.LoadBalancerDomainOptions{
+			DomainType: domainType,
+			Options:    convertLoadBalancerOptionsToProto(spec.DomainOptions[domainType]),
+		})
+	}
+
+	return lb
+}
+
+func convertProtoGoModReplaces(replaces []*pb.GoModReplace) map[string]string {
+	if len(replaces) == 0 {
+		return nil
+	}
+
+	modules := make(map[string]string, len(replaces))
+	for _, r := range replaces {
+		modules[r.Module] = r.Replacement
+	}
+
+	return modules
+}
+
+func convertGoModReplacesToProto(modules map[string]string) []*pb.GoModReplace {
+	var replaces []*pb.GoModReplace
+
+	for _, module := range slices.Sorted(maps.Keys(modules)) {
+		replaces = append(replaces, &pb.GoModReplace{Module: module, Replacement: modules[module]})
+	}
+
+	return replaces
+}
+
+func convertProtoChainSource(source *pb.ChainSource) messages.ChainSource {
+	if source == nil {
+		return messages.ChainSource{}
+	}
+
+	return messages.ChainSource{
+		GitURL:  source.GitUrl,
+		GitAuth: source.GitAuth,
+		Patch:   source.Patch,
+		Tarball: source.Tarball,
+	}
+}
+
+func convertChainSourceToProto(source messages.ChainSource) *pb.ChainSource {
+	if source.GitURL == "" && !source.HasOverlay() {
+		return nil
+	}
+
+	return &pb.ChainSource{
+		GitUrl:  source.GitURL,
+		GitAuth: source.GitAuth,
+		Patch:   source.Patch,
+		Tarball: source.Tarball,
+	}
+}
+
+func convertProtoFaults(faults []*pb.Fault) []messages.FaultSpec {
+	var specs []messages.FaultSpec
+
+	for _, f := range faults {
+		specs = append(specs, messages.FaultSpec{
+			Type:      messages.FaultType(f.Type),
+			After:     f.After,
+			Validator: int(f.Validator),
+			Timeout:   f.Timeout,
+		})
+	}
+
+	return specs
+}
+
+func convertFaultsToProto(specs []messages.FaultSpec) []*pb.Fault {
+	var faults []*pb.Fault
+
+	for _, spec := range specs {
+		faults = append(faults, &pb.Fault{
+			Type:      string(spec.Type),
+			After:     spec.After,
+			Validator: uint32(spec.Validator),
+			Timeout:   spec.Timeout,
+		})
+	}
+
+	return faults
+}
+
 func decodeLoadTestSpec(s string) (catalysttypes.LoadTestSpec, error) {
 	spec := catalysttypes.LoadTestSpec{}
 	err := yaml.Unmarshal([]byte(s), &spec)
@@ -776,6 +1514,9 @@ func (s *Service) convertProtoToWorkflowRequest(req *pb.CreateWorkflowRequest) m
 		SHA:                    req.Sha,
 		CosmosSdkSha:           req.CosmosSdkSha,
 		CometBFTSha:            req.CometbftSha,
+		GoModReplaces:          convertProtoGoModReplaces(req.GoModReplaces),
+		Source:                 convertProtoChainSource(req.Source),
+		BuildVariant:           messages.BuildVariant(req.BuildVariant),
 		IsEvmChain:             req.IsEvmChain,
 		RunnerType:             messages.RunnerType(req.RunnerType),
 		LongRunningTestnet:     req.LongRunningTestnet,
@@ -783,44 +1524,28 @@ func (s *Service) convertProtoToWorkflowRequest(req *pb.CreateWorkflowRequest) m
 		TestnetDuration:        req.TestnetDuration,
 		NumWallets:             int(req.NumWallets),
 		ProviderSpecificConfig: req.ProviderConfig,
+		GenesisMigration:       convertProtoGenesisMigration(req.GenesisMigration),
+		CustomGenesis:          convertProtoCustomGenesis(req.CustomGenesis),
+		AdditionalChains:       s.convertProtoAdditionalChains(req.AdditionalChains),
+		Relayer:                convertProtoRelayer(req.Relayer),
+		LoadBalancer:           convertProtoLoadBalancerSpec(req.LoadBalancer),
+		Faults:                 convertProtoFaults(req.Faults),
 	}
 
 	if req.ChainConfig != nil {
-		chainConfig := types.ChainsConfig{
-			Name:                  req.ChainConfig.Name,
-			Image:                 req.ChainConfig.Image,
-			NumOfNodes:            req.ChainConfig.NumOfNodes,
-			NumOfValidators:       req.ChainConfig.NumOfValidators,
-			SetSeedNode:           req.ChainConfig.SetSeedNode,
-			SetPersistentPeers:    req.ChainConfig.SetPersistentPeers,
-			CustomAppConfig:       s.parseJSONConfig(req.ChainConfig.CustomAppConfig, "custom_app_config"),
-			CustomConsensusConfig: s.parseJSONConfig(req.ChainConfig.CustomConsensusConfig, "custom_consensus_config"),
-			CustomClientConfig:    s.parseJSONConfig(req.ChainConfig.CustomClientConfig, "custom_client_config"),
-		}
-
-		if req.ChainConfig.RegionConfigs != nil {
-			for _, rc := range req.ChainConfig.RegionConfigs {
-				chainConfig.RegionConfigs = append(chainConfig.RegionConfigs, petritypes.RegionConfig{
-					Name:          rc.Name,
-					NumNodes:      int(rc.NumOfNodes),
-					NumValidators: int(rc.NumOfValidators),
-				})
-			}
-		}
-
-		if req.ChainConfig.GenesisModifications != nil {
-			for _, gm := range req.ChainConfig.GenesisModifications {
-				chainConfig.GenesisModifications = append(
-					chainConfig.GenesisModifications,
-					chain.GenesisKV{
-						Key:   gm.Key,
-						Value: gm.Value,
-					},
-				)
-			}
+		workflowReq.ChainConfig = s.convertProtoChainConfig(req.ChainConfig)
+
+		// templates keep the genesis modification values as the strings they were saved with
+		workflowReq.ChainConfig.GenesisModifications = nil
+		for _, gm := range req.ChainConfig.GenesisModifications {
+			workflowReq.ChainConfig.GenesisModifications = append(
+				workflowReq.ChainConfig.GenesisModifications,
+				chain.GenesisKV{
+					Key:   gm.Key,
+					Value: gm.Value,
+				},
+			)
 		}
-
-		workflowReq.ChainConfig = chainConfig
 	}
 
 	if len(req.EncodedLoadTestSpec) != 0 {
@@ -846,6 +1571,9 @@ func (s *Service) convertWorkflowRequestToProto(req messages.TestnetWorkflowRequ
 		Sha:                req.SHA,
 		CosmosSdkSha:       req.CosmosSdkSha,
 		CometbftSha:        req.CometBFTSha,
+		GoModReplaces:      convertGoModReplacesToProto(req.GoModReplaces),
+		Source:             convertChainSourceToProto(req.Source),
+		BuildVariant:       string(req.BuildVariant),
 		IsEvmChain:         req.IsEvmChain,
 		RunnerType:         string(req.RunnerType),
 		LongRunningTestnet: req.LongRunningTestnet,
@@ -853,46 +1581,15 @@ func (s *Service) convertWorkflowRequestToProto(req messages.TestnetWorkflowRequ
 		TestnetDuration:    req.TestnetDuration,
 		NumWallets:         int32(req.NumWallets),
 		ProviderConfig:     req.ProviderSpecificConfig,
+		GenesisMigration:   convertGenesisMigrationToProto(req.GenesisMigration),
+		CustomGenesis:      convertCustomGenesisToProto(req.CustomGenesis),
+		AdditionalChains:   convertAdditionalChainsToProto(req.AdditionalChains),
+		Relayer:            convertRelayerToProto(req.Relayer),
+		LoadBalancer:       convertLoadBalancerSpecToProto(req.LoadBalancer),
+		Faults:             convertFaultsToProto(req.Faults),
 	}
 
-	chainConfig := &pb.ChainConfig{
-		Name:                  req.ChainConfig.Name,
-		Image:                 req.ChainConfig.Image,
-		NumOfNodes:            req.ChainConfig.NumOfNodes,
-		NumOfValidators:       req.ChainConfig.NumOfValidators,
-		SetSeedNode:           req.ChainConfig.SetSeedNode,
-		SetPersistentPeers:    req.ChainConfig.SetPersistentPeers,
-		CustomAppConfig:       marshalJSONConfig(req.ChainConfig.CustomAppConfig),
-		CustomConsensusConfig: marshalJSONConfig(req.ChainConfig.CustomConsensusConfig),
-		CustomClientConfig:    marshalJSONConfig(req.ChainConfig.CustomClientConfig),
-	}
-
-	for _, rc := range req.ChainConfig.RegionConfigs {
-		chainConfig.RegionConfigs = append(chainConfig.RegionConfigs, &pb.RegionConfig{
-			Name:            rc.Name,
-			NumOfNodes:      uint64(rc.NumNodes),
-			NumOfValidators: uint64(rc.NumValidators),
-		})
-	}
-
-	for _, gm := range req.ChainConfig.GenesisModifications {
-		value := ""
-		if gm.Value != nil {
-			if str, ok := gm.Value.(string); ok {
-				value = str
-			} else {
-				if bytes, err := json.Marshal(gm.Value); err == nil {
-					value = string(bytes)
-				}
-			}
-		}
-		chainConfig.GenesisModifications = append(chainConfig.GenesisModifications, &pb.GenesisKV{
-			Key:   gm.Key,
-			Value: value,
-		})
-	}
-
-	protoReq.ChainConfig = chainConfig
+	protoReq.ChainConfig = convertChainConfigToProto(req.ChainConfig)
 
 	if req.EthereumLoadTestSpec != nil {
 		encodedSpec, err := encodeLoadTestSpec(*req.EthereumLoadTestSpec)
@@ -3,9 +3,15 @@ package workflow
 import (
 	"math/big"
 	"testing"
+	"time"
 
 	ctlteth "github.com/skip-mev/catalyst/chains/ethereum/types"
+	catalysttypes "github.com/skip-mev/catalyst/chains/types"
+	"github.com/skip-mev/ironbird/messages"
+	"github.com/skip-mev/ironbird/petri/cosmos/chain"
+	pb "github.com/skip-mev/ironbird/server/proto"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 )
 
 func TestEncodeDecodeLoadTestSpec(t *testing.T) {
@@ -19,3 +25,60 @@ func TestEncodeDecodeLoadTestSpec(t *testing.T) {
 	_, err = encodeLoadTestSpec(decodedLoadTestSpec)
 	require.NoError(t, err)
 }
+
+func TestSetTestnetStatusOnProto(t *testing.T) {
+	dbNodes := []*pb.Node{{Name: "validator-0", Address: "10.0.0.1"}}
+	workflow := &pb.Workflow{Validators: dbNodes}
+
+	setTestnetStatusOnProto(workflow, messages.TestnetStatus{Phase: messages.PhaseLaunching})
+	require.Equal(t, "launching", workflow.Phase)
+	require.Equal(t, dbNodes, workflow.Validators)
+	require.Nil(t, workflow.LoadTest)
+	require.Empty(t, workflow.ExpectedEndTime)
+
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	liveNodes := []*pb.Node{{Name: "validator-0", Address: "10.0.0.2"}}
+	setTestnetStatusOnProto(workflow, messages.TestnetStatus{
+		Phase:      messages.PhaseRunning,
+		ChainID:    "simapp-1",
+		Validators: liveNodes,
+		Health: &messages.CheckHealthResponse{
+			Height: 42,
+			Nodes:  []messages.NodeHealth{{Name: "validator-0", Height: 42}},
+		},
+		HealthCheckedAt: now,
+		LoadTest: &messages.LoadTestProgress{
+			StartedAt: now,
+			Overall:   &catalysttypes.OverallStats{TotalTransactions: 10, SuccessfulTransactions: 9, TPS: 1.5},
+		},
+		ExpectedEnd: now.Add(time.Hour),
+	})
+	require.Equal(t, "running", workflow.Phase)
+	require.Equal(t, "simapp-1", workflow.ChainId)
+	require.Equal(t, liveNodes, workflow.Validators)
+	require.Equal(t, uint64(42), workflow.Height)
+	require.Len(t, workflow.NodeHealth, 1)
+	require.Equal(t, "2026-01-02T03:04:05Z", workflow.LoadTest.StartTime)
+	require.Empty(t, workflow.LoadTest.EndTime)
+	require.Equal(t, int64(9), workflow.LoadTest.SuccessfulTransactions)
+	require.Equal(t, "2026-01-02T04:04:05Z", workflow.ExpectedEndTime)
+}
+
+func TestConvertProtoToWorkflowRequestGenesisModifications(t *testing.T) {
+	s := &Service{logger: zap.NewNop()}
+
+	req := s.convertProtoToWorkflowRequest(&pb.CreateWorkflowRequest{
+		ChainConfig: &pb.ChainConfig{
+			Name:                 "simapp",
+			GenesisModifications: []*pb.GenesisKV{{Key: "app_state.gov.params.burn_vote_veto", Value: "true"}},
+		},
+		GenesisMigration: &pb.GenesisMigration{
+			GenesisModifications: []*pb.GenesisKV{{Key: "app_state.gov.params.quorum", Value: `{"value":"0.1"}`}},
+		},
+	})
+
+	require.Equal(t, []chain.GenesisKV{{Key: "app_state.gov.params.burn_vote_veto", Value: "true"}},
+		req.ChainConfig.GenesisModifications)
+	require.Equal(t, []chain.GenesisKV{{Key: "app_state.gov.params.quorum", Value: map[string]interface{}{"value": "0.1"}}},
+		req.GenesisMigration.GenesisModifications)
+}
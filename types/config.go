@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"time"
 
 	petritypes "github.com/skip-mev/ironbird/petri/core/types"
@@ -34,6 +35,8 @@ type LoadBalancerConfig struct {
 	RootDomain  string `yaml:"root_domain"`
 	SSLKeyPath  string `yaml:"ssl_key_path"`
 	SSLCertPath string `yaml:"ssl_cert_path"`
+	// Image is the Caddy image of load balancers, rate limits require an image with the caddy-ratelimit module
+	Image string `yaml:"image"`
 }
 
 type TelemetryConfig struct {
@@ -77,6 +80,7 @@ type BuilderConfig struct {
 	BuildKitAddress string              `yaml:"build_kit_address"`
 	Local           LocalRegistryConfig `yaml:"local"`
 	ECR             ECRRegistryConfig   `yaml:"ecr"`
+	OCI             OCIRegistryConfig   `yaml:"oci"`
 	AuthEnvConfigs  map[string]string   `yaml:"auth_env_configs"`
 }
 
@@ -89,11 +93,26 @@ type ECRRegistryConfig struct {
 	ImageName string `yaml:"image_name"`
 }
 
+// OCIRegistryConfig is a registry implementing the OCI distribution API, e.g. GHCR, Harbor or a registry:2. Its
+// credentials are read from REGISTRY_USERNAME and REGISTRY_PASSWORD, or REGISTRY_TOKEN for a bearer token
+type OCIRegistryConfig struct {
+	URL       string `yaml:"url"`
+	ImageName string `yaml:"image_name"`
+	// Insecure talks to the registry over plain HTTP, e.g. a registry:2 without TLS
+	Insecure bool `yaml:"insecure,omitempty"`
+}
+
 // RegistryConfig represents the active registry configuration
 type RegistryConfig struct {
 	Type      string
 	URL       string
 	ImageName string
+	Insecure  bool
+	// Username, Password and Token are the static credentials of an oci registry, ECR credentials are fetched
+	// from AWS instead
+	Username string
+	Password string
+	Token    string
 }
 type ChainsConfig struct {
 	Name                  string                    `yaml:"name"`
@@ -108,6 +127,25 @@ type ChainsConfig struct {
 	CustomClientConfig    map[string]interface{}    `yaml:"custom_client_config"`
 	SetSeedNode           bool                      `yaml:"set_seed_node"`
 	SetPersistentPeers    bool                      `yaml:"set_persistent_peers"`
+	ImageGroups           []ImageGroup              `yaml:"image_groups,omitempty"`
+	// ConfigOverrides are applied on top of the custom configs for validators, full nodes or a single node
+	ConfigOverrides []petritypes.NodeConfigOverride `yaml:"config_overrides,omitempty"`
+	// StakeDistribution skews the genesis self-delegations of the validators, they are uniform if not set
+	StakeDistribution *petritypes.StakeDistribution `yaml:"stake_distribution,omitempty"`
+	// Topology determines the peers of every node instead of SetSeedNode and SetPersistentPeers
+	Topology *petritypes.Topology `yaml:"topology,omitempty"`
+	// RemoteSigner runs the consensus key of every validator in separate (optionally threshold) signer tasks
+	RemoteSigner *petritypes.RemoteSigner `yaml:"remote_signer,omitempty"`
+}
+
+// ImageGroup runs a subset of the validators and nodes on an image built from a different SHA of the chain repo.
+// A group with a region overrides every validator and node of that region, other groups are assigned to
+// validators and nodes in order
+type ImageGroup struct {
+	SHA             string `yaml:"sha"`
+	Region          string `yaml:"region,omitempty"`
+	NumOfValidators uint64 `yaml:"num_of_validators"`
+	NumOfNodes      uint64 `yaml:"num_of_nodes"`
 }
 
 type GrafanaConfig struct {
@@ -153,6 +191,10 @@ type ServerConfig struct {
 
 type Chains map[string]ChainImageConfig
 
+// ChainImageConfig describes how to build a chain image and the chain profile of the chains running it
+// DefaultDecimals is the number of decimals of chain images that don't configure them
+const DefaultDecimals = 6
+
 type ChainImageConfig struct {
 	Name            string   `yaml:"name"`
 	Dockerfile      string   `yaml:"dockerfile"`
@@ -163,6 +205,77 @@ type ChainImageConfig struct {
 	Entrypoint      []string `yaml:"entrypoint"`
 	HomeDir         string   `yaml:"home_dir"`
 	GasPrices       string   `yaml:"gas_prices"`
+
+	Denom string `yaml:"denom"`
+	// Decimals is the number of decimals of the denom, DefaultDecimals if unset
+	Decimals     *int   `yaml:"decimals,omitempty"`
+	Bech32Prefix string `yaml:"bech32_prefix"`
+	CoinType     string `yaml:"coin_type"`
+	// SigningAlgorithm is the key algorithm of the chain's wallets, either secp256k1 or eth_secp256k1
+	SigningAlgorithm string `yaml:"signing_algorithm"`
+	// EVMChainID is the EVM chain ID of evm chains, which is also used as their cosmos chain ID
+	EVMChainID           string   `yaml:"evm_chain_id,omitempty"`
+	AdditionalStartFlags []string `yaml:"additional_start_flags,omitempty"`
+	AdditionalPorts      []string `yaml:"additional_ports,omitempty"`
+}
+
+// GetDecimals returns the number of decimals of the denom, or DefaultDecimals if unset
+func (c ChainImageConfig) GetDecimals() uint64 {
+	if c.Decimals == nil {
+		return DefaultDecimals
+	}
+
+	return uint64(*c.Decimals)
+}
+
+func (c ChainImageConfig) ValidateBasic() error {
+	if c.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+
+	if c.Dockerfile == "" {
+		return fmt.Errorf("dockerfile is required")
+	}
+
+	if c.BinaryName == "" {
+		return fmt.Errorf("binary_name is required")
+	}
+
+	if c.HomeDir == "" {
+		return fmt.Errorf("home_dir is required")
+	}
+
+	if c.GasPrices == "" {
+		return fmt.Errorf("gas_prices is required")
+	}
+
+	if c.Denom == "" {
+		return fmt.Errorf("denom is required")
+	}
+
+	if c.Bech32Prefix == "" {
+		return fmt.Errorf("bech32_prefix is required")
+	}
+
+	if c.Decimals != nil && *c.Decimals < 0 {
+		return fmt.Errorf("decimals cannot be negative")
+	}
+
+	if c.CoinType == "" {
+		return fmt.Errorf("coin_type is required")
+	}
+
+	if _, err := strconv.ParseUint(c.CoinType, 10, 32); err != nil {
+		return fmt.Errorf("coin_type %s is not a number", c.CoinType)
+	}
+
+	switch c.SigningAlgorithm {
+	case petritypes.Secp256k1, petritypes.EthSecp256k1:
+	default:
+		return fmt.Errorf("signing_algorithm must be either %s or %s", petritypes.Secp256k1, petritypes.EthSecp256k1)
+	}
+
+	return nil
 }
 
 func ParseWorkerConfig(path string) (WorkerConfig, error) {
@@ -177,6 +290,12 @@ func ParseWorkerConfig(path string) (WorkerConfig, error) {
 		return WorkerConfig{}, fmt.Errorf("failed to unmarshal config: %w", err)
 	}
 
+	for name, chain := range config.Chains {
+		if err := chain.ValidateBasic(); err != nil {
+			return WorkerConfig{}, fmt.Errorf("chain %s is invalid: %w", name, err)
+		}
+	}
+
 	config.DigitalOcean.Token = os.Getenv("DIGITALOCEAN_TOKEN")
 
 	config.Tailscale.NodeAuthKey = os.Getenv("TS_NODE_AUTH_KEY")
@@ -185,6 +304,11 @@ func ParseWorkerConfig(path string) (WorkerConfig, error) {
 	return config, nil
 }
 
+// IsRemote returns whether images are pushed to a registry, rather than loaded into the local Docker daemon
+func (r RegistryConfig) IsRemote() bool {
+	return r.Type == "ecr" || r.Type == "oci"
+}
+
 // GetActiveRegistry returns the active registry configuration based on REGISTRY_MODE env var
 func (c *BuilderConfig) GetActiveRegistry() RegistryConfig {
 	mode := os.Getenv("REGISTRY_MODE")
@@ -202,6 +326,18 @@ func (c *BuilderConfig) GetActiveRegistry() RegistryConfig {
 		}
 	}
 
+	if mode == "oci" {
+		return RegistryConfig{
+			Type:      "oci",
+			URL:       c.OCI.URL,
+			ImageName: c.OCI.ImageName,
+			Insecure:  c.OCI.Insecure,
+			Username:  os.Getenv("REGISTRY_USERNAME"),
+			Password:  os.Getenv("REGISTRY_PASSWORD"),
+			Token:     os.Getenv("REGISTRY_TOKEN"),
+		}
+	}
+
 	return RegistryConfig{
 		Type:      "local",
 		URL:       "",
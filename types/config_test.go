@@ -3,6 +3,7 @@ package types
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -44,6 +45,9 @@ builder:
   ecr:
     url: test.registry.com
     image_name: test/image
+  oci:
+    url: ghcr.io
+    image_name: org/images
   auth_env_configs:
     TEST_ENV: test_value
 chains:
@@ -55,6 +59,11 @@ chains:
     binary_name: test-binary
     home_dir: /home/test
     gas_prices: 0.025stake
+    denom: stake
+    decimals: 6
+    bech32_prefix: osmo
+    coin_type: "118"
+    signing_algorithm: secp256k1
 grafana:
   url: http://grafana:3000
   dashboards:
@@ -98,6 +107,14 @@ server_address: localhost:9006
 		assert.Equal(t, "test/image", config.Builder.ECR.ImageName)
 		assert.Equal(t, "test_value", config.Builder.AuthEnvConfigs["TEST_ENV"])
 
+		t.Setenv("REGISTRY_MODE", "oci")
+		t.Setenv("REGISTRY_TOKEN", "registry-token")
+		registry := config.Builder.GetActiveRegistry()
+		assert.Equal(t, "ghcr.io", registry.URL)
+		assert.Equal(t, "org/images", registry.ImageName)
+		assert.Equal(t, "registry-token", registry.Token)
+		assert.True(t, registry.IsRemote())
+
 		assert.Contains(t, config.Chains, "test-chain")
 		assert.Equal(t, "test-chain", config.Chains["test-chain"].Name)
 		assert.Equal(t, "test.dockerfile", config.Chains["test-chain"].Dockerfile)
@@ -106,6 +123,11 @@ server_address: localhost:9006
 		assert.Equal(t, "test-binary", config.Chains["test-chain"].BinaryName)
 		assert.Equal(t, "/home/test", config.Chains["test-chain"].HomeDir)
 		assert.Equal(t, "0.025stake", config.Chains["test-chain"].GasPrices)
+		assert.Equal(t, "stake", config.Chains["test-chain"].Denom)
+		assert.Equal(t, uint64(6), config.Chains["test-chain"].GetDecimals())
+		assert.Equal(t, "osmo", config.Chains["test-chain"].Bech32Prefix)
+		assert.Equal(t, "118", config.Chains["test-chain"].CoinType)
+		assert.Equal(t, "secp256k1", config.Chains["test-chain"].SigningAlgorithm)
 
 		assert.Equal(t, "http://grafana:3000", config.Grafana.URL)
 		assert.Len(t, config.Grafana.Dashboards, 1)
@@ -131,6 +153,37 @@ server_address: localhost:9006
 		assert.Contains(t, err.Error(), "failed to unmarshal config")
 	})
 
+	t.Run("incomplete chain profile", func(t *testing.T) {
+		incompletePath := filepath.Join(tempDir, "incomplete.yaml")
+		incompleteYaml := strings.Replace(validConfigYaml, "    bech32_prefix: osmo\n", "", 1)
+		require.NoError(t, os.WriteFile(incompletePath, []byte(incompleteYaml), 0644))
+
+		_, err := ParseWorkerConfig(incompletePath)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "chain test-chain is invalid: bech32_prefix is required")
+	})
+
+	t.Run("chain decimals", func(t *testing.T) {
+		decimalsPath := filepath.Join(tempDir, "decimals.yaml")
+
+		zeroYaml := strings.Replace(validConfigYaml, "    decimals: 6\n", "    decimals: 0\n", 1)
+		require.NoError(t, os.WriteFile(decimalsPath, []byte(zeroYaml), 0644))
+		config, err := ParseWorkerConfig(decimalsPath)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(0), config.Chains["test-chain"].GetDecimals())
+
+		unsetYaml := strings.Replace(validConfigYaml, "    decimals: 6\n", "", 1)
+		require.NoError(t, os.WriteFile(decimalsPath, []byte(unsetYaml), 0644))
+		config, err = ParseWorkerConfig(decimalsPath)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(DefaultDecimals), config.Chains["test-chain"].GetDecimals())
+
+		negativeYaml := strings.Replace(validConfigYaml, "    decimals: 6\n", "    decimals: -1\n", 1)
+		require.NoError(t, os.WriteFile(decimalsPath, []byte(negativeYaml), 0644))
+		_, err = ParseWorkerConfig(decimalsPath)
+		assert.ErrorContains(t, err, "chain test-chain is invalid: decimals cannot be negative")
+	})
+
 }
 
 func TestParseServerConfig(t *testing.T) {
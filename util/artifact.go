@@ -0,0 +1,122 @@
+package util
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// blockedArtifactPrefixes are the address ranges, besides loopback, link-local, private and unspecified addresses,
+// that artifacts are never fetched from
+var blockedArtifactPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+}
+
+// artifactClient only connects to public addresses. The address is checked after resolution, so that neither a
+// redirect nor a DNS record pointing at an internal host reaches it
+var artifactClient = &http.Client{
+	Timeout: 30 * time.Minute,
+	Transport: &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout: 30 * time.Second,
+			Control: func(_, address string, _ syscall.RawConn) error {
+				return checkArtifactAddress(address)
+			},
+		}).DialContext,
+		TLSHandshakeTimeout: 10 * time.Second,
+	},
+}
+
+// isPublicAddress reports whether addr is a globally routable address
+func isPublicAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if addr.IsLoopback() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() || addr.IsPrivate() ||
+		addr.IsUnspecified() || addr.IsMulticast() || addr.IsInterfaceLocalMulticast() {
+		return false
+	}
+
+	for _, prefix := range blockedArtifactPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func checkArtifactAddress(address string) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("failed to parse artifact address %q: %w", address, err)
+	}
+
+	if !isPublicAddress(addrPort.Addr()) {
+		return fmt.Errorf("artifact address %s is not public", addrPort.Addr())
+	}
+
+	return nil
+}
+
+// FetchArtifact downloads an artifact referenced by an http(s) URL of a public host. If expectedSHA256 is set the
+// artifact's digest is verified before it is returned. Gzipped artifacts are decompressed
+func FetchArtifact(ctx context.Context, artifactURL, expectedSHA256 string) ([]byte, error) {
+	u, err := url.Parse(artifactURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse artifact url: %w", err)
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported artifact url scheme %q", u.Scheme)
+	}
+
+	if addr, err := netip.ParseAddr(strings.Trim(u.Hostname(), "[]")); err == nil && !isPublicAddress(addr) {
+		return nil, fmt.Errorf("artifact host %s is not public", u.Hostname())
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, artifactURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create artifact request: %w", err)
+	}
+
+	resp, err := artifactClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch artifact: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch artifact: unexpected status %s", resp.Status)
+	}
+
+	bz, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read artifact: %w", err)
+	}
+
+	if expectedSHA256 != "" {
+		digest := sha256.Sum256(bz)
+		if actual := hex.EncodeToString(digest[:]); !strings.EqualFold(actual, expectedSHA256) {
+			return nil, fmt.Errorf("artifact digest mismatch: expected %s, got %s", expectedSHA256, actual)
+		}
+	}
+
+	if bytes.HasPrefix(bz, []byte{0x1f, 0x8b}) {
+		return DecompressData(bz)
+	}
+
+	return bz, nil
+}
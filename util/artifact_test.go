@@ -0,0 +1,54 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsPublicAddress(t *testing.T) {
+	tests := map[string]bool{
+		"8.8.8.8":             true,
+		"2606:4700::1111":     true,
+		"127.0.0.1":           false,
+		"::1":                 false,
+		"10.1.2.3":            false,
+		"172.16.0.1":          false,
+		"192.168.1.1":         false,
+		"169.254.169.254":     false,
+		"fe80::1":             false,
+		"fd00::1":             false,
+		"0.0.0.0":             false,
+		"100.100.100.200":     false,
+		"::ffff:127.0.0.1":    false,
+		"::ffff:169.254.0.10": false,
+	}
+
+	for address, public := range tests {
+		require.Equal(t, public, isPublicAddress(netip.MustParseAddr(address)), address)
+	}
+}
+
+func TestFetchArtifactRejectsInternalHosts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "{}")
+	}))
+	defer server.Close()
+
+	_, err := FetchArtifact(context.Background(), server.URL, "")
+	require.ErrorContains(t, err, "is not public")
+
+	_, err = FetchArtifact(context.Background(), "http://localhost:1/genesis.json", "")
+	require.ErrorContains(t, err, "is not public")
+
+	_, err = FetchArtifact(context.Background(), "http://[::1]:1/genesis.json", "")
+	require.ErrorContains(t, err, "is not public")
+
+	_, err = FetchArtifact(context.Background(), "file:///etc/passwd", "")
+	require.ErrorContains(t, err, "unsupported artifact url scheme")
+}
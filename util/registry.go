@@ -0,0 +1,241 @@
+package util
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/docker/docker/api/types/registry"
+
+	"github.com/skip-mev/ironbird/types"
+)
+
+// manifestMediaTypes are the manifest types a tag can point to, multi-platform images are pushed as an index
+var manifestMediaTypes = []string{
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}
+
+// RegistryCredentials are the credentials images are pushed to and pulled from a registry with, either a username
+// and password or a bearer token. Empty credentials access the registry anonymously
+type RegistryCredentials struct {
+	Username string
+	Password string
+	Token    string
+}
+
+// GetRegistryCredentials returns the credentials of the active registry. ECR credentials are fetched from AWS, oci
+// registries use the static credentials of their config and the local registry doesn't have any
+func GetRegistryCredentials(ctx context.Context, registryConfig types.RegistryConfig, awsConfig *aws.Config,
+) (RegistryCredentials, error) {
+	switch registryConfig.Type {
+	case "ecr":
+		if awsConfig == nil {
+			return RegistryCredentials{}, fmt.Errorf("ecr registry requires an aws config")
+		}
+
+		token, err := FetchDockerRepoToken(ctx, *awsConfig)
+		if err != nil {
+			return RegistryCredentials{}, err
+		}
+
+		decodedToken, err := base64.StdEncoding.DecodeString(token)
+		if err != nil {
+			return RegistryCredentials{}, fmt.Errorf("failed to decode ECR token: %w", err)
+		}
+
+		// username:password
+		username, password, ok := strings.Cut(string(decodedToken), ":")
+		if !ok {
+			return RegistryCredentials{}, fmt.Errorf("invalid ECR token format")
+		}
+
+		return RegistryCredentials{Username: username, Password: password}, nil
+	case "oci":
+		return RegistryCredentials{
+			Username: registryConfig.Username,
+			Password: registryConfig.Password,
+			Token:    registryConfig.Token,
+		}, nil
+	default:
+		return RegistryCredentials{}, nil
+	}
+}
+
+// IsEmpty returns whether the registry is accessed anonymously
+func (c RegistryCredentials) IsEmpty() bool {
+	return c.Username == "" && c.Password == "" && c.Token == ""
+}
+
+// DockerAuth returns the credentials in the Docker API RegistryAuth format, or an empty string for anonymous access
+func (c RegistryCredentials) DockerAuth(serverAddress string) (string, error) {
+	if c.IsEmpty() {
+		return "", nil
+	}
+
+	return registry.EncodeAuthConfig(registry.AuthConfig{
+		Username:      c.Username,
+		Password:      c.Password,
+		RegistryToken: c.Token,
+		ServerAddress: serverAddress,
+	})
+}
+
+// ImageExistsInRegistry checks whether a tag of the registry's image exists through the registry v2 API. Registries
+// that answer with a bearer challenge are authenticated against with a token fetched from their token service
+func ImageExistsInRegistry(ctx context.Context, registryConfig types.RegistryConfig, credentials RegistryCredentials,
+	tag string,
+) (bool, error) {
+	scheme := "https"
+	if registryConfig.Insecure {
+		scheme = "http"
+	}
+	manifestURL := fmt.Sprintf("%s://%s/v2/%s/manifests/%s", scheme, registryConfig.URL, registryConfig.ImageName, tag)
+
+	authorization := ""
+	switch {
+	case credentials.Token != "":
+		authorization = "Bearer " + credentials.Token
+	case credentials.Username != "":
+		authorization = "Basic " + basicAuth(credentials)
+	}
+
+	resp, err := headManifest(ctx, manifestURL, authorization)
+	if err != nil {
+		return false, err
+	}
+
+	if resp.StatusCode == http.StatusUnauthorized && credentials.Token == "" {
+		scheme, params := parseChallenge(resp.Header.Get("WWW-Authenticate"))
+		if !strings.EqualFold(scheme, "bearer") {
+			return false, fmt.Errorf("registry %s rejected the credentials: %s", registryConfig.URL, resp.Status)
+		}
+
+		token, err := fetchRegistryToken(ctx, params, registryConfig.ImageName, credentials)
+		if err != nil {
+			return false, err
+		}
+
+		if resp, err = headManifest(ctx, manifestURL, "Bearer "+token); err != nil {
+			return false, err
+		}
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, fmt.Errorf("failed to check if image exists in registry %s: unexpected status %s",
+			registryConfig.URL, resp.Status)
+	}
+}
+
+func headManifest(ctx context.Context, manifestURL, authorization string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, manifestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create manifest request: %w", err)
+	}
+
+	req.Header.Set("Accept", strings.Join(manifestMediaTypes, ", "))
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch manifest: %w", err)
+	}
+	resp.Body.Close()
+
+	return resp, nil
+}
+
+// fetchRegistryToken fetches a pull token for the image from the token service of a bearer challenge
+func fetchRegistryToken(ctx context.Context, challenge map[string]string, imageName string,
+	credentials RegistryCredentials,
+) (string, error) {
+	realm, err := url.Parse(challenge["realm"])
+	if err != nil || realm.Host == "" {
+		return "", fmt.Errorf("invalid registry token realm %q", challenge["realm"])
+	}
+
+	query := realm.Query()
+	if service := challenge["service"]; service != "" {
+		query.Set("service", service)
+	}
+	query.Set("scope", fmt.Sprintf("repository:%s:pull", imageName))
+	realm.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create token request: %w", err)
+	}
+
+	if credentials.Username != "" {
+		req.SetBasicAuth(credentials.Username, credentials.Password)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch registry token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to fetch registry token: unexpected status %s", resp.Status)
+	}
+
+	var tokenResp struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
+		return "", fmt.Errorf("failed to decode registry token: %w", err)
+	}
+
+	if tokenResp.Token != "" {
+		return tokenResp.Token, nil
+	}
+
+	if tokenResp.AccessToken != "" {
+		return tokenResp.AccessToken, nil
+	}
+
+	return "", fmt.Errorf("registry token service returned no token")
+}
+
+// parseChallenge parses a WWW-Authenticate header like
+// Bearer realm="https://ghcr.io/token",service="ghcr.io",scope="repository:org/image:pull"
+func parseChallenge(header string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(header), " ")
+	params := make(map[string]string)
+
+	for rest != "" {
+		var key, value string
+		key, rest, _ = strings.Cut(strings.TrimLeft(rest, ", "), "=")
+		if strings.HasPrefix(rest, `"`) {
+			value, rest, _ = strings.Cut(rest[1:], `"`)
+		} else {
+			value, rest, _ = strings.Cut(rest, ",")
+		}
+
+		if key = strings.TrimSpace(key); key != "" {
+			params[strings.ToLower(key)] = value
+		}
+	}
+
+	return scheme, params
+}
+
+func basicAuth(credentials RegistryCredentials) string {
+	return base64.StdEncoding.EncodeToString([]byte(credentials.Username + ":" + credentials.Password))
+}
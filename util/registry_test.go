@@ -0,0 +1,78 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/ironbird/types"
+)
+
+func TestImageExistsInRegistry(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/token":
+			username, password, ok := r.BasicAuth()
+			if !ok || username != "user" || password != "pass" ||
+				r.URL.Query().Get("scope") != "repository:org/images:pull" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			fmt.Fprint(w, `{"token":"pull-token"}`)
+		case r.Header.Get("Authorization") != "Bearer pull-token":
+			w.Header().Set("WWW-Authenticate",
+				fmt.Sprintf(`Bearer realm="%s/token",service="registry.test",scope="repository:org/images:pull"`, server.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+		case r.URL.Path == "/v2/org/images/manifests/gaia-abc123":
+			require.Contains(t, r.Header.Get("Accept"), "application/vnd.oci.image.index.v1+json")
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	registryConfig := types.RegistryConfig{
+		Type:      "oci",
+		URL:       strings.TrimPrefix(server.URL, "http://"),
+		ImageName: "org/images",
+		Insecure:  true,
+	}
+	credentials := RegistryCredentials{Username: "user", Password: "pass"}
+
+	exists, err := ImageExistsInRegistry(context.Background(), registryConfig, credentials, "gaia-abc123")
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	exists, err = ImageExistsInRegistry(context.Background(), registryConfig, credentials, "gaia-def456")
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	// a static token is sent as is, so a registry rejecting it isn't retried with a token from the token service
+	_, err = ImageExistsInRegistry(context.Background(), registryConfig, RegistryCredentials{Token: "stale"}, "gaia-abc123")
+	require.ErrorContains(t, err, "401")
+
+	exists, err = ImageExistsInRegistry(context.Background(), registryConfig, RegistryCredentials{Token: "pull-token"}, "gaia-abc123")
+	require.NoError(t, err)
+	require.True(t, exists)
+}
+
+func TestParseChallenge(t *testing.T) {
+	scheme, params := parseChallenge(`Bearer realm="https://ghcr.io/token",service="ghcr.io",scope="repository:org/image:pull"`)
+	require.Equal(t, "Bearer", scheme)
+	require.Equal(t, map[string]string{
+		"realm":   "https://ghcr.io/token",
+		"service": "ghcr.io",
+		"scope":   "repository:org/image:pull",
+	}, params)
+
+	scheme, params = parseChallenge(`Basic realm="Harbor"`)
+	require.Equal(t, "Basic", scheme)
+	require.Equal(t, "Harbor", params["realm"])
+}
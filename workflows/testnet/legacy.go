@@ -0,0 +1,184 @@
+package testnet
+
+import (
+	"fmt"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"go.uber.org/zap"
+
+	"github.com/skip-mev/ironbird/messages"
+	"github.com/skip-mev/ironbird/petri/core/util"
+	ironbirdutil "github.com/skip-mev/ironbird/util"
+)
+
+const (
+	// supervisedTestnetChangeID versions runs of the testnet workflow launched in resumable steps, with renewed
+	// expiries, persisted provider state and supervised long-running testnets. Runs started by workers predating it
+	// have no version marker and keep replaying legacyWorkflow. Once none of them is left, legacyWorkflow can be removed
+	// and the minimum supported version raised to supervisedTestnetVersion
+	supervisedTestnetChangeID = "supervised-testnet"
+	supervisedTestnetVersion  = 1
+)
+
+// legacyWorkflow issues the commands of the testnet workflow as it was before supervisedTestnetChangeID, so that runs
+// started before it replay deterministically until they are torn down
+func legacyWorkflow(ctx workflow.Context, req messages.TestnetWorkflowRequest) (messages.TestnetWorkflowResponse, error) {
+	logger := workflow.GetLogger(ctx)
+	runName := fmt.Sprintf("ib-%s", util.RandomString(6))
+	logger.Info("replaying testnet workflow started before supervised testnets", zap.String("run_name", runName))
+	ctx = workflow.WithActivityOptions(ctx, defaultWorkflowOptions)
+
+	var buildResult messages.BuildDockerImageResponse
+	if err := workflow.ExecuteActivity(ctx, builderActivities.BuildDockerImage, messages.BuildDockerImageRequest{
+		Repo:         req.Repo,
+		SHA:          req.SHA,
+		CosmosSdkSha: req.CosmosSdkSha,
+		CometBFTSha:  req.CometBFTSha,
+		ImageConfig: messages.ImageConfig{
+			Name:    req.ChainConfig.Name,
+			Image:   req.ChainConfig.Image,
+			Version: req.ChainConfig.Version,
+		},
+	}).Get(ctx, &buildResult); err != nil {
+		return "", err
+	}
+
+	if err := legacyStartWorkflow(ctx, req, runName, buildResult); err != nil {
+		if temporal.IsCanceledError(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return "", nil
+}
+
+func legacyStartWorkflow(ctx workflow.Context, req messages.TestnetWorkflowRequest, runName string,
+	buildResult messages.BuildDockerImageResponse,
+) error {
+	var providerState []byte
+	cleanupCtx, _ := workflow.NewDisconnectedContext(ctx)
+	defer func() {
+		if len(providerState) != 0 {
+			// unlike teardownProvider, the previous layout did not clear the saved provider state
+			workflow.GetLogger(cleanupCtx).Info("tearing down provider")
+			if err := workflow.ExecuteActivity(cleanupCtx, testnetActivities.TeardownProvider, messages.TeardownProviderRequest{
+				RunnerType:    req.RunnerType,
+				ProviderState: providerState,
+			}).Get(cleanupCtx, nil); err != nil {
+				workflow.GetLogger(cleanupCtx).Error("failed to teardown provider", zap.Error(err))
+			}
+		}
+	}()
+
+	var createProviderResp messages.CreateProviderResponse
+	if err := workflow.ExecuteActivity(ctx, testnetActivities.CreateProvider, messages.CreateProviderRequest{
+		RunnerType: req.RunnerType,
+		Name:       runName,
+	}).Get(ctx, &createProviderResp); err != nil {
+		return err
+	}
+
+	var testnetResp messages.LaunchTestnetResponse
+	launchCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Hour * 24 * 365,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 1,
+		},
+	})
+	if err := workflow.ExecuteActivity(launchCtx, testnetActivities.LaunchTestnet, messages.LaunchTestnetRequest{
+		Name:                   req.ChainConfig.Name,
+		Repo:                   req.Repo,
+		SHA:                    req.SHA,
+		IsEvmChain:             req.IsEvmChain,
+		Image:                  buildResult.FQDNTag,
+		BaseImage:              req.ChainConfig.Image,
+		GenesisModifications:   req.ChainConfig.GenesisModifications,
+		RunnerType:             req.RunnerType,
+		NumOfValidators:        req.ChainConfig.NumOfValidators,
+		NumOfNodes:             req.ChainConfig.NumOfNodes,
+		RegionConfigs:          req.ChainConfig.RegionConfigs,
+		CustomAppConfig:        req.ChainConfig.CustomAppConfig,
+		CustomConsensusConfig:  req.ChainConfig.CustomConsensusConfig,
+		CustomClientConfig:     req.ChainConfig.CustomClientConfig,
+		SetSeedNode:            req.ChainConfig.SetSeedNode,
+		SetPersistentPeers:     req.ChainConfig.SetPersistentPeers,
+		ProviderState:          createProviderResp.ProviderState,
+		NumWallets:             req.NumWallets,
+		BaseMnemonic:           req.BaseMnemonic,
+		ProviderSpecificConfig: req.ProviderSpecificConfig,
+	}).Get(ctx, &testnetResp); err != nil {
+		// CreateProvider returns the provider state uncompressed, TeardownProvider expects it compressed
+		providerState = createProviderResp.ProviderState
+		if compressed, compressErr := ironbirdutil.CompressData(providerState); compressErr == nil {
+			providerState = compressed
+		}
+		return err
+	}
+
+	providerState = testnetResp.ProviderState
+
+	if req.LaunchLoadBalancer && req.RunnerType == messages.DigitalOcean {
+		domains := messages.LoadBalancerSpec{}.Domains(req.ChainConfig.Name, testnetResp.Nodes, testnetResp.Validators,
+			req.IsEvmChain)
+
+		var loadBalancerResp messages.LaunchLoadBalancerResponse
+		if err := workflow.ExecuteActivity(ctx, loadBalancerActivities.LaunchLoadBalancer, messages.LaunchLoadBalancerRequest{
+			ProviderState: providerState,
+			RunnerType:    req.RunnerType,
+			Domains:       domains,
+			WorkflowID:    workflow.GetInfo(ctx).WorkflowExecution.ID,
+			IsEvmChain:    req.IsEvmChain,
+		}).Get(ctx, &loadBalancerResp); err != nil {
+			return err
+		}
+		providerState = loadBalancerResp.ProviderState
+	}
+
+	selector := workflow.NewSelector(ctx)
+
+	var loadTestFuture workflow.Future
+	loadTestSpec := req.EthereumLoadTestSpec
+	if loadTestSpec == nil {
+		loadTestSpec = req.CosmosLoadTestSpec
+	}
+	if loadTestSpec != nil {
+		loadTestFuture = workflow.ExecuteActivity(workflow.WithStartToCloseTimeout(ctx, loadTestTimeout),
+			loadTestActivities.RunLoadTest, messages.RunLoadTestRequest{
+				ChainState:      testnetResp.ChainState,
+				ProviderState:   providerState,
+				LoadTestSpec:    *loadTestSpec,
+				RunnerType:      req.RunnerType,
+				IsEvmChain:      req.IsEvmChain,
+				BaseMnemonic:    req.BaseMnemonic,
+				NumWallets:      req.NumWallets,
+				CatalystVersion: req.CatalystVersion,
+			})
+		selector.AddFuture(loadTestFuture, func(f workflow.Future) {
+			if err := f.Get(ctx, nil); err != nil {
+				workflow.GetLogger(ctx).Error("load test failed", zap.Error(err))
+			}
+		})
+	}
+
+	timeout := time.Hour * 24 * 365 * 10
+	if !req.LongRunningTestnet {
+		timeout = defaultRuntime
+		if d, err := time.ParseDuration(req.TestnetDuration); err == nil {
+			timeout = max(d, defaultRuntime)
+		}
+	}
+	selector.AddFuture(workflow.NewTimer(ctx, timeout), func(_ workflow.Future) {})
+
+	selector.Select(ctx)
+
+	if loadTestFuture != nil && !temporal.IsCanceledError(ctx.Err()) {
+		if err := loadTestFuture.Get(ctx, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package testnet
+
+import (
+	"github.com/skip-mev/ironbird/messages"
+	"go.temporal.io/sdk/workflow"
+)
+
+// setStatusQueryHandler answers TestnetStatusQuery with the current value of status, so that the server sees the
+// live state of the testnet even if a push of the workflow's data failed
+func setStatusQueryHandler(ctx workflow.Context, status *messages.TestnetStatus) error {
+	return workflow.SetQueryHandler(ctx, messages.TestnetStatusQuery, func() (messages.TestnetStatus, error) {
+		return *status, nil
+	})
+}
+
+// recordLoadTest records the result of a completed load test in its progress
+func recordLoadTest(ctx workflow.Context, progress *messages.LoadTestProgress, resp messages.RunLoadTestResponse,
+	err error,
+) {
+	progress.CompletedAt = workflow.Now(ctx)
+	if err != nil {
+		progress.Error = err.Error()
+		return
+	}
+
+	progress.Error = resp.Result.Error
+	progress.Overall = &resp.Result.Overall
+}
@@ -0,0 +1,232 @@
+package testnet
+
+import (
+	"slices"
+	"time"
+
+	"github.com/skip-mev/ironbird/messages"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"go.uber.org/zap"
+)
+
+const (
+	// supervisePeriod is how long a run of a long-running testnet's workflow supervises it before it continues as
+	// new, which keeps the history of every run bounded
+	supervisePeriod = time.Hour * 24
+	// healthCheckInterval is how often the nodes of long-running testnets are checked
+	healthCheckInterval = time.Minute * 10
+)
+
+// Supervise is the workflow long-running testnets continue as once they launched. Every run supervises the testnet
+// for supervisePeriod and continues as new with the testnet's state, until the workflow is cancelled
+func Supervise(ctx workflow.Context, req messages.SuperviseWorkflowRequest) error {
+	ctx = workflow.WithActivityOptions(ctx, defaultWorkflowOptions)
+	testnet := req.Testnet
+
+	cleanupCtx, _ := workflow.NewDisconnectedContext(ctx)
+	stateSaver := &providerStateSaver{
+		workflowID: workflow.GetInfo(ctx).WorkflowExecution.ID,
+		runnerType: req.Request.RunnerType,
+		saved:      testnet.ProviderState,
+	}
+
+	err := superviseTestnet(ctx, req.Request, &testnet, stateSaver, workflow.NewSelector(ctx))
+	if workflow.IsContinueAsNewError(err) {
+		return err
+	}
+
+	testnet.Status.Phase = messages.PhaseTearingDown
+	teardownProvider(cleanupCtx, req.Request.RunnerType, testnet.ProviderState)
+	return err
+}
+
+// superviseTestnet runs a launched long-running testnet until the workflow is cancelled. It handles signals, injects
+// the pending faults, checks the health of the chain and renews the lease on the testnet's resources. After
+// supervisePeriod, or once the history of the run grows too large, it returns a continue-as-new error carrying the
+// testnet as soon as the load started on the testnet completed
+func superviseTestnet(ctx workflow.Context, req messages.TestnetWorkflowRequest, testnet *messages.SupervisedTestnet,
+	stateSaver *providerStateSaver, selector workflow.Selector, loads ...workflow.Future,
+) error {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("supervising long-running testnet until the workflow is cancelled",
+		zap.Time("launched_at", testnet.LaunchedAt), zap.Int("pending_faults", len(testnet.PendingFaults)))
+
+	// the status is answered from the supervised testnet, so that it's carried over when continuing as new
+	testnet.Status.Phase = messages.PhaseRunning
+	testnet.Status.ExpectedEnd = time.Time{}
+	if err := setStatusQueryHandler(ctx, &testnet.Status); err != nil {
+		return err
+	}
+
+	// upgrades and faults run as chain operations, their completion wakes up the selector so that the run can
+	// continue as new
+	ops := newChainOperations(ctx)
+	runOperation := func(op func(ctx workflow.Context)) {
+		selector.AddFuture(ops.run(ctx, op), func(workflow.Future) {})
+	}
+
+	// the lease is renewed at the start of every run and then halfway through it
+	renewLease := func(ctx workflow.Context) {
+		testnet.ProviderState = setExpiry(ctx, req, testnet.ProviderState, resourceExpiry(ctx, req, time.Time{}))
+		stateSaver.save(ctx, testnet.ProviderState)
+	}
+	renewLease(ctx)
+
+	// timer futures are canceled when the workflow context is canceled, which unblocks the selector
+	var addLeaseTimer func()
+	addLeaseTimer = func() {
+		selector.AddFuture(workflow.NewTimer(ctx, longRunningLease/2), func(f workflow.Future) {
+			if f.Get(ctx, nil) != nil {
+				return
+			}
+			// the lease changes the provider state, so it's serialized with the operations that change it as well
+			runOperation(renewLease)
+			addLeaseTimer()
+		})
+	}
+	addLeaseTimer()
+
+	var addHealthCheckTimer func()
+	addHealthCheckTimer = func() {
+		selector.AddFuture(workflow.NewTimer(ctx, healthCheckInterval), func(f workflow.Future) {
+			if f.Get(ctx, nil) != nil {
+				return
+			}
+			checkHealth(ctx, req, testnet)
+			addHealthCheckTimer()
+		})
+	}
+	addHealthCheckTimer()
+
+	for _, signalName := range upgradeSignalNames {
+		selector.AddReceive(workflow.GetSignalChannel(ctx, signalName), func(c workflow.ReceiveChannel, _ bool) {
+			var signal messages.UpgradeSignal
+			c.Receive(ctx, &signal)
+
+			// the testnet keeps running until the workflow is cancelled, so failed upgrades are only logged
+			runOperation(func(ctx workflow.Context) {
+				testnet.Status.Phase = messages.PhaseUpgrading
+				testnet.ChainState, testnet.ProviderState, _ = upgradeTestnet(ctx, req, signalName, signal,
+					testnet.ChainState, testnet.ProviderState, testnet.LoadBalancerState, &testnet.Status)
+				stateSaver.save(ctx, testnet.ProviderState)
+				testnet.Status.Phase = messages.PhaseRunning
+			})
+		})
+	}
+
+	selector.AddReceive(workflow.GetSignalChannel(ctx, messages.ExtendTestnetSignal), func(c workflow.ReceiveChannel, _ bool) {
+		var signal messages.ExtendSignal
+		c.Receive(ctx, &signal)
+		logger.Info("long-running testnets renew their lease until the workflow is cancelled, ignoring extension")
+	})
+
+	for _, fault := range testnet.PendingFaults {
+		// delays were validated when the workflow was created
+		after, _ := time.ParseDuration(fault.After)
+		delay := max(testnet.LaunchedAt.Add(after).Sub(workflow.Now(ctx)), 0)
+		selector.AddFuture(workflow.NewTimer(ctx, delay), func(f workflow.Future) {
+			if f.Get(ctx, nil) != nil {
+				return
+			}
+
+			if i := slices.Index(testnet.PendingFaults, fault); i >= 0 {
+				testnet.PendingFaults = slices.Delete(testnet.PendingFaults, i, i+1)
+			}
+
+			runOperation(func(ctx workflow.Context) {
+				testnet.Status.Phase = messages.PhaseInjectingFault
+				var err error
+				testnet.ProviderState, err = injectFault(ctx, req, fault, testnet.ChainState, testnet.ProviderState)
+				stateSaver.save(ctx, testnet.ProviderState)
+				testnet.Status.Phase = messages.PhaseRunning
+				if err != nil {
+					logger.Error("fault failed", zap.String("type", string(fault.Type)), zap.Error(err))
+				}
+			})
+		})
+	}
+
+	continueAsNew := false
+	selector.AddFuture(workflow.NewTimer(ctx, supervisePeriod), func(f workflow.Future) {
+		continueAsNew = f.Get(ctx, nil) == nil
+	})
+
+	loadsCompleted := func() bool {
+		for _, load := range loads {
+			if load != nil && !load.IsReady() {
+				return false
+			}
+		}
+		return true
+	}
+
+	for {
+		selector.Select(ctx)
+		if ctx.Err() != nil {
+			break
+		}
+
+		// signals that were received but not handled yet would be lost when continuing as new, and so would the
+		// state of running operations
+		if (continueAsNew || workflow.GetInfo(ctx).GetContinueAsNewSuggested()) && !selector.HasPending() &&
+			ops.idle() && loadsCompleted() {
+			logger.Info("continuing supervision of long-running testnet as new",
+				zap.Int("history_length", workflow.GetInfo(ctx).GetCurrentHistoryLength()))
+			return workflow.NewContinueAsNewError(ctx, Supervise, messages.SuperviseWorkflowRequest{
+				Request: req,
+				Testnet: *testnet,
+			})
+		}
+	}
+
+	// operations that are still running finish before the testnet is torn down, their provider state includes the
+	// tasks they created
+	cleanupCtx, _ := workflow.NewDisconnectedContext(ctx)
+	ops.wait(cleanupCtx)
+
+	// instrumented binaries only flush their coverage counters when they stop. The workflow was cancelled, which
+	// completes it gracefully, so failures are only logged
+	if req.BuildVariant != "" {
+		testnet.Status.Phase = messages.PhaseCollectingInstrumentation
+		_ = collectInstrumentation(cleanupCtx, req, testnet.ChainState, testnet.ProviderState)
+	}
+
+	logger.Info("workflow was cancelled, completing gracefully")
+	return nil
+}
+
+// checkHealth checks the nodes of a long-running testnet. Unhealthy nodes and chains that stopped producing blocks
+// are only logged since the testnet keeps running
+func checkHealth(ctx workflow.Context, req messages.TestnetWorkflowRequest, testnet *messages.SupervisedTestnet) {
+	logger := workflow.GetLogger(ctx)
+
+	activityOptions := workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 1,
+		},
+	}
+
+	var resp messages.CheckHealthResponse
+	if err := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, activityOptions), testnetActivities.CheckHealth,
+		messages.CheckHealthRequest{
+			RunnerType:    req.RunnerType,
+			ProviderState: testnet.ProviderState,
+			ChainState:    testnet.ChainState,
+		}).Get(ctx, &resp); err != nil {
+		logger.Error("health check failed", zap.Error(err))
+		return
+	}
+
+	if unhealthy := resp.Unhealthy(); len(unhealthy) != 0 {
+		logger.Warn("testnet has unhealthy nodes", zap.Int("unhealthy", len(unhealthy)), zap.Any("nodes", unhealthy))
+	}
+
+	if last := testnet.Status.Health; last != nil && resp.Height <= last.Height {
+		logger.Error("chain did not produce blocks since the last health check", zap.Uint64("height", resp.Height))
+	}
+
+	testnet.Status.Health = &resp
+	testnet.Status.HealthCheckedAt = workflow.Now(ctx)
+}
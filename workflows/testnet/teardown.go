@@ -0,0 +1,76 @@
+package testnet
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/skip-mev/ironbird/messages"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"go.uber.org/zap"
+)
+
+var saveProviderStateOptions = workflow.ActivityOptions{
+	StartToCloseTimeout: time.Minute,
+	RetryPolicy: &temporal.RetryPolicy{
+		MaximumAttempts: 3,
+	},
+}
+
+// saveProviderState saves the compressed provider state of a workflow's testnet to the server. Failures are only
+// logged, the workflow tears its testnet down itself unless it is terminated
+func saveProviderState(ctx workflow.Context, workflowID string, runnerType messages.RunnerType, providerState []byte) {
+	err := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, saveProviderStateOptions),
+		testnetActivities.SaveProviderState, messages.SaveProviderStateRequest{
+			WorkflowID:    workflowID,
+			RunnerType:    runnerType,
+			ProviderState: providerState,
+		}).Get(ctx, nil)
+	if err != nil {
+		workflow.GetLogger(ctx).Error("failed to save provider state", zap.String("workflow_id", workflowID),
+			zap.Error(err))
+	}
+}
+
+// providerStateSaver saves the provider state of a workflow's testnet whenever it changed. States are saved even if
+// the workflow was cancelled, so that the last one is saved before the testnet is torn down
+type providerStateSaver struct {
+	workflowID string
+	runnerType messages.RunnerType
+	saved      []byte
+}
+
+func (s *providerStateSaver) save(ctx workflow.Context, providerState []byte) {
+	if len(providerState) == 0 || bytes.Equal(providerState, s.saved) {
+		return
+	}
+	ctx, _ = workflow.NewDisconnectedContext(ctx)
+	saveProviderState(ctx, s.workflowID, s.runnerType, providerState)
+	s.saved = providerState
+}
+
+// TeardownWorkflow tears down the testnet of another workflow from its last saved provider state. It's started by
+// ForceTeardown for workflows that can't tear down their testnet themselves, e.g. because they were terminated
+func TeardownWorkflow(ctx workflow.Context, req messages.TeardownWorkflowRequest) error {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("force tearing down testnet", zap.String("workflow_id", req.WorkflowID))
+
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Hour,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 3,
+		},
+	})
+
+	if err := workflow.ExecuteActivity(ctx, testnetActivities.TeardownProvider, messages.TeardownProviderRequest{
+		RunnerType:    req.RunnerType,
+		ProviderState: req.ProviderState,
+	}).Get(ctx, nil); err != nil {
+		logger.Error("failed to force teardown testnet", zap.String("workflow_id", req.WorkflowID), zap.Error(err))
+		return err
+	}
+
+	saveProviderState(ctx, req.WorkflowID, req.RunnerType, nil)
+
+	return nil
+}
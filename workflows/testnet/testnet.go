@@ -1,20 +1,25 @@
 package testnet
 
 import (
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	pb "github.com/skip-mev/ironbird/server/proto"
 
-	"github.com/skip-mev/ironbird/petri/core/apps"
+	petritypes "github.com/skip-mev/ironbird/petri/core/types"
 	"github.com/skip-mev/ironbird/petri/core/util"
 
 	"github.com/skip-mev/ironbird/activities/loadbalancer"
 	"github.com/skip-mev/ironbird/messages"
+	"github.com/skip-mev/ironbird/types"
 	ironbirdutil "github.com/skip-mev/ironbird/util"
 
 	"github.com/skip-mev/ironbird/activities/builder"
 	"github.com/skip-mev/ironbird/activities/loadtest"
+	"github.com/skip-mev/ironbird/activities/relayer"
 	"github.com/skip-mev/ironbird/activities/testnet"
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
@@ -26,11 +31,19 @@ var (
 	loadTestActivities     *loadtest.Activity
 	builderActivities      *builder.Activity
 	loadBalancerActivities *loadbalancer.Activity
+	relayerActivities      *relayer.Activity
 )
 
 const (
 	defaultRuntime  = time.Minute * 2
 	loadTestTimeout = time.Hour
+	// ibcTransferLoadHeartbeatTimeout detects an IBC transfer load whose worker hung, it heartbeats after every batch
+	ibcTransferLoadHeartbeatTimeout = time.Minute * 5
+	// expiryGracePeriod is added to the end of a testnet for the expiry stamped on its resources, so that launching
+	// and tearing it down never races the cleanup job
+	expiryGracePeriod = time.Hour
+	// longRunningLease is the expiry of the resources of long-running testnets, the workflow renews it while it runs
+	longRunningLease = time.Hour * 24
 )
 
 var defaultWorkflowOptions = workflow.ActivityOptions{
@@ -40,6 +53,17 @@ var defaultWorkflowOptions = workflow.ActivityOptions{
 	},
 }
 
+// launchTestnetOptions are the options of LaunchTestnet. A retried launch resumes from the checkpoint in its last
+// heartbeat, the heartbeat timeout detects workers that hung while launching
+var launchTestnetOptions = workflow.ActivityOptions{
+	StartToCloseTimeout: time.Hour * 24,
+	HeartbeatTimeout:    time.Minute * 5,
+	RetryPolicy: &temporal.RetryPolicy{
+		InitialInterval: time.Minute,
+		MaximumAttempts: 3,
+	},
+}
+
 func teardownProvider(ctx workflow.Context, runnerType messages.RunnerType, providerState []byte) {
 	workflow.GetLogger(ctx).Info("tearing down provider")
 	err := workflow.ExecuteActivity(ctx, testnetActivities.TeardownProvider, messages.TeardownProviderRequest{
@@ -48,41 +72,66 @@ func teardownProvider(ctx workflow.Context, runnerType messages.RunnerType, prov
 	}).Get(ctx, nil)
 	if err != nil {
 		workflow.GetLogger(ctx).Error("failed to teardown provider", zap.Error(err))
+		return
 	}
+
+	saveProviderState(ctx, workflow.GetInfo(ctx).WorkflowExecution.ID, runnerType, nil)
 }
 
-func waitForTestnetCompletion(ctx workflow.Context, req messages.TestnetWorkflowRequest,
-	selector workflow.Selector,
-) {
-	logger := workflow.GetLogger(ctx)
-	// 2. Long-running testnet does not end
-	if req.LongRunningTestnet {
-		logger.Info("testnet is in long-running mode - will run until workflow is cancelled")
-		// Use a very long timer so that cancellation unblocks the selector.
-		// Timer futures are canceled when the workflow context is canceled,
-		// which allows shutdownSelector.Select(ctx) to return deterministically
-		f := workflow.NewTimer(ctx, time.Hour*24*365*10)
-		selector.AddFuture(f, func(_ workflow.Future) {})
-	} else {
-		testnetDuration := defaultRuntime
-		if req.TestnetDuration != "" {
-			var err error
-			testnetDuration, err = time.ParseDuration(req.TestnetDuration)
-			if err != nil {
-				logger.Error("failed to parse testnet duration, falling back to default runtime",
-					zap.String("duration", req.TestnetDuration))
-				testnetDuration = defaultRuntime
-			}
+// networkTimeout returns how long a testnet runs once it is launched, unless it is long-running
+func networkTimeout(ctx workflow.Context, req messages.TestnetWorkflowRequest) time.Duration {
+	testnetDuration := defaultRuntime
+	if req.TestnetDuration != "" {
+		var err error
+		testnetDuration, err = time.ParseDuration(req.TestnetDuration)
+		if err != nil {
+			workflow.GetLogger(ctx).Error("failed to parse testnet duration, falling back to default runtime",
+				zap.String("duration", req.TestnetDuration))
+			testnetDuration = defaultRuntime
 		}
+	}
+
+	return max(testnetDuration, defaultRuntime)
+}
 
-		networkTimeout := max(testnetDuration, defaultRuntime)
-		logger.Info("network timeout", zap.Duration("timeout", networkTimeout))
-		f := workflow.NewTimer(ctx, networkTimeout)
-		selector.AddFuture(f, func(_ workflow.Future) {})
+// resourceExpiry returns the expiry stamped on the resources of a testnet ending at deadline
+func resourceExpiry(ctx workflow.Context, req messages.TestnetWorkflowRequest, deadline time.Time) time.Time {
+	if req.LongRunningTestnet {
+		return workflow.Now(ctx).Add(longRunningLease)
 	}
+	return deadline.Add(expiryGracePeriod)
+}
+
+// setExpiry stamps the resources of the testnet with a new expiry. Failures are only logged since the testnet keeps
+// running, the server database still protects it from the cleanup job
+func setExpiry(ctx workflow.Context, req messages.TestnetWorkflowRequest, providerState []byte, expiresAt time.Time) []byte {
+	activityOptions := workflow.ActivityOptions{
+		StartToCloseTimeout: 10 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 3,
+		},
+	}
+
+	var resp messages.SetExpiryResponse
+	if err := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, activityOptions), testnetActivities.SetExpiry,
+		messages.SetExpiryRequest{
+			RunnerType:    req.RunnerType,
+			ProviderState: providerState,
+			ExpiresAt:     expiresAt,
+		}).Get(ctx, &resp); err != nil {
+		workflow.GetLogger(ctx).Error("failed to set expiry", zap.Time("expires_at", expiresAt), zap.Error(err))
+		return providerState
+	}
+
+	return resp.ProviderState
 }
 
 func Workflow(ctx workflow.Context, req messages.TestnetWorkflowRequest) (messages.TestnetWorkflowResponse, error) {
+	if workflow.GetVersion(ctx, supervisedTestnetChangeID, workflow.DefaultVersion, supervisedTestnetVersion) ==
+		workflow.DefaultVersion {
+		return legacyWorkflow(ctx, req)
+	}
+
 	if err := req.Validate(); err != nil {
 		return "", temporal.NewApplicationErrorWithOptions("invalid workflow options", err.Error(),
 			temporal.ApplicationErrorOptions{NonRetryable: true})
@@ -94,12 +143,20 @@ func Workflow(ctx workflow.Context, req messages.TestnetWorkflowRequest) (messag
 	workflow.GetLogger(ctx).Info("run info", zap.String("run_id", runID), zap.String("run_name", runName), zap.Any("req", req))
 	ctx = workflow.WithActivityOptions(ctx, defaultWorkflowOptions)
 
+	status := &messages.TestnetStatus{Phase: messages.PhaseBuilding}
+	if err := setStatusQueryHandler(ctx, status); err != nil {
+		return "", err
+	}
+
 	var buildResult messages.BuildDockerImageResponse
 	err := workflow.ExecuteActivity(ctx, builderActivities.BuildDockerImage, messages.BuildDockerImageRequest{
-		Repo:         req.Repo,
-		SHA:          req.SHA,
-		CosmosSdkSha: req.CosmosSdkSha,
-		CometBFTSha:  req.CometBFTSha,
+		Repo:          req.Repo,
+		SHA:           req.SHA,
+		CosmosSdkSha:  req.CosmosSdkSha,
+		CometBFTSha:   req.CometBFTSha,
+		GoModReplaces: req.GoModReplaces,
+		Source:        req.Source,
+		Variant:       req.BuildVariant,
 		ImageConfig: messages.ImageConfig{
 			Name:    req.ChainConfig.Name,
 			Image:   req.ChainConfig.Image,
@@ -110,7 +167,10 @@ func Workflow(ctx workflow.Context, req messages.TestnetWorkflowRequest) (messag
 		return "", err
 	}
 
-	if err := startWorkflow(ctx, req, runName, buildResult, workflowID); err != nil {
+	if err := startWorkflow(ctx, req, runName, buildResult, workflowID, status); err != nil {
+		if workflow.IsContinueAsNewError(err) {
+			return "", err
+		}
 		if temporal.IsCanceledError(err) {
 			workflow.GetLogger(ctx).Info("testnet workflow was cancelled, completing gracefully")
 			return "", nil
@@ -122,32 +182,96 @@ func Workflow(ctx workflow.Context, req messages.TestnetWorkflowRequest) (messag
 	return "", nil
 }
 
+// buildImageGroups builds an image for every SHA of the chain's image groups that isn't the SHA of the chain
+// image and returns the chain's region configs and image groups with the built images assigned
+func buildImageGroups(ctx workflow.Context, buildReq messages.BuildDockerImageRequest, chainImage string,
+	chainConfig types.ChainsConfig,
+) ([]petritypes.RegionConfig, []petritypes.ImageGroup, error) {
+	regionConfigs := slices.Clone(chainConfig.RegionConfigs)
+	var imageGroups []petritypes.ImageGroup
+
+	images := map[string]string{buildReq.SHA: chainImage}
+	for _, group := range chainConfig.ImageGroups {
+		image, ok := images[group.SHA]
+		if !ok {
+			workflow.GetLogger(ctx).Info("building image group", zap.String("sha", group.SHA))
+
+			var buildResult messages.BuildDockerImageResponse
+			groupReq := buildReq
+			groupReq.SHA = group.SHA
+			groupReq.Source = buildReq.Source.WithoutOverlay()
+			if err := workflow.ExecuteActivity(ctx, builderActivities.BuildDockerImage, groupReq).Get(ctx, &buildResult); err != nil {
+				return nil, nil, err
+			}
+
+			image = buildResult.FQDNTag
+			images[group.SHA] = image
+		}
+
+		if group.Region == "" {
+			imageGroups = append(imageGroups, petritypes.ImageGroup{
+				Image:         image,
+				NumValidators: int(group.NumOfValidators),
+				NumNodes:      int(group.NumOfNodes),
+			})
+			continue
+		}
+
+		for i := range regionConfigs {
+			if regionConfigs[i].Name == group.Region {
+				regionConfigs[i].Image = image
+			}
+		}
+	}
+
+	return regionConfigs, imageGroups, nil
+}
+
 func launchTestnet(ctx workflow.Context, req messages.TestnetWorkflowRequest, runName string,
-	buildResult messages.BuildDockerImageResponse,
-) ([]byte, []byte, []*pb.Node, []*pb.Node, error) {
-	var providerState, chainState []byte
+	buildResult messages.BuildDockerImageResponse, expiresAt time.Time,
+) (messages.LaunchTestnetResponse, error) {
+	var providerState []byte
 	workflow.GetLogger(ctx).Info("launching testnet", zap.Any("req", req))
 
+	regionConfigs, imageGroups, err := buildImageGroups(ctx, messages.BuildDockerImageRequest{
+		Repo:          req.Repo,
+		SHA:           req.SHA,
+		CosmosSdkSha:  req.CosmosSdkSha,
+		CometBFTSha:   req.CometBFTSha,
+		GoModReplaces: req.GoModReplaces,
+		Source:        req.Source,
+		Variant:       req.BuildVariant,
+		ImageConfig: messages.ImageConfig{
+			Name:    req.ChainConfig.Name,
+			Image:   req.ChainConfig.Image,
+			Version: req.ChainConfig.Version,
+		},
+	}, buildResult.FQDNTag, req.ChainConfig)
+	if err != nil {
+		return messages.LaunchTestnetResponse{}, err
+	}
+
 	var createProviderResp messages.CreateProviderResponse
 	if err := workflow.ExecuteActivity(ctx, testnetActivities.CreateProvider, messages.CreateProviderRequest{
 		RunnerType: req.RunnerType,
 		Name:       runName,
+		ExpiresAt:  expiresAt,
 	}).Get(ctx, &createProviderResp); err != nil {
-		return nil, nil, nil, nil, err
+		return messages.LaunchTestnetResponse{}, err
 	}
 
 	providerState = createProviderResp.ProviderState
 
-	var testnetResp messages.LaunchTestnetResponse
-	activityOptions := workflow.ActivityOptions{
-		// HeartbeatTimeout:    time.Hour * 1,
-		StartToCloseTimeout: time.Hour * 24 * 365,
-		RetryPolicy: &temporal.RetryPolicy{
-			MaximumAttempts: 1,
-		},
+	// the testnet can be torn down from the saved state as soon as the provider exists, e.g. if the workflow is
+	// terminated while launching it
+	if compressedProviderState, err := ironbirdutil.CompressData(providerState); err == nil {
+		saveProviderState(ctx, workflow.GetInfo(ctx).WorkflowExecution.ID, req.RunnerType, compressedProviderState)
+	} else {
+		workflow.GetLogger(ctx).Error("failed to compress provider state", zap.Error(err))
 	}
 
-	if err := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, activityOptions), testnetActivities.LaunchTestnet,
+	var testnetResp messages.LaunchTestnetResponse
+	if err := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, launchTestnetOptions), testnetActivities.LaunchTestnet,
 		messages.LaunchTestnetRequest{
 			Name:                   req.ChainConfig.Name,
 			Repo:                   req.Repo,
@@ -159,7 +283,13 @@ func launchTestnet(ctx workflow.Context, req messages.TestnetWorkflowRequest, ru
 			RunnerType:             req.RunnerType,
 			NumOfValidators:        req.ChainConfig.NumOfValidators,
 			NumOfNodes:             req.ChainConfig.NumOfNodes,
-			RegionConfigs:          req.ChainConfig.RegionConfigs,
+			RegionConfigs:          regionConfigs,
+			ImageGroups:            imageGroups,
+			ConfigOverrides:        req.ChainConfig.ConfigOverrides,
+			StakeDistribution:      req.ChainConfig.StakeDistribution,
+			Topology:               req.ChainConfig.Topology,
+			RemoteSigner:           req.ChainConfig.RemoteSigner,
+			Instrumented:           req.BuildVariant != "",
 			CustomAppConfig:        req.ChainConfig.CustomAppConfig,
 			CustomConsensusConfig:  req.ChainConfig.CustomConsensusConfig,
 			CustomClientConfig:     req.ChainConfig.CustomClientConfig,
@@ -169,39 +299,442 @@ func launchTestnet(ctx workflow.Context, req messages.TestnetWorkflowRequest, ru
 			NumWallets:             req.NumWallets,
 			BaseMnemonic:           req.BaseMnemonic,
 			ProviderSpecificConfig: req.ProviderSpecificConfig,
+			CustomGenesis:          req.CustomGenesis,
 		}).Get(ctx, &testnetResp); err != nil {
+		// the provider state of the last attempt includes the tasks it created
+		var appErr *temporal.ApplicationError
+		if errors.As(err, &appErr) && appErr.HasDetails() {
+			var updatedProviderState []byte
+			if detailsErr := appErr.Details(&updatedProviderState); detailsErr == nil && len(updatedProviderState) != 0 {
+				return messages.LaunchTestnetResponse{ProviderState: updatedProviderState}, err
+			}
+		}
+
 		compressedProviderState, compressErr := ironbirdutil.CompressData(providerState)
 		if compressErr != nil {
 			workflow.GetLogger(ctx).Error("failed to compress provider state for cleanup", zap.Error(compressErr))
-			return nil, providerState, nil, nil, err
+			return messages.LaunchTestnetResponse{ProviderState: providerState}, err
 		}
-		return nil, compressedProviderState, nil, nil, err
+		return messages.LaunchTestnetResponse{ProviderState: compressedProviderState}, err
 	}
 
-	chainState = testnetResp.ChainState
-	providerState = testnetResp.ProviderState
+	return testnetResp, nil
+}
+
+// migrateGenesis migrates the genesis of the primary chain and replaces its nodes in status with the migrated ones
+func migrateGenesis(ctx workflow.Context, req messages.TestnetWorkflowRequest, chainState, providerState []byte,
+	status *messages.TestnetStatus,
+) (messages.MigrateGenesisResponse, error) {
+	logger := workflow.GetLogger(ctx)
+	spec := req.GenesisMigration
 
-	return chainState, providerState, testnetResp.Nodes, testnetResp.Validators, nil
+	var image string
+	if spec.SHA != "" {
+		var buildResult messages.BuildDockerImageResponse
+		if err := workflow.ExecuteActivity(ctx, builderActivities.BuildDockerImage, messages.BuildDockerImageRequest{
+			Repo:          req.Repo,
+			SHA:           spec.SHA,
+			CosmosSdkSha:  req.CosmosSdkSha,
+			CometBFTSha:   req.CometBFTSha,
+			GoModReplaces: req.GoModReplaces,
+			Source:        req.Source.WithoutOverlay(),
+			Variant:       req.BuildVariant,
+			ImageConfig: messages.ImageConfig{
+				Name:    req.ChainConfig.Name,
+				Image:   req.ChainConfig.Image,
+				Version: req.ChainConfig.Version,
+			},
+		}).Get(ctx, &buildResult); err != nil {
+			return messages.MigrateGenesisResponse{ChainState: chainState, ProviderState: providerState}, err
+		}
+		image = buildResult.FQDNTag
+	}
+
+	logger.Info("migrating testnet genesis", zap.Uint64("export_height", spec.ExportHeight), zap.String("image", image))
+
+	activityOptions := workflow.ActivityOptions{
+		StartToCloseTimeout: time.Hour * 24,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 1,
+		},
+	}
+
+	var migrateResp messages.MigrateGenesisResponse
+	if err := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, activityOptions), testnetActivities.MigrateGenesis,
+		messages.MigrateGenesisRequest{
+			RunnerType:           req.RunnerType,
+			ProviderState:        providerState,
+			ChainState:           chainState,
+			IsEvmChain:           req.IsEvmChain,
+			Image:                image,
+			ExportHeight:         spec.ExportHeight,
+			MigrationCommand:     spec.MigrationCommand,
+			GenesisModifications: spec.GenesisModifications,
+			ExistingNodes:        status.Nodes,
+			ExistingValidators:   status.Validators,
+		}).Get(ctx, &migrateResp); err != nil {
+		var appErr *temporal.ApplicationError
+		if errors.As(err, &appErr) && appErr.HasDetails() {
+			var updatedProviderState []byte
+			if detailsErr := appErr.Details(&updatedProviderState); detailsErr == nil && len(updatedProviderState) != 0 {
+				providerState = updatedProviderState
+			}
+		}
+		return messages.MigrateGenesisResponse{ChainState: chainState, ProviderState: providerState}, err
+	}
+
+	status.Nodes = messages.ReplaceNodes(status.Nodes, migrateResp.Nodes)
+	status.Validators = messages.ReplaceNodes(status.Validators, migrateResp.Validators)
+
+	return migrateResp, nil
 }
 
-func launchLoadBalancer(ctx workflow.Context, req messages.TestnetWorkflowRequest, providerState []byte,
-	nodes []*pb.Node, validators []*pb.Node,
+func launchAdditionalChains(ctx workflow.Context, req messages.TestnetWorkflowRequest, providerState []byte,
+	status *messages.TestnetStatus,
+) ([]messages.RelayerChainState, []byte, error) {
+	logger := workflow.GetLogger(ctx)
+	chains := make([]messages.RelayerChainState, 0, len(req.AdditionalChains))
+
+	for _, chain := range req.AdditionalChains {
+		sha := chain.SHA
+		source := req.Source
+		if sha == "" {
+			sha = req.SHA
+		} else {
+			source = req.Source.WithoutOverlay()
+		}
+
+		buildReq := messages.BuildDockerImageRequest{
+			Repo:          chain.Repo,
+			SHA:           sha,
+			CosmosSdkSha:  req.CosmosSdkSha,
+			CometBFTSha:   req.CometBFTSha,
+			GoModReplaces: req.GoModReplaces,
+			Source:        source,
+			Variant:       req.BuildVariant,
+			ImageConfig: messages.ImageConfig{
+				Name:    chain.ChainConfig.Name,
+				Image:   chain.ChainConfig.Image,
+				Version: chain.ChainConfig.Version,
+			},
+		}
+
+		var buildResult messages.BuildDockerImageResponse
+		if err := workflow.ExecuteActivity(ctx, builderActivities.BuildDockerImage, buildReq).Get(ctx, &buildResult); err != nil {
+			return chains, providerState, err
+		}
+
+		regionConfigs, imageGroups, err := buildImageGroups(ctx, buildReq, buildResult.FQDNTag, chain.ChainConfig)
+		if err != nil {
+			return chains, providerState, err
+		}
+
+		// LaunchTestnet restores the provider from uncompressed state
+		decompressedProviderState, err := ironbirdutil.DecompressData(providerState)
+		if err != nil {
+			return chains, providerState, err
+		}
+
+		logger.Info("launching additional chain", zap.String("chain", chain.ChainConfig.Name))
+
+		var testnetResp messages.LaunchTestnetResponse
+		if err := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, launchTestnetOptions), testnetActivities.LaunchTestnet,
+			messages.LaunchTestnetRequest{
+				Name:                   chain.ChainConfig.Name,
+				Repo:                   chain.Repo,
+				SHA:                    sha,
+				IsEvmChain:             chain.IsEvmChain,
+				Image:                  buildResult.FQDNTag,
+				BaseImage:              chain.ChainConfig.Image,
+				GenesisModifications:   chain.ChainConfig.GenesisModifications,
+				RunnerType:             req.RunnerType,
+				NumOfValidators:        chain.ChainConfig.NumOfValidators,
+				NumOfNodes:             chain.ChainConfig.NumOfNodes,
+				RegionConfigs:          regionConfigs,
+				ImageGroups:            imageGroups,
+				ConfigOverrides:        chain.ChainConfig.ConfigOverrides,
+				StakeDistribution:      chain.ChainConfig.StakeDistribution,
+				Topology:               chain.ChainConfig.Topology,
+				RemoteSigner:           chain.ChainConfig.RemoteSigner,
+				CustomAppConfig:        chain.ChainConfig.CustomAppConfig,
+				CustomConsensusConfig:  chain.ChainConfig.CustomConsensusConfig,
+				CustomClientConfig:     chain.ChainConfig.CustomClientConfig,
+				SetSeedNode:            chain.ChainConfig.SetSeedNode,
+				SetPersistentPeers:     chain.ChainConfig.SetPersistentPeers,
+				ProviderState:          decompressedProviderState,
+				NumWallets:             req.NumWallets,
+				BaseMnemonic:           req.BaseMnemonic,
+				ProviderSpecificConfig: req.ProviderSpecificConfig,
+				ExistingNodes:          status.Nodes,
+				ExistingValidators:     status.Validators,
+			}).Get(ctx, &testnetResp); err != nil {
+			var appErr *temporal.ApplicationError
+			if errors.As(err, &appErr) && appErr.HasDetails() {
+				var updatedProviderState []byte
+				if detailsErr := appErr.Details(&updatedProviderState); detailsErr == nil && len(updatedProviderState) != 0 {
+					providerState = updatedProviderState
+				}
+			}
+			return chains, providerState, err
+		}
+
+		providerState = testnetResp.ProviderState
+		status.Nodes = append(status.Nodes, testnetResp.Nodes...)
+		status.Validators = append(status.Validators, testnetResp.Validators...)
+
+		chains = append(chains, messages.RelayerChainState{
+			ChainState: testnetResp.ChainState,
+			IsEvmChain: chain.IsEvmChain,
+		})
+	}
+
+	return chains, providerState, nil
+}
+
+func launchRelayer(ctx workflow.Context, req messages.TestnetWorkflowRequest, chains []messages.RelayerChainState,
+	providerState []byte,
+) (messages.LaunchRelayerResponse, []byte, error) {
+	workflow.GetLogger(ctx).Info("launching relayer", zap.Int("chains", len(chains)))
+
+	var relayerResp messages.LaunchRelayerResponse
+	if err := workflow.ExecuteActivity(ctx, relayerActivities.LaunchRelayer, messages.LaunchRelayerRequest{
+		RunnerType:             req.RunnerType,
+		ProviderState:          providerState,
+		Chains:                 chains,
+		Image:                  req.Relayer.Image,
+		ProviderSpecificConfig: req.ProviderSpecificConfig,
+	}).Get(ctx, &relayerResp); err != nil {
+		var appErr *temporal.ApplicationError
+		if errors.As(err, &appErr) && appErr.HasDetails() {
+			var updatedProviderState []byte
+			if detailsErr := appErr.Details(&updatedProviderState); detailsErr == nil && len(updatedProviderState) != 0 {
+				providerState = updatedProviderState
+			}
+		}
+		return relayerResp, providerState, err
+	}
+
+	return relayerResp, relayerResp.ProviderState, nil
+}
+
+func runIBCTransferLoad(ctx workflow.Context, req messages.TestnetWorkflowRequest, relayerResp messages.LaunchRelayerResponse,
+	providerState []byte,
+) workflow.Future {
+	spec := req.Relayer.IBCTransferLoad
+
+	timeout := loadTestTimeout
+	if duration, err := time.ParseDuration(spec.Duration); err == nil {
+		timeout += duration
+	}
+
+	return workflow.ExecuteActivity(
+		workflow.WithHeartbeatTimeout(workflow.WithStartToCloseTimeout(ctx, timeout), ibcTransferLoadHeartbeatTimeout),
+		relayerActivities.RunIBCTransferLoad,
+		messages.RunIBCTransferLoadRequest{
+			RunnerType:    req.RunnerType,
+			ProviderState: providerState,
+			RelayerState:  relayerResp.RelayerState,
+			Channels:      relayerResp.Channels,
+			Denoms:        relayerResp.Denoms,
+			Spec:          *spec,
+		},
+	)
+}
+
+// upgradeSignalNames are the signals that upgrade the primary chain of a running testnet
+var upgradeSignalNames = []string{messages.RollingRestartSignal, messages.HaltHeightUpgradeSignal}
+
+var upgradeModes = map[string]messages.UpgradeMode{
+	messages.RollingRestartSignal:    messages.RollingRestart,
+	messages.HaltHeightUpgradeSignal: messages.HaltHeightUpgrade,
+}
+
+// chainOperations runs the operations that change a running testnet, e.g. upgrades, in their own coroutines so that
+// they don't block the selector of the testnet. Operations run one at a time since each one continues from the chain
+// and provider state the previous one left
+type chainOperations struct {
+	mutex    workflow.Mutex
+	inFlight int
+}
+
+func newChainOperations(ctx workflow.Context) *chainOperations {
+	return &chainOperations{mutex: workflow.NewMutex(ctx)}
+}
+
+// run runs op in a new coroutine once the operations started before it completed. The returned future is ready once
+// op completed, or was skipped because the workflow was cancelled
+func (o *chainOperations) run(ctx workflow.Context, op func(ctx workflow.Context)) workflow.Future {
+	future, settable := workflow.NewFuture(ctx)
+	o.inFlight++
+	workflow.Go(ctx, func(ctx workflow.Context) {
+		defer func() {
+			o.inFlight--
+			settable.Set(nil, nil)
+		}()
+
+		if err := o.mutex.Lock(ctx); err != nil {
+			return
+		}
+		defer o.mutex.Unlock()
+		op(ctx)
+	})
+
+	return future
+}
+
+func (o *chainOperations) idle() bool {
+	return o.inFlight == 0
+}
+
+// wait blocks until every started operation completed
+func (o *chainOperations) wait(ctx workflow.Context) {
+	_ = workflow.Await(ctx, o.idle)
+}
+
+// upgradeTestnet upgrades the primary chain of a running testnet on an upgrade signal and points its load balancer at
+// the upgraded nodes. The testnet keeps running if the upgrade failed, the error is returned to be reported when the
+// testnet ends
+func upgradeTestnet(ctx workflow.Context, req messages.TestnetWorkflowRequest, signalName string,
+	signal messages.UpgradeSignal, chainState, providerState, loadBalancerState []byte, status *messages.TestnetStatus,
+) ([]byte, []byte, error) {
+	chainState, providerState, err := upgradeChain(ctx, req, upgradeModes[signalName], signal, chainState, providerState,
+		status)
+	if err != nil {
+		workflow.GetLogger(ctx).Error("chain upgrade failed", zap.String("signal", signalName), zap.Error(err))
+		err = fmt.Errorf("%s upgrade failed: %w", upgradeModes[signalName], err)
+	}
+
+	// upgrades may recreate nodes, which can change their IPs
+	if len(loadBalancerState) != 0 {
+		if lbErr := updateLoadBalancer(ctx, req, chainState, providerState, loadBalancerState); lbErr != nil {
+			workflow.GetLogger(ctx).Error("failed to update load balancer", zap.Error(lbErr))
+			err = errors.Join(err, fmt.Errorf("failed to update load balancer after upgrade: %w", lbErr))
+		}
+	}
+
+	return chainState, providerState, err
+}
+
+// upgradeChain restarts the chain's nodes on an image built from the signalled SHA, either one at a time or
+// all at once at the signalled halt height, and replaces its nodes in status with the upgraded ones
+func upgradeChain(ctx workflow.Context, req messages.TestnetWorkflowRequest, mode messages.UpgradeMode,
+	signal messages.UpgradeSignal, chainState, providerState []byte, status *messages.TestnetStatus,
+) ([]byte, []byte, error) {
+	logger := workflow.GetLogger(ctx)
+
+	var image string
+	if signal.SHA != "" {
+		var buildResult messages.BuildDockerImageResponse
+		if err := workflow.ExecuteActivity(ctx, builderActivities.BuildDockerImage, messages.BuildDockerImageRequest{
+			Repo:          req.Repo,
+			SHA:           signal.SHA,
+			CosmosSdkSha:  req.CosmosSdkSha,
+			CometBFTSha:   req.CometBFTSha,
+			GoModReplaces: req.GoModReplaces,
+			Source:        req.Source.WithoutOverlay(),
+			Variant:       req.BuildVariant,
+			ImageConfig: messages.ImageConfig{
+				Name:    req.ChainConfig.Name,
+				Image:   req.ChainConfig.Image,
+				Version: req.ChainConfig.Version,
+			},
+		}).Get(ctx, &buildResult); err != nil {
+			return chainState, providerState, err
+		}
+		image = buildResult.FQDNTag
+	}
+
+	logger.Info("upgrading chain", zap.String("mode", string(mode)), zap.String("image", image),
+		zap.Uint64("halt_height", signal.HaltHeight))
+
+	activityOptions := workflow.ActivityOptions{
+		StartToCloseTimeout: time.Hour * 24,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 1,
+		},
+	}
+
+	var upgradeResp messages.UpgradeChainResponse
+	if err := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, activityOptions), testnetActivities.UpgradeChain,
+		messages.UpgradeChainRequest{
+			RunnerType:         req.RunnerType,
+			ProviderState:      providerState,
+			ChainState:         chainState,
+			IsEvmChain:         req.IsEvmChain,
+			Mode:               mode,
+			Image:              image,
+			HaltHeight:         signal.HaltHeight,
+			ExistingNodes:      status.Nodes,
+			ExistingValidators: status.Validators,
+		}).Get(ctx, &upgradeResp); err != nil {
+		var appErr *temporal.ApplicationError
+		if errors.As(err, &appErr) && appErr.HasDetails() {
+			var updatedProviderState []byte
+			if detailsErr := appErr.Details(&updatedProviderState); detailsErr == nil && len(updatedProviderState) != 0 {
+				providerState = updatedProviderState
+			}
+		}
+		return chainState, providerState, err
+	}
+
+	status.Nodes = messages.ReplaceNodes(status.Nodes, upgradeResp.Nodes)
+	status.Validators = messages.ReplaceNodes(status.Validators, upgradeResp.Validators)
+
+	return upgradeResp.ChainState, upgradeResp.ProviderState, nil
+}
+
+// injectFault injects a fault into the primary chain and waits for the chain to react to it
+func injectFault(ctx workflow.Context, req messages.TestnetWorkflowRequest, fault messages.FaultSpec,
+	chainState, providerState []byte,
 ) ([]byte, error) {
 	logger := workflow.GetLogger(ctx)
-	workflowID := workflow.GetInfo(ctx).WorkflowExecution.ID
+	logger.Info("injecting fault", zap.String("type", string(fault.Type)), zap.Int("validator", fault.Validator))
 
-	if req.RunnerType != messages.DigitalOcean {
-		logger.Info("Skipping loadbalancer creation for non-DigitalOcean runner",
-			zap.String("runnerType", string(req.RunnerType)))
-		return providerState, nil
+	activityOptions := workflow.ActivityOptions{
+		StartToCloseTimeout: fault.GetTimeout() + time.Hour,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 1,
+		},
+	}
+
+	var faultResp messages.InjectFaultResponse
+	if err := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, activityOptions), testnetActivities.InjectFault,
+		messages.InjectFaultRequest{
+			RunnerType:             req.RunnerType,
+			ProviderState:          providerState,
+			ChainState:             chainState,
+			Fault:                  fault,
+			ProviderSpecificConfig: req.ProviderSpecificConfig,
+		}).Get(ctx, &faultResp); err != nil {
+		var appErr *temporal.ApplicationError
+		if errors.As(err, &appErr) && appErr.HasDetails() {
+			var updatedProviderState []byte
+			if detailsErr := appErr.Details(&updatedProviderState); detailsErr == nil && len(updatedProviderState) != 0 {
+				providerState = updatedProviderState
+			}
+		}
+		return providerState, fmt.Errorf("%s fault failed: %w", fault.Type, err)
 	}
 
+	logger.Info("fault completed", zap.String("type", string(fault.Type)), zap.String("validator", faultResp.OperatorAddress),
+		zap.Bool("jailed", faultResp.Jailed), zap.Bool("tombstoned", faultResp.Tombstoned),
+		zap.String("tokens_before", faultResp.TokensBefore), zap.String("tokens_after", faultResp.TokensAfter))
+
+	return faultResp.ProviderState, nil
+}
+
+func launchLoadBalancer(ctx workflow.Context, req messages.TestnetWorkflowRequest, providerState []byte,
+	nodes []*pb.Node, validators []*pb.Node,
+) ([]byte, []byte, error) {
+	logger := workflow.GetLogger(ctx)
+	workflowID := workflow.GetInfo(ctx).WorkflowExecution.ID
+
 	logger.Info("Creating loadbalancer domains for nodes",
 		zap.Int("nodeCount", len(nodes)),
 		zap.String("workflowID", workflowID))
 
 	var loadBalancerResp messages.LaunchLoadBalancerResponse
-	domains := processDomainInfo(req.ChainConfig.Name, nodes, validators, req.IsEvmChain)
+	domains := loadBalancerSpec(req).Domains(req.ChainConfig.Name, nodes, validators, req.IsEvmChain)
 
 	if err := workflow.ExecuteActivity(
 		ctx,
@@ -215,14 +748,44 @@ func launchLoadBalancer(ctx workflow.Context, req messages.TestnetWorkflowReques
 		},
 	).Get(ctx, &loadBalancerResp); err != nil {
 		logger.Error("Failed to launch loadbalancer", zap.Error(err))
-		return providerState, err
+		return providerState, nil, err
 	}
 
-	return loadBalancerResp.ProviderState, nil
+	return loadBalancerResp.ProviderState, loadBalancerResp.LoadBalancerState, nil
+}
+
+// updateLoadBalancer points the load balancer at the current nodes of the primary chain, it's run whenever the
+// nodes of the chain may have changed
+func updateLoadBalancer(ctx workflow.Context, req messages.TestnetWorkflowRequest, chainState, providerState,
+	loadBalancerState []byte,
+) error {
+	var updateResp messages.UpdateLoadBalancerResponse
+	if err := workflow.ExecuteActivity(ctx, loadBalancerActivities.UpdateLoadBalancer,
+		messages.UpdateLoadBalancerRequest{
+			ProviderState:     providerState,
+			RunnerType:        req.RunnerType,
+			LoadBalancerState: loadBalancerState,
+			ChainState:        chainState,
+			ChainName:         req.ChainConfig.Name,
+			IsEvmChain:        req.IsEvmChain,
+			LoadBalancer:      loadBalancerSpec(req),
+		}).Get(ctx, &updateResp); err != nil {
+		return err
+	}
+
+	workflow.GetLogger(ctx).Info("updated load balancer", zap.Bool("reloaded", updateResp.Reloaded))
+	return nil
+}
+
+func loadBalancerSpec(req messages.TestnetWorkflowRequest) messages.LoadBalancerSpec {
+	if req.LoadBalancer == nil {
+		return messages.LoadBalancerSpec{}
+	}
+	return *req.LoadBalancer
 }
 
 func runLoadTest(ctx workflow.Context, req messages.TestnetWorkflowRequest, chainState, providerState []byte,
-	selector workflow.Selector,
+	selector workflow.Selector, status *messages.TestnetStatus,
 ) (workflow.Future, error) {
 	if req.EthereumLoadTestSpec != nil {
 		var loadTestResp messages.RunLoadTestResponse
@@ -241,6 +804,7 @@ func runLoadTest(ctx workflow.Context, req messages.TestnetWorkflowRequest, chai
 			},
 		)
 
+		status.LoadTest = &messages.LoadTestProgress{StartedAt: workflow.Now(ctx)}
 		selector.AddFuture(f, func(f workflow.Future) {
 			activityErr := f.Get(ctx, &loadTestResp)
 			if activityErr != nil {
@@ -248,6 +812,7 @@ func runLoadTest(ctx workflow.Context, req messages.TestnetWorkflowRequest, chai
 			} else if loadTestResp.Result.Error != "" {
 				workflow.GetLogger(ctx).Error("ethereum load test reported an error", zap.String("error", loadTestResp.Result.Error))
 			}
+			recordLoadTest(ctx, status.LoadTest, loadTestResp, activityErr)
 		})
 
 		return f, nil
@@ -268,6 +833,7 @@ func runLoadTest(ctx workflow.Context, req messages.TestnetWorkflowRequest, chai
 			},
 		)
 
+		status.LoadTest = &messages.LoadTestProgress{StartedAt: workflow.Now(ctx)}
 		selector.AddFuture(f, func(f workflow.Future) {
 			activityErr := f.Get(ctx, &loadTestResp)
 			if activityErr != nil {
@@ -275,6 +841,7 @@ func runLoadTest(ctx workflow.Context, req messages.TestnetWorkflowRequest, chai
 			} else if loadTestResp.Result.Error != "" {
 				workflow.GetLogger(ctx).Error("cosmos load test reported an error", zap.String("error", loadTestResp.Result.Error))
 			}
+			recordLoadTest(ctx, status.LoadTest, loadTestResp, activityErr)
 		})
 
 		return f, nil
@@ -283,22 +850,81 @@ func runLoadTest(ctx workflow.Context, req messages.TestnetWorkflowRequest, chai
 	return nil, nil
 }
 
-func startWorkflow(ctx workflow.Context, req messages.TestnetWorkflowRequest, runName string, buildResult messages.BuildDockerImageResponse, workflowID string) error {
+func startWorkflow(ctx workflow.Context, req messages.TestnetWorkflowRequest, runName string,
+	buildResult messages.BuildDockerImageResponse, workflowID string, status *messages.TestnetStatus,
+) error {
 	var providerState []byte
 	cleanupCtx, _ := workflow.NewDisconnectedContext(ctx)
 	defer func() {
 		if len(providerState) != 0 {
+			status.Phase = messages.PhaseTearingDown
 			teardownProvider(cleanupCtx, req.RunnerType, providerState)
 		}
 	}()
 
-	chainState, providerState, nodes, validators, err := launchTestnet(ctx, req, runName, buildResult)
+	// the provider state is saved to the server after every activity that changed it, so that ForceTeardown can
+	// tear the testnet down if the teardown above never runs
+	stateSaver := &providerStateSaver{workflowID: workflowID, runnerType: req.RunnerType}
+
+	// the deadline is provisional until the testnet is launched
+	deadline := workflow.Now(ctx).Add(networkTimeout(ctx, req))
+	status.Phase = messages.PhaseLaunching
+	testnetResp, err := launchTestnet(ctx, req, runName, buildResult, resourceExpiry(ctx, req, deadline))
+	chainState, providerState := testnetResp.ChainState, testnetResp.ProviderState
+	stateSaver.save(ctx, providerState)
 	if err != nil {
 		return err
 	}
 
+	nodes, validators := testnetResp.Nodes, testnetResp.Validators
+	status.ChainID = testnetResp.ChainID
+	status.Nodes, status.Validators = slices.Clone(nodes), slices.Clone(validators)
+
+	if req.GenesisMigration != nil {
+		status.Phase = messages.PhaseMigratingGenesis
+		var migrateResp messages.MigrateGenesisResponse
+		migrateResp, err = migrateGenesis(ctx, req, chainState, providerState, status)
+		chainState, providerState = migrateResp.ChainState, migrateResp.ProviderState
+		stateSaver.save(ctx, providerState)
+		if err != nil {
+			return err
+		}
+		nodes, validators = migrateResp.Nodes, migrateResp.Validators
+	}
+
+	var ibcTransferLoadFuture workflow.Future
+	if len(req.AdditionalChains) > 0 {
+		var chains []messages.RelayerChainState
+		status.Phase = messages.PhaseLaunchingAdditionalChains
+		chains, providerState, err = launchAdditionalChains(ctx, req, providerState, status)
+		stateSaver.save(ctx, providerState)
+		if err != nil {
+			return err
+		}
+
+		if req.Relayer != nil {
+			// the primary chain is connected to every additional chain
+			chains = append([]messages.RelayerChainState{{ChainState: chainState, IsEvmChain: req.IsEvmChain}}, chains...)
+
+			var relayerResp messages.LaunchRelayerResponse
+			status.Phase = messages.PhaseLaunchingRelayer
+			relayerResp, providerState, err = launchRelayer(ctx, req, chains, providerState)
+			stateSaver.save(ctx, providerState)
+			if err != nil {
+				return err
+			}
+
+			if req.Relayer.IBCTransferLoad != nil {
+				ibcTransferLoadFuture = runIBCTransferLoad(ctx, req, relayerResp, providerState)
+			}
+		}
+	}
+
+	var loadBalancerState []byte
 	if req.LaunchLoadBalancer {
-		providerState, err = launchLoadBalancer(ctx, req, providerState, nodes, validators)
+		status.Phase = messages.PhaseLaunchingLoadBalancer
+		providerState, loadBalancerState, err = launchLoadBalancer(ctx, req, providerState, nodes, validators)
+		stateSaver.save(ctx, providerState)
 		if err != nil {
 			return err
 		}
@@ -306,14 +932,146 @@ func startWorkflow(ctx workflow.Context, req messages.TestnetWorkflowRequest, ru
 
 	shutdownSelector := workflow.NewSelector(ctx)
 	// 1. load test selector
-	loadTestFuture, err := runLoadTest(ctx, req, chainState, providerState, shutdownSelector)
+	loadTestFuture, err := runLoadTest(ctx, req, chainState, providerState, shutdownSelector, status)
 	if err != nil {
 		workflow.GetLogger(ctx).Error("load test initiation failed", zap.Error(err))
 	}
 
-	waitForTestnetCompletion(ctx, req, shutdownSelector)
+	// long-running testnets are supervised until the workflow is cancelled, continuing as new periodically
+	if req.LongRunningTestnet {
+		testnet := &messages.SupervisedTestnet{
+			ChainState:        chainState,
+			ProviderState:     providerState,
+			LoadBalancerState: loadBalancerState,
+			LaunchedAt:        workflow.Now(ctx),
+			PendingFaults:     slices.Clone(req.Faults),
+			Status:            *status,
+		}
+		if ibcTransferLoadFuture != nil {
+			shutdownSelector.AddFuture(ibcTransferLoadFuture, func(f workflow.Future) {
+				_ = logIBCTransferLoad(ctx, f)
+			})
+		}
+
+		err := superviseTestnet(ctx, req, testnet, stateSaver, shutdownSelector, loadTestFuture, ibcTransferLoadFuture)
+		providerState = testnet.ProviderState
+		if workflow.IsContinueAsNewError(err) {
+			// the next run tears the testnet down
+			providerState = nil
+		}
+		return err
+	}
 
-	shutdownSelector.Select(ctx)
+	// 3. upgrade signals and faults are handled while the testnet is running, any other event ends the testnet. They
+	// run as chain operations, whose completion is an event as well
+	eventHandled := false
+	ops := newChainOperations(ctx)
+	// testnetErrs are the failures of upgrades, faults and the instrumentation collection, which don't end the
+	// testnet but fail the workflow once it ended
+	var testnetErrs []error
+	runOperation := func(op func(ctx workflow.Context)) {
+		shutdownSelector.AddFuture(ops.run(ctx, op), func(workflow.Future) {
+			eventHandled = true
+		})
+	}
+
+	for _, signalName := range upgradeSignalNames {
+		shutdownSelector.AddReceive(workflow.GetSignalChannel(ctx, signalName), func(c workflow.ReceiveChannel, _ bool) {
+			var signal messages.UpgradeSignal
+			c.Receive(ctx, &signal)
+			eventHandled = true
+
+			runOperation(func(ctx workflow.Context) {
+				status.Phase = messages.PhaseUpgrading
+				var upgradeErr error
+				chainState, providerState, upgradeErr = upgradeTestnet(ctx, req, signalName, signal, chainState,
+					providerState, loadBalancerState, status)
+				stateSaver.save(ctx, providerState)
+				status.Phase = messages.PhaseRunning
+				if upgradeErr != nil {
+					testnetErrs = append(testnetErrs, upgradeErr)
+				}
+			})
+		})
+	}
+
+	for _, fault := range req.Faults {
+		// delays were validated when the workflow was created
+		after, _ := time.ParseDuration(fault.After)
+		shutdownSelector.AddFuture(workflow.NewTimer(ctx, after), func(f workflow.Future) {
+			// the timer only fails if the workflow was cancelled, which ends the testnet
+			if f.Get(ctx, nil) != nil {
+				return
+			}
+			eventHandled = true
+
+			runOperation(func(ctx workflow.Context) {
+				status.Phase = messages.PhaseInjectingFault
+				var faultErr error
+				providerState, faultErr = injectFault(ctx, req, fault, chainState, providerState)
+				stateSaver.save(ctx, providerState)
+				status.Phase = messages.PhaseRunning
+				if faultErr != nil {
+					workflow.GetLogger(ctx).Error("fault failed", zap.String("type", string(fault.Type)), zap.Error(faultErr))
+					testnetErrs = append(testnetErrs, faultErr)
+				}
+			})
+		})
+	}
+
+	// 2. the testnet ends at its deadline, which the extend signal pushes forward
+	logger := workflow.GetLogger(ctx)
+	deadline = workflow.Now(ctx).Add(networkTimeout(ctx, req))
+	logger.Info("testnet deadline", zap.Time("deadline", deadline))
+	status.Phase = messages.PhaseRunning
+	status.ExpectedEnd = deadline
+
+	addDeadlineTimer := func() {
+		timerDeadline := deadline
+		shutdownSelector.AddFuture(workflow.NewTimer(ctx, timerDeadline.Sub(workflow.Now(ctx))), func(f workflow.Future) {
+			// timers of deadlines that were extended don't end the testnet
+			if f.Get(ctx, nil) == nil && deadline.After(timerDeadline) {
+				eventHandled = true
+			}
+		})
+	}
+	addDeadlineTimer()
+	providerState = setExpiry(ctx, req, providerState, resourceExpiry(ctx, req, deadline))
+	stateSaver.save(ctx, providerState)
+
+	shutdownSelector.AddReceive(workflow.GetSignalChannel(ctx, messages.ExtendTestnetSignal), func(c workflow.ReceiveChannel, _ bool) {
+		var signal messages.ExtendSignal
+		c.Receive(ctx, &signal)
+		eventHandled = true
+
+		extension, err := time.ParseDuration(signal.Duration)
+		if err != nil || extension <= 0 {
+			logger.Error("invalid testnet extension", zap.String("duration", signal.Duration), zap.Error(err))
+			return
+		}
+
+		deadline = deadline.Add(extension)
+		status.ExpectedEnd = deadline
+		logger.Info("extending testnet", zap.Duration("extension", extension), zap.Time("deadline", deadline))
+		addDeadlineTimer()
+		// the expiry changes the provider state, so it's serialized with the operations that change it as well
+		runOperation(func(ctx workflow.Context) {
+			providerState = setExpiry(ctx, req, providerState, resourceExpiry(ctx, req, deadline))
+			stateSaver.save(ctx, providerState)
+		})
+	})
+
+	for {
+		eventHandled = false
+		shutdownSelector.Select(ctx)
+		if !eventHandled {
+			break
+		}
+	}
+
+	// operations that are still running finish before the testnet is torn down, their provider state includes the
+	// tasks they created
+	ops.wait(cleanupCtx)
 
 	// If we have a loadtest running and the duration timer expired (not cancelled),
 	// wait for the loadtest to complete before allowing teardown
@@ -327,46 +1085,72 @@ func startWorkflow(ctx workflow.Context, req messages.TestnetWorkflowRequest, ru
 		workflow.GetLogger(ctx).Info("loadtest completed, proceeding with teardown")
 	}
 
+	if ibcTransferLoadFuture != nil && !temporal.IsCanceledError(ctx.Err()) {
+		workflow.GetLogger(ctx).Info("waiting for ibc transfer load to complete")
+		if err := logIBCTransferLoad(ctx, ibcTransferLoadFuture); err != nil {
+			return err
+		}
+	}
+
+	// instrumented binaries only flush their coverage counters when they stop, so the output is collected even if
+	// the workflow was cancelled
+	if req.BuildVariant != "" {
+		status.Phase = messages.PhaseCollectingInstrumentation
+		if err := collectInstrumentation(cleanupCtx, req, chainState, providerState); err != nil {
+			testnetErrs = append(testnetErrs, err)
+		}
+	}
+
 	if ctx.Err() != nil && temporal.IsCanceledError(ctx.Err()) {
 		workflow.GetLogger(ctx).Info("workflow was cancelled, completing gracefully")
 		return nil
 	}
 
+	return errors.Join(testnetErrs...)
+}
+
+// logIBCTransferLoad waits for the IBC transfer load of a testnet and logs its result
+func logIBCTransferLoad(ctx workflow.Context, f workflow.Future) error {
+	var ibcTransferLoadResp messages.RunIBCTransferLoadResponse
+	if err := f.Get(ctx, &ibcTransferLoadResp); err != nil {
+		workflow.GetLogger(ctx).Error("ibc transfer load failed", zap.Error(err))
+		return err
+	}
+	workflow.GetLogger(ctx).Info("ibc transfer load completed", zap.Int("transfers_sent", ibcTransferLoadResp.TransfersSent))
 	return nil
 }
 
-func processDomainInfo(chainName string, nodes []*pb.Node, validators []*pb.Node, isEvmChain bool) []apps.LoadBalancerDomain {
-	var domains []apps.LoadBalancerDomain
+// collectInstrumentation collects the coverage profile or race reports of the primary chain. Data races fail the
+// workflow
+func collectInstrumentation(ctx workflow.Context, req messages.TestnetWorkflowRequest, chainState, providerState []byte) error {
+	logger := workflow.GetLogger(ctx)
 
-	domainTypes := map[string]string{
-		"grpc": "9090",
-		"rpc":  "26657",
-		"lcd":  "1317",
+	activityOptions := workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 3,
+		},
 	}
 
-	if isEvmChain {
-		domainTypes["evmrpc"] = "8545"
-		domainTypes["evmws"] = "8546"
+	var collectResp messages.CollectInstrumentationResponse
+	if err := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, activityOptions), testnetActivities.CollectInstrumentation,
+		messages.CollectInstrumentationRequest{
+			RunnerType:    req.RunnerType,
+			ProviderState: providerState,
+			ChainState:    chainState,
+		}).Get(ctx, &collectResp); err != nil {
+		logger.Error("failed to collect instrumentation", zap.String("variant", string(req.BuildVariant)), zap.Error(err))
+		return fmt.Errorf("failed to collect %s instrumentation: %w", req.BuildVariant, err)
 	}
 
-	for domainType, port := range domainTypes {
-		domain := apps.LoadBalancerDomain{Domain: fmt.Sprintf("%s-%s", chainName, domainType)}
-
-		var ips []string
-		for _, node := range append(nodes, validators...) {
-			ips = append(ips, fmt.Sprintf("%s:%s", node.Address, port))
-		}
-
-		if domainType == "grpc" {
-			domain.Protocol = "grpc"
-		} else {
-			domain.Protocol = "http"
-		}
-
-		domain.IPs = ips
+	if req.BuildVariant == messages.CoverBuild {
+		logger.Info("collected coverage profile", zap.Float64("coverage", collectResp.Coverage))
+	}
 
-		domains = append(domains, domain)
+	if len(collectResp.RaceReports) > 0 {
+		return fmt.Errorf("found %d data race reports: %s", len(collectResp.RaceReports),
+			strings.Join(collectResp.RaceReports, ", "))
 	}
 
-	return domains
+	return nil
 }
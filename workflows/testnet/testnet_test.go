@@ -28,10 +28,14 @@ import (
 	testnettypes "github.com/skip-mev/ironbird/activities/testnet"
 	"github.com/skip-mev/ironbird/messages"
 	petrichain "github.com/skip-mev/ironbird/petri/cosmos/chain"
+	pb "github.com/skip-mev/ironbird/server/proto"
 	"github.com/skip-mev/ironbird/types"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
 )
 
 type TestnetWorkflowTestSuite struct {
@@ -245,11 +249,14 @@ func (s *TestnetWorkflowTestSuite) setupMockActivitiesDocker() {
 		s.T().Fatal(err)
 	}
 	testnetActivity := &testnettypes.Activity{
-		Chains:       cfg.Chains,
-		RegistryType: "local",
+		Chains:   cfg.Chains,
+		Registry: types.RegistryConfig{Type: "local"},
 	}
 	s.env.RegisterActivity(testnetActivity.CreateProvider)
 	s.env.RegisterActivity(testnetActivity.TeardownProvider)
+	s.env.RegisterActivity(testnetActivity.SetExpiry)
+	s.env.RegisterActivity(testnetActivity.SaveProviderState)
+	s.env.RegisterActivity(testnetActivity.CheckHealth)
 	s.env.RegisterActivity(testnetActivity.LaunchTestnet)
 
 	loadTestActivity := &loadtest.Activity{}
@@ -359,7 +366,7 @@ func (s *TestnetWorkflowTestSuite) setupMockActivitiesDigitalOcean() {
 		TailscaleSettings: tailscaleSettings,
 		Chains:            cfg.Chains,
 		AwsConfig:         &awsConfig,
-		RegistryType:      "ecr",
+		Registry:          types.RegistryConfig{Type: "ecr"},
 	}
 	loadBalancerActivity := &loadbalancer.Activity{
 		RootDomain:        "ib-local.dev.skip.build",
@@ -369,6 +376,9 @@ func (s *TestnetWorkflowTestSuite) setupMockActivitiesDigitalOcean() {
 
 	s.env.RegisterActivity(testnetActivity.CreateProvider)
 	s.env.RegisterActivity(testnetActivity.TeardownProvider)
+	s.env.RegisterActivity(testnetActivity.SetExpiry)
+	s.env.RegisterActivity(testnetActivity.SaveProviderState)
+	s.env.RegisterActivity(testnetActivity.CheckHealth)
 	s.env.RegisterActivity(testnetActivity.LaunchTestnet)
 	s.env.RegisterActivity(loadBalancerActivity.LaunchLoadBalancer)
 
@@ -596,6 +606,9 @@ func (s *TestnetWorkflowTestSuite) Test_TestnetWorkflowWaitsForLoadTestOnDuratio
 
 	s.env.RegisterActivity(testnetActivity.CreateProvider)
 	s.env.RegisterActivity(testnetActivity.TeardownProvider)
+	s.env.RegisterActivity(testnetActivity.SetExpiry)
+	s.env.RegisterActivity(testnetActivity.SaveProviderState)
+	s.env.RegisterActivity(testnetActivity.CheckHealth)
 	s.env.RegisterActivity(testnetActivity.LaunchTestnet)
 	s.env.RegisterActivity(loadTestActivity.RunLoadTest)
 	s.env.RegisterActivity(builderActivity.BuildDockerImage)
@@ -663,6 +676,264 @@ func (s *TestnetWorkflowTestSuite) Test_TestnetWorkflowWaitsForLoadTestOnDuratio
 	s.env.AssertActivityNumberOfCalls(s.T(), "TeardownProvider", 1)
 }
 
+func (s *TestnetWorkflowTestSuite) Test_TeardownWorkflow() {
+	testnetActivities = &testnettypes.Activity{}
+	s.env.RegisterActivity(testnetActivities.TeardownProvider)
+	s.env.RegisterActivity(testnetActivities.SaveProviderState)
+
+	s.env.OnActivity(testnetActivities.TeardownProvider, mock.Anything, messages.TeardownProviderRequest{
+		RunnerType:    messages.DigitalOcean,
+		ProviderState: []byte("state"),
+	}).Return(messages.TeardownProviderResponse{}, nil).Once()
+	// the saved state is cleared once the testnet is torn down
+	s.env.OnActivity(testnetActivities.SaveProviderState, mock.Anything, messages.SaveProviderStateRequest{
+		WorkflowID: "terminated-workflow",
+		RunnerType: messages.DigitalOcean,
+	}).Return(messages.SaveProviderStateResponse{}, nil).Once()
+
+	s.env.ExecuteWorkflow(TeardownWorkflow, messages.TeardownWorkflowRequest{
+		WorkflowID:    "terminated-workflow",
+		RunnerType:    messages.DigitalOcean,
+		ProviderState: []byte("state"),
+	})
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+	s.env.AssertExpectations(s.T())
+}
+
+func (s *TestnetWorkflowTestSuite) Test_LaunchTestnetRetriedAndTornDownFromLastAttempt() {
+	testnetActivities = &testnettypes.Activity{}
+	builderActivities = &builder.Activity{}
+	s.env.RegisterActivity(builderActivities.BuildDockerImage)
+	s.env.RegisterActivity(testnetActivities.CreateProvider)
+	s.env.RegisterActivity(testnetActivities.LaunchTestnet)
+	s.env.RegisterActivity(testnetActivities.SaveProviderState)
+	s.env.RegisterActivity(testnetActivities.TeardownProvider)
+
+	s.env.OnActivity(builderActivities.BuildDockerImage, mock.Anything, mock.Anything).Return(
+		messages.BuildDockerImageResponse{FQDNTag: "simapp:latest"}, nil)
+	s.env.OnActivity(testnetActivities.CreateProvider, mock.Anything, mock.Anything).Return(
+		messages.CreateProviderResponse{ProviderState: []byte("provider")}, nil)
+	s.env.OnActivity(testnetActivities.SaveProviderState, mock.Anything, mock.Anything).Return(
+		messages.SaveProviderStateResponse{}, nil)
+
+	// every attempt fails with the provider state including the tasks it created
+	s.env.OnActivity(testnetActivities.LaunchTestnet, mock.Anything, mock.Anything).Return(
+		messages.LaunchTestnetResponse{}, temporal.NewApplicationErrorWithOptions("failed to start nodes", "ssh timeout",
+			temporal.ApplicationErrorOptions{Details: []interface{}{[]byte("launched")}})).Times(3)
+	s.env.OnActivity(testnetActivities.TeardownProvider, mock.Anything, messages.TeardownProviderRequest{
+		RunnerType:    messages.Docker,
+		ProviderState: []byte("launched"),
+	}).Return(messages.TeardownProviderResponse{}, nil).Once()
+
+	req := simappReq
+	req.Repo = "cosmos-sdk"
+	req.SHA = "acb1d65cdc1e0fc36d93f3c5bb6aaf919a1321e2"
+	req.RunnerType = messages.Docker
+	s.env.ExecuteWorkflow(Workflow, req)
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.Error(s.env.GetWorkflowError())
+	s.env.AssertActivityNumberOfCalls(s.T(), "LaunchTestnet", 3)
+	s.env.AssertActivityNumberOfCalls(s.T(), "TeardownProvider", 1)
+}
+
+func (s *TestnetWorkflowTestSuite) Test_LegacyWorkflowReplaysPreviousCommands() {
+	testnetActivities = &testnettypes.Activity{}
+	builderActivities = &builder.Activity{}
+	s.env.RegisterActivity(builderActivities.BuildDockerImage)
+	s.env.RegisterActivity(testnetActivities.CreateProvider)
+	s.env.RegisterActivity(testnetActivities.LaunchTestnet)
+	s.env.RegisterActivity(testnetActivities.SetExpiry)
+	s.env.RegisterActivity(testnetActivities.SaveProviderState)
+	s.env.RegisterActivity(testnetActivities.TeardownProvider)
+
+	s.env.OnGetVersion(supervisedTestnetChangeID, workflow.DefaultVersion, supervisedTestnetVersion).
+		Return(workflow.DefaultVersion)
+
+	s.env.OnActivity(builderActivities.BuildDockerImage, mock.Anything, mock.Anything).Return(
+		messages.BuildDockerImageResponse{FQDNTag: "simapp:latest"}, nil)
+	s.env.OnActivity(testnetActivities.CreateProvider, mock.Anything, mock.Anything).Return(
+		messages.CreateProviderResponse{ProviderState: []byte("provider")}, nil)
+	s.env.OnActivity(testnetActivities.LaunchTestnet, mock.Anything, mock.Anything).Return(
+		messages.LaunchTestnetResponse{ProviderState: []byte("launched")}, nil).Once()
+	s.env.OnActivity(testnetActivities.TeardownProvider, mock.Anything, messages.TeardownProviderRequest{
+		RunnerType:    messages.Docker,
+		ProviderState: []byte("launched"),
+	}).Return(messages.TeardownProviderResponse{}, nil).Once()
+
+	req := simappReq
+	req.RunnerType = messages.Docker
+	req.TestnetDuration = "1h"
+	req.CosmosLoadTestSpec = nil
+	s.env.ExecuteWorkflow(Workflow, req)
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+	s.env.AssertActivityNumberOfCalls(s.T(), "SetExpiry", 0)
+	s.env.AssertActivityNumberOfCalls(s.T(), "SaveProviderState", 0)
+	s.env.AssertActivityNumberOfCalls(s.T(), "TeardownProvider", 1)
+}
+
+func (s *TestnetWorkflowTestSuite) setupSuperviseActivities() {
+	testnetActivities = &testnettypes.Activity{}
+	s.env.RegisterActivity(testnetActivities.SetExpiry)
+	s.env.RegisterActivity(testnetActivities.CheckHealth)
+	s.env.RegisterActivity(testnetActivities.InjectFault)
+	s.env.RegisterActivity(testnetActivities.SaveProviderState)
+	s.env.RegisterActivity(testnetActivities.TeardownProvider)
+
+	s.env.OnActivity(testnetActivities.SetExpiry, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, req messages.SetExpiryRequest) (messages.SetExpiryResponse, error) {
+			return messages.SetExpiryResponse{ProviderState: req.ProviderState}, nil
+		})
+
+	var height uint64
+	s.env.OnActivity(testnetActivities.CheckHealth, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, _ messages.CheckHealthRequest) (messages.CheckHealthResponse, error) {
+			height += 10
+			return messages.CheckHealthResponse{
+				Height: height,
+				Nodes:  []messages.NodeHealth{{Name: "validator-0", Height: height}},
+			}, nil
+		})
+
+	s.env.OnActivity(testnetActivities.InjectFault, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, req messages.InjectFaultRequest) (messages.InjectFaultResponse, error) {
+			return messages.InjectFaultResponse{ProviderState: []byte("faulted")}, nil
+		})
+
+	s.env.OnActivity(testnetActivities.SaveProviderState, mock.Anything, mock.Anything).Return(
+		messages.SaveProviderStateResponse{}, nil)
+}
+
+func (s *TestnetWorkflowTestSuite) superviseRequest() messages.SuperviseWorkflowRequest {
+	req := simappReq
+	req.RunnerType = messages.Docker
+	req.LongRunningTestnet = true
+	req.Faults = []messages.FaultSpec{
+		{Type: messages.DoubleSignFault, After: "1h"},
+		{Type: messages.DoubleSignFault, After: "48h"},
+	}
+
+	return messages.SuperviseWorkflowRequest{
+		Request: req,
+		Testnet: messages.SupervisedTestnet{
+			ChainState:    []byte("chain"),
+			ProviderState: []byte("provider"),
+			LaunchedAt:    s.env.Now(),
+			PendingFaults: req.Faults,
+			Status:        messages.TestnetStatus{ChainID: "simapp-1"},
+		},
+	}
+}
+
+func (s *TestnetWorkflowTestSuite) Test_SuperviseContinuesAsNew() {
+	s.setupSuperviseActivities()
+
+	s.env.ExecuteWorkflow(Supervise, s.superviseRequest())
+
+	s.True(s.env.IsWorkflowCompleted())
+	var continueAsNewErr *workflow.ContinueAsNewError
+	s.Require().ErrorAs(s.env.GetWorkflowError(), &continueAsNewErr)
+	s.Equal("Supervise", continueAsNewErr.WorkflowType.Name)
+
+	var next messages.SuperviseWorkflowRequest
+	s.Require().NoError(converter.GetDefaultDataConverter().FromPayloads(continueAsNewErr.Input, &next))
+	s.Equal([]byte("faulted"), next.Testnet.ProviderState)
+	s.Equal([]messages.FaultSpec{{Type: messages.DoubleSignFault, After: "48h"}}, next.Testnet.PendingFaults)
+	s.Require().NotNil(next.Testnet.Status.Health)
+	s.NotZero(next.Testnet.Status.Health.Height)
+	s.Equal("simapp-1", next.Testnet.Status.ChainID)
+
+	s.env.AssertActivityNumberOfCalls(s.T(), "InjectFault", 1)
+	s.env.AssertActivityNumberOfCalls(s.T(), "CheckHealth", int(supervisePeriod/healthCheckInterval)-1)
+	s.env.AssertActivityNumberOfCalls(s.T(), "TeardownProvider", 0)
+}
+
+func (s *TestnetWorkflowTestSuite) Test_SuperviseCancelled() {
+	s.setupSuperviseActivities()
+	s.env.OnActivity(testnetActivities.TeardownProvider, mock.Anything, messages.TeardownProviderRequest{
+		RunnerType:    messages.Docker,
+		ProviderState: []byte("provider"),
+	}).Return(messages.TeardownProviderResponse{}, nil).Once()
+
+	var status messages.TestnetStatus
+	s.env.RegisterDelayedCallback(func() {
+		result, err := s.env.QueryWorkflow(messages.TestnetStatusQuery)
+		s.Require().NoError(err)
+		s.Require().NoError(result.Get(&status))
+	}, 25*time.Minute)
+
+	s.env.RegisterDelayedCallback(func() {
+		s.env.CancelWorkflow()
+	}, 30*time.Minute)
+
+	s.env.ExecuteWorkflow(Supervise, s.superviseRequest())
+
+	s.Equal(messages.PhaseRunning, status.Phase)
+	s.Equal("simapp-1", status.ChainID)
+	s.Zero(status.ExpectedEnd)
+	s.Require().NotNil(status.Health)
+	s.Equal(uint64(20), status.Health.Height)
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+	s.env.AssertActivityNumberOfCalls(s.T(), "InjectFault", 0)
+	s.env.AssertActivityNumberOfCalls(s.T(), "TeardownProvider", 1)
+}
+
+func (s *TestnetWorkflowTestSuite) Test_SuperviseUpgradeDoesNotBlockHealthChecks() {
+	s.setupSuperviseActivities()
+	s.env.RegisterActivity(testnetActivities.UpgradeChain)
+	s.env.OnActivity(testnetActivities.UpgradeChain, mock.Anything, mock.Anything).Return(
+		messages.UpgradeChainResponse{
+			ChainState:    []byte("upgraded"),
+			ProviderState: []byte("provider"),
+			Validators:    []*pb.Node{{Name: "validator-0", Image: "simapp:upgraded"}},
+		}, nil).After(time.Hour).Once()
+	s.env.OnActivity(testnetActivities.TeardownProvider, mock.Anything, mock.Anything).Return(
+		messages.TeardownProviderResponse{}, nil).Once()
+
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(messages.RollingRestartSignal, messages.UpgradeSignal{})
+	}, 5*time.Minute)
+
+	var status messages.TestnetStatus
+	s.env.RegisterDelayedCallback(func() {
+		result, err := s.env.QueryWorkflow(messages.TestnetStatusQuery)
+		s.Require().NoError(err)
+		s.Require().NoError(result.Get(&status))
+	}, 45*time.Minute)
+
+	var upgradedStatus messages.TestnetStatus
+	s.env.RegisterDelayedCallback(func() {
+		result, err := s.env.QueryWorkflow(messages.TestnetStatusQuery)
+		s.Require().NoError(err)
+		s.Require().NoError(result.Get(&upgradedStatus))
+	}, 90*time.Minute)
+
+	s.env.RegisterDelayedCallback(func() {
+		s.env.CancelWorkflow()
+	}, 2*time.Hour)
+
+	s.env.ExecuteWorkflow(Supervise, s.superviseRequest())
+
+	s.Equal(messages.PhaseUpgrading, status.Phase)
+	s.Require().NotNil(status.Health)
+	s.Equal(uint64(40), status.Health.Height)
+
+	s.Equal(messages.PhaseRunning, upgradedStatus.Phase)
+	s.Require().Len(upgradedStatus.Validators, 1)
+	s.Equal("simapp:upgraded", upgradedStatus.Validators[0].Image)
+
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+	s.env.AssertActivityNumberOfCalls(s.T(), "UpgradeChain", 1)
+	s.env.AssertActivityNumberOfCalls(s.T(), "TeardownProvider", 1)
+}
+
 func TestTestnetWorkflowTestSuite(t *testing.T) {
 	suite.Run(t, new(TestnetWorkflowTestSuite))
 }